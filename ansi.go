@@ -0,0 +1,119 @@
+package gtf
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Colors enables the ANSI escape sequences written by color, bgcolor and
+// the style functions like bold. It is false if the NO_COLOR environment
+// variable is set to a non-empty value (https://no-color.org). Set it to
+// false to disable colors without changing templates, for example when
+// the output is not a terminal. It is not safe to change it concurrently
+// with template execution.
+var Colors = os.Getenv("NO_COLOR") == ""
+
+// ansiPattern matches ANSI escape sequences: CSI sequences like colors
+// and cursor movements, OSC sequences like hyperlinks and the other
+// two-character escapes.
+var ansiPattern = regexp.MustCompile("\x1b(?:\\[[0-?]*[ -/]*[@-~]|\\][^\x07\x1b]*(?:\x07|\x1b\\\\)|[@-Z\\\\-_])")
+
+// ansiColors are the codes of the named foreground colors. Background
+// colors are 10 higher.
+var ansiColors = map[string]int{
+	"black": 30, "red": 31, "green": 32, "yellow": 33,
+	"blue": 34, "magenta": 35, "cyan": 36, "white": 37,
+	"default": 39, "gray": 90, "grey": 90,
+	"brightblack": 90, "brightred": 91, "brightgreen": 92, "brightyellow": 93,
+	"brightblue": 94, "brightmagenta": 95, "brightcyan": 96, "brightwhite": 97,
+}
+
+// stripANSI removes the ANSI escape sequences from s.
+func stripANSI(s string) string {
+	return ansiPattern.ReplaceAllString(s, "")
+}
+
+// textLength returns the number of characters of s, not counting ANSI
+// escape sequences.
+func textLength(s string) int {
+	return len([]rune(stripANSI(s)))
+}
+
+// ansiColor returns the SGR parameters of a color given as a name like
+// "red" or "bright_red", a number of the 256-color palette or a "#rrggbb"
+// or "#rgb" truecolor.
+func ansiColor(color interface{}, background bool) (string, error) {
+	offset := 0
+	if background {
+		offset = 10
+	}
+
+	if n, ok := toInt(color); ok {
+		return ansiPalette(n, offset)
+	}
+
+	s, _ := color.(string)
+	name := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(s))
+	if code, ok := ansiColors[name]; ok {
+		return strconv.Itoa(code + offset), nil
+	}
+	if n, err := strconv.Atoi(name); err == nil {
+		return ansiPalette(n, offset)
+	}
+
+	if strings.HasPrefix(name, "#") {
+		hex := name[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if rgb, err := strconv.ParseUint(hex, 16, 32); err == nil && len(hex) == 6 {
+			return fmt.Sprintf("%d;2;%d;%d;%d", 38+offset, rgb>>16, rgb>>8&0xff, rgb&0xff), nil
+		}
+	}
+
+	return "", fmt.Errorf("gtf: unknown color %q", color)
+}
+
+// ansiPalette returns the SGR parameters of color n of the 256-color
+// palette.
+func ansiPalette(n, offset int) (string, error) {
+	if n < 0 || n > 255 {
+		return "", fmt.Errorf("gtf: color %d is not in the 256-color palette", n)
+	}
+
+	return fmt.Sprintf("%d;5;%d", 38+offset, n), nil
+}
+
+// sgr wraps s in the SGR sequences which turn an attribute on and off, if
+// Colors is true. The attribute is turned on again after any sequence
+// inside s which turns it off, so styles can be nested.
+func sgr(on, off string, s string) string {
+	if !Colors || s == "" {
+		return s
+	}
+
+	start := "\x1b[" + on + "m"
+	end := "\x1b[" + off + "m"
+	s = strings.Replace(s, end, end+start, -1)
+	s = strings.Replace(s, "\x1b[0m", "\x1b[0m"+start, -1)
+
+	return start + s + end
+}
+
+// colorize writes s in color, on a background if background is true. s is
+// left unstyled if the color is unknown.
+func colorize(color interface{}, background bool, s string) string {
+	code, err := ansiColor(color, background)
+	if err != nil {
+		return s
+	}
+
+	if background {
+		return sgr(code, "49", s)
+	}
+
+	return sgr(code, "39", s)
+}
@@ -0,0 +1,81 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestANSIColors(t *testing.T) {
+	var buffer bytes.Buffer
+
+	defer func(colors bool) { Colors = colors }(Colors)
+	Colors = true
+
+	cases := map[string]string{
+		`{{ "error" | color "red" }}`:                    "\x1b[31merror\x1b[39m",
+		`{{ "hint" | color "Bright_Cyan" }}`:             "\x1b[96mhint\x1b[39m",
+		`{{ "x" | color 208 }}`:                          "\x1b[38;5;208mx\x1b[39m",
+		`{{ "x" | color "208" }}`:                        "\x1b[38;5;208mx\x1b[39m",
+		`{{ "x" | color "#ff8700" }}`:                    "\x1b[38;2;255;135;0mx\x1b[39m",
+		`{{ "x" | bgcolor "#0af" }}`:                     "\x1b[48;2;0;170;255mx\x1b[49m",
+		`{{ "x" | bgcolor "blue" }}`:                     "\x1b[44mx\x1b[49m",
+		`{{ 42 | bold }}`:                                "\x1b[1m42\x1b[22m",
+		`{{ "x" | dim | italic }}`:                       "\x1b[3m\x1b[2mx\x1b[22m\x1b[23m",
+		`{{ "x" | underline }}{{ "y" | strikethrough }}`: "\x1b[4mx\x1b[24m\x1b[9my\x1b[29m",
+		`{{ "x" | color "nosuchcolor" }}`:                "x",
+		`{{ "x" | color 256 }}`:                          "x",
+		`{{ "" | color "red" }}`:                         "",
+	}
+
+	for input, expected := range cases {
+		TextTemplateParseTest(&buffer, input, "")
+		AssertEqual(t, &buffer, expected)
+	}
+
+	// The outer color is restored after a nested one.
+	TextTemplateParseTest(&buffer, `{{ print "a " (color "blue" "b") " c" | color "red" }}`, "")
+	AssertEqual(t, &buffer, "\x1b[31ma \x1b[34mb\x1b[39m\x1b[31m c\x1b[39m")
+
+	Colors = false
+	TextTemplateParseTest(&buffer, `{{ . | color "red" | bold | underline }}`, "plain")
+	AssertEqual(t, &buffer, "plain")
+}
+
+func TestStripANSI(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := map[string]string{
+		"\x1b[1;31merror\x1b[0m: x":                           "error: x",
+		"\x1b[38;2;255;0;0mtrue\x1b[39m\x1b[38;5;1mcolor":     "truecolor",
+		"\x1b]8;;https://go.dev\x1b\\link\x1b]8;;\x1b\\ text": "link text",
+		"\x1b]0;title\x07\x1b[2K\x1b[1Aline\x1bM":             "line",
+		"안녕 \x1b[4m하세요\x1b[24m":                               "안녕 하세요",
+	}
+
+	for input, expected := range cases {
+		TextTemplateParseTest(&buffer, "{{ . | stripansi }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+}
+
+func TestANSIPadding(t *testing.T) {
+	var buffer bytes.Buffer
+
+	defer func(colors bool) { Colors = colors }(Colors)
+	Colors = true
+
+	TextTemplateParseTest(&buffer, `{{ "Go" | color "red" | length }}`, "")
+	AssertEqual(t, &buffer, "2")
+
+	TextTemplateParseTest(&buffer, `{{ "Go" | bold | lengthis 2 }}`, "")
+	AssertEqual(t, &buffer, "true")
+
+	TextTemplateParseTest(&buffer, `[{{ "Go" | color "red" | ljust 5 }}]`, "")
+	AssertEqual(t, &buffer, "[\x1b[31mGo\x1b[39m   ]")
+
+	TextTemplateParseTest(&buffer, `[{{ "Go" | color "red" | rjust 5 }}]`, "")
+	AssertEqual(t, &buffer, "[   \x1b[31mGo\x1b[39m]")
+
+	TextTemplateParseTest(&buffer, `[{{ "안녕" | underline | center 6 }}]`, "")
+	AssertEqual(t, &buffer, "[  \x1b[4m안녕\x1b[24m  ]")
+}
@@ -0,0 +1,173 @@
+package gtf
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// mapKey converts name into a key of the given map key type.
+func mapKey(name string, keyType reflect.Type) (reflect.Value, bool) {
+	key := reflect.New(keyType).Elem()
+
+	switch keyType.Kind() {
+	case reflect.String:
+		key.SetString(name)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(name, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(name, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(name, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(name)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetBool(b)
+	case reflect.Interface:
+		if !reflect.TypeOf(name).Implements(keyType) {
+			return reflect.Value{}, false
+		}
+		key.Set(reflect.ValueOf(name))
+	default:
+		return reflect.Value{}, false
+	}
+
+	return key, true
+}
+
+// callMethod calls the method name of v if it takes no arguments and
+// returns at least one value.
+func callMethod(v reflect.Value, name string) (reflect.Value, bool) {
+	if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+		return reflect.Value{}, false
+	}
+
+	method := v.MethodByName(name)
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() == 0 {
+		return reflect.Value{}, false
+	}
+
+	return method.Call(nil)[0], true
+}
+
+// attribute looks up name on v. It resolves exported struct fields,
+// methods without arguments, map keys of any basic key type and slice
+// or array indexes. Negative indexes count from the end.
+func attribute(v reflect.Value, name string) (reflect.Value, bool) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	if v.CanAddr() {
+		if result, ok := callMethod(v.Addr(), name); ok {
+			return result, true
+		}
+	} else if result, ok := callMethod(v, name); ok {
+		return result, true
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		field, ok := v.Type().FieldByName(name)
+		if ok && field.PkgPath == "" {
+			return v.FieldByIndex(field.Index), true
+		}
+	case reflect.Map:
+		key, ok := mapKey(name, v.Type().Key())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		if item := v.MapIndex(key); item.IsValid() {
+			return item, true
+		}
+	case reflect.Slice, reflect.Array, reflect.String:
+		i, err := strconv.Atoi(name)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		if v.Kind() == reflect.String {
+			str := []rune(v.String())
+			if i < 0 {
+				i += len(str)
+			}
+			if i < 0 || i >= len(str) {
+				return reflect.Value{}, false
+			}
+			return reflect.ValueOf(string(str[i])), true
+		}
+		if i < 0 {
+			i += v.Len()
+		}
+		if i >= 0 && i < v.Len() {
+			return v.Index(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// pathSegments converts a dotted path string or a slice of segments into
+// a list of segment names.
+func pathSegments(path interface{}) ([]string, bool) {
+	if s, ok := path.(string); ok {
+		if s == "" {
+			return nil, true
+		}
+		return strings.Split(s, "."), true
+	}
+
+	items, _, ok := listItems(path)
+	if !ok {
+		return nil, false
+	}
+
+	segments := make([]string, len(items))
+	for i, item := range items {
+		segments[i] = stringify(indirect(item))
+	}
+
+	return segments, true
+}
+
+// resolvePath walks segments starting from v.
+func resolvePath(v reflect.Value, segments []string) (reflect.Value, bool) {
+	for _, segment := range segments {
+		var ok bool
+		if v, ok = attribute(v, segment); !ok {
+			return reflect.Value{}, false
+		}
+	}
+
+	return v, true
+}
+
+// lookup resolves segments on the last element of args. If another
+// argument is given, it is returned when the path can not be resolved.
+func lookup(segments []string, args []interface{}) interface{} {
+	result, ok := resolvePath(reflect.ValueOf(args[len(args)-1]), segments)
+	if ok && result.IsValid() && result.CanInterface() &&
+		(result.Kind() != reflect.Interface || !result.IsNil()) {
+		return result.Interface()
+	}
+
+	if len(args) > 1 {
+		return args[0]
+	}
+
+	return ""
+}
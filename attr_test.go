@@ -0,0 +1,74 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+type attrTestOwner struct {
+	Name string
+	nick string
+}
+
+func (o *attrTestOwner) Greeting() string {
+	return "Hello, " + o.Name
+}
+
+type attrTestItem struct {
+	Owner *attrTestOwner
+	Tags  map[int]string
+}
+
+func TestAttrFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	data := map[string]interface{}{
+		"items": []attrTestItem{
+			{Owner: &attrTestOwner{Name: "gopher", nick: "go"}, Tags: map[int]string{1: "fast"}},
+			{Owner: nil},
+		},
+		"meta": map[string]string{"og:title": "The Go Programming Language"},
+	}
+
+	ParseTest(&buffer, "{{ . | get \"items.0.Owner.Name\" }}", data)
+	AssertEqual(t, &buffer, "gopher")
+
+	ParseTest(&buffer, "{{ . | get \"items.0.Owner.Greeting\" }}", data)
+	AssertEqual(t, &buffer, "Hello, gopher")
+
+	ParseTest(&buffer, "{{ . | get \"items.-2.Tags.1\" }}", data)
+	AssertEqual(t, &buffer, "fast")
+
+	ParseTest(&buffer, "{{ . | get .Path }}", map[string]interface{}{
+		"Path": []string{"meta", "og:title"},
+		"meta": map[string]string{"og:title": "Go"},
+	})
+	AssertEqual(t, &buffer, "Go")
+
+	ParseTest(&buffer, "{{ . | get \"items.1.Owner.Name\" \"nobody\" }}", data)
+	AssertEqual(t, &buffer, "nobody")
+
+	ParseTest(&buffer, "{{ . | get \"items.5\" \"nothing\" }}", data)
+	AssertEqual(t, &buffer, "nothing")
+
+	ParseTest(&buffer, "{{ . | get \"items.0.Owner.nick\" \"hidden\" }}", data)
+	AssertEqual(t, &buffer, "hidden")
+
+	ParseTest(&buffer, "{{ . | get \"items.0.Owner.Missing\" }}", data)
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | get \"1\" }}", "안녕")
+	AssertEqual(t, &buffer, "녕")
+
+	ParseTest(&buffer, "{{ . | attr \"og:title\" }}", map[string]string{"og:title": "Go"})
+	AssertEqual(t, &buffer, "Go")
+
+	ParseTest(&buffer, "{{ . | attr \"a.b\" }}", map[string]string{"a.b": "dotted"})
+	AssertEqual(t, &buffer, "dotted")
+
+	ParseTest(&buffer, "{{ . | attr \"3\" \"none\" }}", map[uint8]string{2: "two"})
+	AssertEqual(t, &buffer, "none")
+
+	ParseTest(&buffer, "{{ . | attr \"true\" }}", map[bool]string{true: "yes"})
+	AssertEqual(t, &buffer, "yes")
+}
@@ -0,0 +1,128 @@
+package gtf
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Initialisms lists the words written in all capitals by camelcase and
+// pascalcase, like golint's common initialisms. Keys are uppercase.
+// Modify it before executing templates; it is not safe to change it
+// concurrently with template execution.
+var Initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true,
+	"DNS": true, "EOF": true, "GUID": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "IP": true, "JSON": true, "LHS": true,
+	"QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true,
+	"SMTP": true, "SQL": true, "SSH": true, "TCP": true, "TLS": true,
+	"TTL": true, "UDP": true, "UI": true, "UID": true, "UUID": true,
+	"URI": true, "URL": true, "UTF8": true, "VM": true, "XML": true,
+	"XMPP": true, "XSRF": true, "XSS": true,
+}
+
+// splitWords splits s into words at separators, at lower to upper case
+// transitions and before the last capital of an acronym followed by a
+// lower case letter, so "HTTPServerID" becomes "HTTP", "Server", "ID".
+// An initialism followed by a single lower case letter stays one word, so
+// "UserIDs" becomes "User", "IDs" and "IPv4Address" becomes "IPv4",
+// "Address". Digits stay attached to the preceding word.
+func splitWords(s string) []string {
+	var words []string
+	var word []rune
+
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+
+		if len(word) > 0 && unicode.IsUpper(r) {
+			previous := word[len(word)-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) ||
+				(unicode.IsUpper(previous) && nextIsLower && !isInitialismPrefix(string(word)+string(r), runes[i+1:])) {
+				flush()
+			}
+		}
+
+		word = append(word, r)
+	}
+	flush()
+
+	return words
+}
+
+// isInitialismPrefix reports whether the capitals word are an initialism
+// followed by a single lower case letter in rest, like "ID" in "IDs".
+func isInitialismPrefix(word string, rest []rune) bool {
+	return Initialisms[strings.ToUpper(word)] && (len(rest) < 2 || !unicode.IsLower(rest[1]))
+}
+
+// capitalize upper cases the first letter of word and lower cases the
+// rest.
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+
+	return string(unicode.ToTitle(r)) + strings.ToLower(word[size:])
+}
+
+// camelWord capitalizes word, writing initialisms (optionally followed by
+// digits, like "URL2") in all capitals. The plurals of initialisms and
+// words starting with an initialism in capitals, like "IDs" and "IPv4",
+// keep the capitals of the initialism.
+func camelWord(word string) string {
+	upper := strings.ToUpper(word)
+	if Initialisms[upper] || Initialisms[strings.TrimRightFunc(upper, unicode.IsDigit)] {
+		return upper
+	}
+	if trimmed := strings.TrimSuffix(upper, "S"); trimmed != upper && Initialisms[trimmed] {
+		return trimmed + "s"
+	}
+	if prefix := strings.TrimRightFunc(word, func(r rune) bool { return !unicode.IsUpper(r) }); prefix != word && Initialisms[prefix] {
+		return prefix + strings.ToLower(word[len(prefix):])
+	}
+
+	return capitalize(word)
+}
+
+func pascalCase(s string) string {
+	words := splitWords(s)
+	for i, word := range words {
+		words[i] = camelWord(word)
+	}
+
+	return strings.Join(words, "")
+}
+
+func camelCase(s string) string {
+	words := splitWords(s)
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = camelWord(word)
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// joinWords joins the words of s with sep after applying convert to each
+// of them.
+func joinWords(s string, sep string, convert func(string) string) string {
+	words := splitWords(s)
+	for i, word := range words {
+		words[i] = convert(word)
+	}
+
+	return strings.Join(words, sep)
+}
@@ -0,0 +1,62 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCaseFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := []struct {
+		input, camel, pascal, snake, kebab, screaming, dot, train string
+	}{
+		{"HTTPServerID", "httpServerID", "HTTPServerID", "http_server_id", "http-server-id", "HTTP_SERVER_ID", "http.server.id", "Http-Server-Id"},
+		{"user_id", "userID", "UserID", "user_id", "user-id", "USER_ID", "user.id", "User-Id"},
+		{"the go programming language", "theGoProgrammingLanguage", "TheGoProgrammingLanguage", "the_go_programming_language", "the-go-programming-language", "THE_GO_PROGRAMMING_LANGUAGE", "the.go.programming.language", "The-Go-Programming-Language"},
+		{"parseURL2JSON", "parseURL2JSON", "ParseURL2JSON", "parse_url2_json", "parse-url2-json", "PARSE_URL2_JSON", "parse.url2.json", "Parse-Url2-Json"},
+		{"content-type", "contentType", "ContentType", "content_type", "content-type", "CONTENT_TYPE", "content.type", "Content-Type"},
+		{"  __ÉcoleName", "écoleName", "ÉcoleName", "école_name", "école-name", "ÉCOLE_NAME", "école.name", "École-Name"},
+		{"userIDs", "userIDs", "UserIDs", "user_ids", "user-ids", "USER_IDS", "user.ids", "User-Ids"},
+		{"IPv4Address", "ipv4Address", "IPv4Address", "ipv4_address", "ipv4-address", "IPV4_ADDRESS", "ipv4.address", "Ipv4-Address"},
+		{"APIsAndURLs", "apisAndURLs", "APIsAndURLs", "apis_and_urls", "apis-and-urls", "APIS_AND_URLS", "apis.and.urls", "Apis-And-Urls"},
+		{"HTTPSettings", "httpSettings", "HTTPSettings", "http_settings", "http-settings", "HTTP_SETTINGS", "http.settings", "Http-Settings"},
+		{"", "", "", "", "", "", "", ""},
+	}
+
+	for _, c := range cases {
+		ParseTest(&buffer, "{{ . | camelcase }}", c.input)
+		AssertEqual(t, &buffer, c.camel)
+
+		ParseTest(&buffer, "{{ . | pascalcase }}", c.input)
+		AssertEqual(t, &buffer, c.pascal)
+
+		ParseTest(&buffer, "{{ . | snakecase }}", c.input)
+		AssertEqual(t, &buffer, c.snake)
+
+		ParseTest(&buffer, "{{ . | kebabcase }}", c.input)
+		AssertEqual(t, &buffer, c.kebab)
+
+		ParseTest(&buffer, "{{ . | screamingsnake }}", c.input)
+		AssertEqual(t, &buffer, c.screaming)
+
+		ParseTest(&buffer, "{{ . | dotcase }}", c.input)
+		AssertEqual(t, &buffer, c.dot)
+
+		ParseTest(&buffer, "{{ . | traincase }}", c.input)
+		AssertEqual(t, &buffer, c.train)
+	}
+
+	Initialisms["GTF"] = true
+	defer delete(Initialisms, "GTF")
+
+	ParseTest(&buffer, "{{ . | pascalcase }}", "gtf_func_map")
+	AssertEqual(t, &buffer, "GTFFuncMap")
+
+	// snakecase and pascalcase round trip the plurals made by pluralword.
+	ParseTest(&buffer, "{{ . | pluralword | snakecase }}", "UserID")
+	AssertEqual(t, &buffer, "user_ids")
+
+	ParseTest(&buffer, "{{ . | pluralword | snakecase | pascalcase }}", "UserID")
+	AssertEqual(t, &buffer, "UserIDs")
+}
@@ -0,0 +1,482 @@
+package gtf
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// indirect dereferences pointers and interfaces until it reaches a
+// concrete value. It returns the invalid Value for nil pointers.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+// toInt converts any integer or float value into an int.
+func toInt(value interface{}) (int, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return int(v.Float()), true
+	}
+
+	return 0, false
+}
+
+// listItems returns the elements of a slice or array. ok is false for
+// any other kind of value.
+func listItems(value interface{}) (items []reflect.Value, elem reflect.Type, ok bool) {
+	v := indirect(reflect.ValueOf(value))
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		items = make([]reflect.Value, v.Len())
+		for i := range items {
+			items[i] = v.Index(i)
+		}
+		return items, v.Type().Elem(), true
+	}
+
+	return nil, nil, false
+}
+
+// makeList builds a new slice of elem holding items. Items which can not
+// be stored in elem turn the result into a []interface{}.
+func makeList(elem reflect.Type, items []reflect.Value) interface{} {
+	for _, item := range items {
+		if !item.IsValid() || !item.Type().AssignableTo(elem) {
+			elem = interfaceType
+			break
+		}
+	}
+
+	list := reflect.MakeSlice(reflect.SliceOf(elem), len(items), len(items))
+	for i, item := range items {
+		if item.IsValid() {
+			list.Index(i).Set(item)
+		}
+	}
+
+	return list.Interface()
+}
+
+// makeNestedList builds a slice of slices of elem from groups.
+func makeNestedList(elem reflect.Type, groups [][]reflect.Value) interface{} {
+	for _, group := range groups {
+		for _, item := range group {
+			if !item.IsValid() || !item.Type().AssignableTo(elem) {
+				elem = interfaceType
+			}
+		}
+	}
+
+	list := reflect.MakeSlice(reflect.SliceOf(reflect.SliceOf(elem)), len(groups), len(groups))
+	for i, group := range groups {
+		list.Index(i).Set(reflect.ValueOf(makeList(elem, group)))
+	}
+
+	return list.Interface()
+}
+
+// hashable reports whether v can be used as a map key.
+func hashable(v reflect.Value) bool {
+	v = indirect(v)
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !hashable(v.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !hashable(v.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return v.Type().Comparable()
+}
+
+func unique(args ...interface{}) interface{} {
+	items, elem, ok := listItems(args[len(args)-1])
+	if !ok {
+		return ""
+	}
+
+	var result []reflect.Value
+	var seenList []interface{}
+	seen := map[interface{}]bool{}
+	for _, item := range items {
+		key := item
+		if len(args) > 1 {
+			key, _ = attribute(item, args[0].(string))
+		}
+
+		var k interface{}
+		if key.IsValid() {
+			k = key.Interface()
+		}
+
+		if hashable(key) {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+		} else {
+			duplicated := false
+			for _, s := range seenList {
+				if reflect.DeepEqual(s, k) {
+					duplicated = true
+					break
+				}
+			}
+			if duplicated {
+				continue
+			}
+			seenList = append(seenList, k)
+		}
+
+		result = append(result, item)
+	}
+
+	return makeList(elem, result)
+}
+
+func reverse(value interface{}) interface{} {
+	v := indirect(reflect.ValueOf(value))
+	if v.Kind() == reflect.String {
+		clusters := graphemes(v.String())
+		for i, j := 0, len(clusters)-1; i < j; i, j = i+1, j-1 {
+			clusters[i], clusters[j] = clusters[j], clusters[i]
+		}
+		return strings.Join(clusters, "")
+	}
+
+	items, elem, ok := listItems(value)
+	if !ok {
+		return ""
+	}
+
+	result := make([]reflect.Value, len(items))
+	for i, item := range items {
+		result[len(items)-1-i] = item
+	}
+
+	return makeList(elem, result)
+}
+
+func flattenInto(result []reflect.Value, v reflect.Value) []reflect.Value {
+	if inner := indirect(v); inner.Kind() == reflect.Slice || inner.Kind() == reflect.Array {
+		for i := 0; i < inner.Len(); i++ {
+			result = flattenInto(result, inner.Index(i))
+		}
+		return result
+	}
+
+	if v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	return append(result, v)
+}
+
+func flatten(value interface{}) interface{} {
+	v := indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return ""
+	}
+
+	result := flattenInto(nil, v)
+	if len(result) == 0 {
+		return []interface{}{}
+	}
+
+	elem := interfaceType
+	if result[0].IsValid() {
+		elem = result[0].Type()
+	}
+
+	return makeList(elem, result)
+}
+
+// chunkItems splits items into groups of size n. A valid fill value pads
+// the last group up to n items.
+func chunkItems(n int, items []reflect.Value, fill reflect.Value) [][]reflect.Value {
+	var groups [][]reflect.Value
+	for start := 0; start < len(items); start += n {
+		end := start + n
+		if end > len(items) {
+			end = len(items)
+		}
+		groups = append(groups, items[start:end])
+	}
+
+	if fill.IsValid() && len(groups) > 0 {
+		last := groups[len(groups)-1]
+		padded := append([]reflect.Value{}, last...)
+		for len(padded) < n {
+			padded = append(padded, fill)
+		}
+		groups[len(groups)-1] = padded
+	}
+
+	return groups
+}
+
+func batch(n int, args ...interface{}) interface{} {
+	if n <= 0 || len(args) == 0 {
+		return ""
+	}
+
+	items, elem, ok := listItems(args[len(args)-1])
+	if !ok {
+		return ""
+	}
+
+	var fill reflect.Value
+	if len(args) > 1 {
+		fill = reflect.ValueOf(args[0])
+	}
+
+	return makeNestedList(elem, chunkItems(n, items, fill))
+}
+
+// sliceColumns splits items into n columns like Jinja2's slice filter.
+// The first len(items) % n columns get one extra item.
+func sliceColumns(n int, value interface{}) interface{} {
+	items, elem, ok := listItems(value)
+	if !ok || n <= 0 {
+		return ""
+	}
+
+	perColumn := len(items) / n
+	withExtra := len(items) % n
+
+	groups := make([][]reflect.Value, n)
+	offset := 0
+	for i := range groups {
+		size := perColumn
+		if i < withExtra {
+			size++
+		}
+		groups[i] = items[offset : offset+size]
+		offset += size
+	}
+
+	return makeNestedList(elem, groups)
+}
+
+func zip(lists ...interface{}) interface{} {
+	var columns [][]reflect.Value
+	length := -1
+	for _, list := range lists {
+		items, _, ok := listItems(list)
+		if !ok {
+			return ""
+		}
+		if length < 0 || len(items) < length {
+			length = len(items)
+		}
+		columns = append(columns, items)
+	}
+
+	if length < 0 {
+		length = 0
+	}
+
+	result := make([][]interface{}, length)
+	for i := range result {
+		row := make([]interface{}, len(columns))
+		for j, column := range columns {
+			row[j] = column[i].Interface()
+		}
+		result[i] = row
+	}
+
+	return result
+}
+
+func compact(value interface{}) interface{} {
+	items, elem, ok := listItems(value)
+	if !ok {
+		return ""
+	}
+
+	var result []reflect.Value
+	for _, item := range items {
+		if !isEmpty(item) {
+			result = append(result, item)
+		}
+	}
+
+	return makeList(elem, result)
+}
+
+// isNegative reports whether the number v is less than zero.
+func isNegative(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() < 0
+	}
+
+	return false
+}
+
+// equalItem reports whether the list item equals value. Like toMapKey,
+// numbers are converted to the type of the item first, since numbers in
+// templates are always int. Conversions which change the value never
+// match.
+func equalItem(item reflect.Value, value interface{}) bool {
+	if item.Kind() == reflect.Interface {
+		item = item.Elem()
+	}
+	if !item.IsValid() {
+		return value == nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.IsValid() && v.Type() != item.Type() &&
+		isNumberKind(v.Kind()) && isNumberKind(item.Kind()) {
+		converted := v.Convert(item.Type())
+		return converted.Interface() == item.Interface() &&
+			converted.Convert(v.Type()).Interface() == value &&
+			isNegative(converted) == isNegative(v)
+	}
+
+	return reflect.DeepEqual(item.Interface(), value)
+}
+
+func without(args ...interface{}) interface{} {
+	items, elem, ok := listItems(args[len(args)-1])
+	if !ok {
+		return ""
+	}
+
+	excluded := args[:len(args)-1]
+
+	var result []reflect.Value
+	for _, item := range items {
+		keep := true
+		for _, e := range excluded {
+			if equalItem(item, e) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			result = append(result, item)
+		}
+	}
+
+	return makeList(elem, result)
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// stringify formats v with fmt, also honouring String methods declared on
+// a pointer receiver when v is addressable.
+func stringify(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+
+	if v.Kind() != reflect.Ptr && v.CanAddr() && v.Addr().Type().Implements(stringerType) {
+		return v.Addr().Interface().(fmt.Stringer).String()
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// joinItems stringifies each item of value, or the named attribute of
+// each item when name is not empty.
+func joinItems(name string, value interface{}) ([]string, bool) {
+	if strs, ok := value.([]string); ok {
+		return strs, true
+	}
+
+	items, _, ok := listItems(value)
+	if !ok {
+		return nil, false
+	}
+
+	strs := make([]string, len(items))
+	for i, item := range items {
+		if name != "" {
+			item, _ = attribute(item, name)
+		}
+		strs[i] = stringify(item)
+	}
+
+	return strs, true
+}
+
+func join(sep string, args ...interface{}) string {
+	name := ""
+	if len(args) > 1 {
+		name = args[0].(string)
+	}
+
+	strs, ok := joinItems(name, args[len(args)-1])
+	if !ok {
+		return ""
+	}
+
+	return strings.Join(strs, sep)
+}
+
+func joinNatural(args ...interface{}) string {
+	conjunction := "and"
+	oxfordComma := true
+	for _, arg := range args[:len(args)-1] {
+		switch a := arg.(type) {
+		case string:
+			conjunction = a
+		case bool:
+			oxfordComma = a
+		}
+	}
+
+	strs, ok := joinItems("", args[len(args)-1])
+	if !ok {
+		return ""
+	}
+
+	switch len(strs) {
+	case 0:
+		return ""
+	case 1:
+		return strs[0]
+	case 2:
+		return strs[0] + " " + conjunction + " " + strs[1]
+	}
+
+	last := len(strs) - 1
+	result := strings.Join(strs[:last], ", ")
+	if oxfordComma {
+		result += ","
+	}
+
+	return result + " " + conjunction + " " + strs[last]
+}
@@ -0,0 +1,173 @@
+package gtf
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+type collectionTestUser struct {
+	Name string
+	Age  int
+}
+
+func (u collectionTestUser) Initial() string {
+	return u.Name[:1]
+}
+
+func TestCollectionFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	users := []collectionTestUser{{"go", 10}, {"python", 20}, {"gopher", 10}}
+
+	ParseTest(&buffer, "{{ . | unique }}", []string{"go", "python", "go", "ruby", "python"})
+	AssertEqual(t, &buffer, "[go python ruby]")
+
+	ParseTest(&buffer, "{{ . | unique }}", []int{3, 1, 3, 2, 1})
+	AssertEqual(t, &buffer, "[3 1 2]")
+
+	ParseTest(&buffer, "{{ range . | unique \"Age\" }}{{ .Name }} {{ end }}", users)
+	AssertEqual(t, &buffer, "go python ")
+
+	ParseTest(&buffer, "{{ range . | unique \"Initial\" }}{{ .Name }} {{ end }}", users)
+	AssertEqual(t, &buffer, "go python ")
+
+	ParseTest(&buffer, "{{ . | unique }}", [][]int{{1}, {1}, {2}})
+	AssertEqual(t, &buffer, "[[1] [2]]")
+
+	ParseTest(&buffer, "{{ . | unique }}", false)
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | reverse }}", "The Go")
+	AssertEqual(t, &buffer, "oG ehT")
+
+	ParseTest(&buffer, "{{ . | reverse }}", "안녕하세요")
+	AssertEqual(t, &buffer, "요세하녕안")
+
+	ParseTest(&buffer, "{{ . | reverse }}", "éa")
+	AssertEqual(t, &buffer, "aé")
+
+	ParseTest(&buffer, "{{ . | reverse }}", "\U0001F1F0\U0001F1F7\U0001F44D\U0001F3FD")
+	AssertEqual(t, &buffer, "\U0001F44D\U0001F3FD\U0001F1F0\U0001F1F7")
+
+	ParseTest(&buffer, "{{ . | reverse }}", "각ᄂ")
+	AssertEqual(t, &buffer, "ᄂ각")
+
+	ParseTest(&buffer, "{{ . | reverse }}", []string{"go", "python", "ruby"})
+	AssertEqual(t, &buffer, "[ruby python go]")
+
+	ParseTest(&buffer, "{{ . | reverse }}", [3]int{1, 2, 3})
+	AssertEqual(t, &buffer, "[3 2 1]")
+
+	ParseTest(&buffer, "{{ . | reverse }}", false)
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | flatten }}", [][]int{{1, 2}, {3}, {}, {4, 5}})
+	AssertEqual(t, &buffer, "[1 2 3 4 5]")
+
+	ParseTest(&buffer, "{{ . | flatten }}", []interface{}{"go", []interface{}{"python", []string{"ruby"}}})
+	AssertEqual(t, &buffer, "[go python ruby]")
+
+	ParseTest(&buffer, "{{ . | flatten }}", []interface{}{1, []interface{}{"go"}})
+	AssertEqual(t, &buffer, "[1 go]")
+
+	ParseTest(&buffer, "{{ . | flatten }}", "go")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | batch 2 }}", []int{1, 2, 3, 4, 5})
+	AssertEqual(t, &buffer, "[[1 2] [3 4] [5]]")
+
+	ParseTest(&buffer, "{{ . | batch 2 0 }}", []int{1, 2, 3, 4, 5})
+	AssertEqual(t, &buffer, "[[1 2] [3 4] [5 0]]")
+
+	ParseTest(&buffer, "{{ . | batch 0 }}", []int{1, 2, 3})
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | chunk 3 }}", []string{"a", "b", "c", "d"})
+	AssertEqual(t, &buffer, "[[a b c] [d]]")
+
+	ParseTest(&buffer, "{{ . | slice 3 }}", []int{1, 2, 3, 4, 5, 6, 7})
+	AssertEqual(t, &buffer, "[[1 2 3] [4 5] [6 7]]")
+
+	ParseTest(&buffer, "{{ . | slice 2 }}", [4]string{"a", "b", "c", "d"})
+	AssertEqual(t, &buffer, "[[a b] [c d]]")
+
+	ParseTest(&buffer, "{{ zip .A .B }}", map[string]interface{}{
+		"A": []string{"a", "b", "c"},
+		"B": []int{1, 2},
+	})
+	AssertEqual(t, &buffer, "[[a 1] [b 2]]")
+
+	ParseTest(&buffer, "{{ zip .A false }}", map[string]interface{}{"A": []string{"a"}})
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | compact }}", []string{"go", "", "ruby", ""})
+	AssertEqual(t, &buffer, "[go ruby]")
+
+	ParseTest(&buffer, "{{ . | compact }}", []interface{}{0, 1, nil, false, "go"})
+	AssertEqual(t, &buffer, "[1 go]")
+
+	ParseTest(&buffer, "{{ . | without \"go\" \"ruby\" }}", []string{"go", "python", "ruby", "go"})
+	AssertEqual(t, &buffer, "[python]")
+
+	ParseTest(&buffer, "{{ . | without 2 }}", []int{1, 2, 3, 2})
+	AssertEqual(t, &buffer, "[1 3]")
+
+	ParseTest(&buffer, "{{ . | without 1 3 }}", []int64{1, 2, 3})
+	AssertEqual(t, &buffer, "[2]")
+
+	ParseTest(&buffer, "{{ . | without 2 }}", []interface{}{int64(1), uint(2), 2.0, "2"})
+	AssertEqual(t, &buffer, "[1 2]")
+
+	ParseTest(&buffer, "{{ . | without 1.5 -1 300 }}", []uint8{1, 255, 44})
+	AssertEqual(t, &buffer, "[1 255 44]")
+
+	ParseTest(&buffer, "{{ . | without \"a\" }}", []interface{}{"a", nil, "b"})
+	AssertEqual(t, &buffer, "[&lt;nil&gt; b]")
+
+	TextTemplateParseTest(&buffer, "{{ . | without nil }}", []interface{}{"a", nil, "b"})
+	AssertEqual(t, &buffer, "[a b]")
+}
+
+type collectionTestTag int
+
+func (t collectionTestTag) String() string {
+	return fmt.Sprintf("#%d", int(t))
+}
+
+func TestJoinFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ . | join \", \" }}", []int{1, 2, 3})
+	AssertEqual(t, &buffer, "1, 2, 3")
+
+	ParseTest(&buffer, "{{ . | join \" \" }}", [2]string{"go", "ruby"})
+	AssertEqual(t, &buffer, "go ruby")
+
+	ParseTest(&buffer, "{{ . | join \" \" }}", []collectionTestTag{1, 2})
+	AssertEqual(t, &buffer, "#1 #2")
+
+	ParseTest(&buffer, "{{ . | join \", \" \"Name\" }}", []collectionTestUser{{"go", 10}, {"python", 20}})
+	AssertEqual(t, &buffer, "go, python")
+
+	ParseTest(&buffer, "{{ . | join \", \" \"name\" }}", []map[string]string{{"name": "go"}, {"name": "ruby"}})
+	AssertEqual(t, &buffer, "go, ruby")
+
+	ParseTest(&buffer, "{{ . | join \", \" }}", "go")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | joinnatural }}", []string{"go", "python", "ruby"})
+	AssertEqual(t, &buffer, "go, python, and ruby")
+
+	ParseTest(&buffer, "{{ . | joinnatural \"or\" false }}", []string{"go", "python", "ruby"})
+	AssertEqual(t, &buffer, "go, python or ruby")
+
+	ParseTest(&buffer, "{{ . | joinnatural }}", []string{"go", "ruby"})
+	AssertEqual(t, &buffer, "go and ruby")
+
+	ParseTest(&buffer, "{{ . | joinnatural }}", []int{1})
+	AssertEqual(t, &buffer, "1")
+
+	ParseTest(&buffer, "{{ . | joinnatural }}", []int{})
+	AssertEqual(t, &buffer, "")
+}
@@ -0,0 +1,249 @@
+package gtf
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var genericMapType = reflect.TypeOf(map[string]interface{}{})
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// toMapKey converts key into a value of keyType. Numbers are converted
+// between numeric types and strings are parsed like path segments.
+func toMapKey(key interface{}, keyType reflect.Type) (reflect.Value, bool) {
+	k := reflect.ValueOf(key)
+	if !k.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	switch {
+	case k.Type().AssignableTo(keyType):
+		return k, true
+	case isNumberKind(k.Kind()) && isNumberKind(keyType.Kind()),
+		k.Kind() == reflect.String && keyType.Kind() == reflect.String:
+		return k.Convert(keyType), true
+	}
+
+	return mapKey(fmt.Sprint(key), keyType)
+}
+
+// toMap returns value as a map Value, following pointers and interfaces.
+func toMap(value interface{}) (reflect.Value, bool) {
+	v := indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Map {
+		return reflect.Value{}, false
+	}
+
+	return v, true
+}
+
+// copyMap returns a shallow copy of m. If elem is not nil and can not be
+// stored in m, the copy is a map[string]interface{}.
+func copyMap(m reflect.Value, elem reflect.Value) reflect.Value {
+	mapType := m.Type()
+	if elem.IsValid() && !elem.Type().AssignableTo(mapType.Elem()) {
+		mapType = genericMapType
+	}
+
+	result := reflect.MakeMap(mapType)
+	for _, key := range m.MapKeys() {
+		k, ok := toMapKey(key.Interface(), mapType.Key())
+		if !ok {
+			continue
+		}
+		result.SetMapIndex(k, m.MapIndex(key))
+	}
+
+	return result
+}
+
+// lessValue orders map keys of any basic type. Numbers are compared
+// numerically, anything else by its formatted representation.
+func lessValue(a, b reflect.Value) bool {
+	a, b = indirect(a), indirect(b)
+	if a.IsValid() && b.IsValid() && a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		}
+	}
+
+	return stringify(a) < stringify(b)
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessValue(keys[i], keys[j])
+	})
+
+	return keys
+}
+
+func dict(pairs ...interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		result[fmt.Sprint(pairs[i])] = pairs[i+1]
+	}
+
+	return result
+}
+
+func setKey(key interface{}, value interface{}, m interface{}) interface{} {
+	v, ok := toMap(m)
+	if !ok {
+		return ""
+	}
+
+	elem := reflect.ValueOf(value)
+	result := copyMap(v, elem)
+
+	k, ok := toMapKey(key, result.Type().Key())
+	if !ok {
+		return ""
+	}
+
+	if elem.IsValid() {
+		result.SetMapIndex(k, elem)
+	} else {
+		result.SetMapIndex(k, reflect.Zero(result.Type().Elem()))
+	}
+
+	return result.Interface()
+}
+
+// filterKeys returns a copy of the last argument, keeping only the given
+// keys if keep is true or dropping them otherwise.
+func filterKeys(keep bool, args []interface{}) interface{} {
+	v, ok := toMap(args[len(args)-1])
+	if !ok {
+		return ""
+	}
+
+	listed := reflect.MakeMap(reflect.MapOf(v.Type().Key(), reflect.TypeOf(true)))
+	for _, key := range args[:len(args)-1] {
+		if k, ok := toMapKey(key, v.Type().Key()); ok {
+			listed.SetMapIndex(k, reflect.ValueOf(true))
+		}
+	}
+
+	result := reflect.MakeMap(v.Type())
+	for _, key := range v.MapKeys() {
+		if listed.MapIndex(key).IsValid() == keep {
+			result.SetMapIndex(key, v.MapIndex(key))
+		}
+	}
+
+	return result.Interface()
+}
+
+// mergeMaps merges maps into a new map. Keys of earlier maps take
+// precedence. If deep is true, nested maps are merged recursively.
+func mergeMaps(deep bool, maps ...interface{}) interface{} {
+	var values []reflect.Value
+	for _, m := range maps {
+		v, ok := toMap(m)
+		if !ok {
+			return ""
+		}
+		values = append(values, v)
+	}
+
+	if len(values) == 0 {
+		return map[string]interface{}{}
+	}
+
+	mapType := values[0].Type()
+	for _, v := range values[1:] {
+		if v.Type() != mapType {
+			mapType = genericMapType
+			break
+		}
+	}
+
+	result := reflect.MakeMap(mapType)
+	for i := len(values) - 1; i >= 0; i-- {
+		for _, key := range values[i].MapKeys() {
+			k, ok := toMapKey(key.Interface(), mapType.Key())
+			if !ok {
+				continue
+			}
+
+			item := values[i].MapIndex(key)
+			if deep {
+				if previous := result.MapIndex(k); previous.IsValid() {
+					_, previousIsMap := toMap(previous.Interface())
+					_, itemIsMap := toMap(item.Interface())
+					if previousIsMap && itemIsMap {
+						merged := reflect.ValueOf(mergeMaps(true, item.Interface(), previous.Interface()))
+						if merged.Type().AssignableTo(mapType.Elem()) {
+							item = merged
+						}
+					}
+				}
+			}
+
+			result.SetMapIndex(k, item)
+		}
+	}
+
+	return result.Interface()
+}
+
+func keys(value interface{}) interface{} {
+	v, ok := toMap(value)
+	if !ok {
+		return ""
+	}
+
+	return makeList(v.Type().Key(), sortedKeys(v))
+}
+
+func values(value interface{}) interface{} {
+	v, ok := toMap(value)
+	if !ok {
+		return ""
+	}
+
+	keys := sortedKeys(v)
+	items := make([]reflect.Value, len(keys))
+	for i, key := range keys {
+		items[i] = v.MapIndex(key)
+	}
+
+	return makeList(v.Type().Elem(), items)
+}
+
+func hasKey(key interface{}, value interface{}) bool {
+	v, ok := toMap(value)
+	if !ok {
+		return false
+	}
+
+	k, ok := toMapKey(key, v.Type().Key())
+	if !ok {
+		return false
+	}
+
+	return v.MapIndex(k).IsValid()
+}
@@ -0,0 +1,92 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDictFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ $d := dict \"name\" \"go\" \"year\" 2009 }}{{ $d.name }} {{ $d.year }}", "")
+	AssertEqual(t, &buffer, "go 2009")
+
+	ParseTest(&buffer, "{{ dict \"b\" 2 \"a\" 1 }}", "")
+	AssertEqual(t, &buffer, "map[a:1 b:2]")
+
+	ParseTest(&buffer, "{{ list 1 \"go\" true }}", "")
+	AssertEqual(t, &buffer, "[1 go true]")
+
+	ParseTest(&buffer, "{{ range list \"go\" \"ruby\" }}{{ . }} {{ end }}", "")
+	AssertEqual(t, &buffer, "go ruby ")
+
+	m := map[string]int{"a": 1, "b": 2}
+
+	ParseTest(&buffer, "{{ . | set \"c\" 3 }} {{ . }}", m)
+	AssertEqual(t, &buffer, "map[a:1 b:2 c:3] map[a:1 b:2]")
+
+	ParseTest(&buffer, "{{ . | set \"c\" \"x\" }}", m)
+	AssertEqual(t, &buffer, "map[a:1 b:2 c:x]")
+
+	ParseTest(&buffer, "{{ . | set 2 \"two\" }}", map[int]string{1: "one"})
+	AssertEqual(t, &buffer, "map[1:one 2:two]")
+
+	ParseTest(&buffer, "{{ . | unset \"a\" }} {{ . }}", m)
+	AssertEqual(t, &buffer, "map[b:2] map[a:1 b:2]")
+
+	ParseTest(&buffer, "{{ merge .A .B }}", map[string]interface{}{
+		"A": map[string]int{"a": 1},
+		"B": map[string]int{"a": 9, "b": 2},
+	})
+	AssertEqual(t, &buffer, "map[a:1 b:2]")
+
+	ParseTest(&buffer, "{{ merge .A .B }}", map[string]interface{}{
+		"A": map[string]int{"a": 1},
+		"B": map[string]string{"b": "go"},
+	})
+	AssertEqual(t, &buffer, "map[a:1 b:go]")
+
+	nested := map[string]interface{}{
+		"A": map[string]interface{}{"db": map[string]interface{}{"host": "example.com"}},
+		"B": map[string]interface{}{"db": map[string]interface{}{"host": "localhost", "port": 5432}, "debug": true},
+	}
+
+	ParseTest(&buffer, "{{ merge .A .B }}", nested)
+	AssertEqual(t, &buffer, "map[db:map[host:example.com] debug:true]")
+
+	ParseTest(&buffer, "{{ deepmerge .A .B }}", nested)
+	AssertEqual(t, &buffer, "map[db:map[host:example.com port:5432] debug:true]")
+
+	ParseTest(&buffer, "{{ merge .A false }}", nested)
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | keys }}", map[string]int{"c": 3, "a": 1, "b": 2})
+	AssertEqual(t, &buffer, "[a b c]")
+
+	ParseTest(&buffer, "{{ . | keys }}", map[int]string{10: "ten", 9: "nine", 100: "hundred"})
+	AssertEqual(t, &buffer, "[9 10 100]")
+
+	ParseTest(&buffer, "{{ . | values }}", map[string]int{"c": 3, "a": 1, "b": 2})
+	AssertEqual(t, &buffer, "[1 2 3]")
+
+	ParseTest(&buffer, "{{ . | keys }}", "go")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | pick \"a\" \"c\" \"x\" }}", map[string]int{"c": 3, "a": 1, "b": 2})
+	AssertEqual(t, &buffer, "map[a:1 c:3]")
+
+	ParseTest(&buffer, "{{ . | omit \"a\" \"c\" }}", map[string]int{"c": 3, "a": 1, "b": 2})
+	AssertEqual(t, &buffer, "map[b:2]")
+
+	ParseTest(&buffer, "{{ . | haskey \"a\" }}", map[string]int{"a": 1})
+	AssertEqual(t, &buffer, "true")
+
+	ParseTest(&buffer, "{{ . | haskey 2 }}", map[int]string{1: "one"})
+	AssertEqual(t, &buffer, "false")
+
+	ParseTest(&buffer, "{{ . | haskey \"1\" }}", map[int]string{1: "one"})
+	AssertEqual(t, &buffer, "true")
+
+	ParseTest(&buffer, "{{ . | haskey \"a\" }}", "go")
+	AssertEqual(t, &buffer, "false")
+}
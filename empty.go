@@ -0,0 +1,100 @@
+package gtf
+
+import "reflect"
+
+// Emptier is implemented by types which decide themselves whether they
+// are empty. default, coalesce, ternary and compact honour it.
+type Emptier interface {
+	IsEmpty() bool
+}
+
+type zeroer interface {
+	IsZero() bool
+}
+
+var (
+	emptierType = reflect.TypeOf((*Emptier)(nil)).Elem()
+	zeroerType  = reflect.TypeOf((*zeroer)(nil)).Elem()
+)
+
+// isNil reports whether v is nil, a nil pointer or an interface holding
+// a nil pointer.
+func isNil(v reflect.Value) bool {
+	for v.IsValid() {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			if v.IsNil() {
+				return true
+			}
+			if v.Kind() != reflect.Interface {
+				return false
+			}
+			v = v.Elem()
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// isEmpty reports whether v is empty: nil, false, a zero number, an empty
+// string, slice, array or map, a struct whose fields are all empty, or a
+// value whose IsEmpty (Emptier) or IsZero method returns true.
+func isEmpty(v reflect.Value) bool {
+	if v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if isNil(v) {
+		return true
+	}
+
+	if v.CanInterface() {
+		if v.Type().Implements(emptierType) {
+			return v.Interface().(Emptier).IsEmpty()
+		}
+		if v.CanAddr() && v.Addr().Type().Implements(emptierType) {
+			return v.Addr().Interface().(Emptier).IsEmpty()
+		}
+		if v.Type().Implements(zeroerType) {
+			return v.Interface().(zeroer).IsZero()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex() == 0
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !isEmpty(v.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// coalesce returns the first non-empty argument, or "" if all of them
+// are empty.
+func coalesce(args ...interface{}) interface{} {
+	for _, arg := range args {
+		if !isEmpty(reflect.ValueOf(arg)) {
+			return arg
+		}
+	}
+
+	return ""
+}
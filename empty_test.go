@@ -0,0 +1,89 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+type emptyTestCart struct {
+	Items []string
+}
+
+func (c emptyTestCart) IsEmpty() bool {
+	return len(c.Items) == 0
+}
+
+type emptyTestPoint struct {
+	X, Y int
+}
+
+func TestEmptyFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	var nilPointer *emptyTestPoint
+	var nilInterface interface{}
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", 0)
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", 0.0)
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", nilPointer)
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ .V | default \"empty\" }}", map[string]interface{}{"V": nilInterface})
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", time.Time{})
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", emptyTestPoint{})
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", emptyTestPoint{1, 0})
+	AssertEqual(t, &buffer, "{1 0}")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", &emptyTestPoint{})
+	AssertEqual(t, &buffer, "{0 0}")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", emptyTestCart{})
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", emptyTestCart{Items: []string{}})
+	AssertEqual(t, &buffer, "empty")
+
+	ParseTest(&buffer, "{{ . | default \"empty\" }}", emptyTestCart{Items: []string{"go"}})
+	AssertEqual(t, &buffer, "{[go]}")
+
+	ParseTest(&buffer, "{{ . | default_if_none \"none\" }}", nilPointer)
+	AssertEqual(t, &buffer, "none")
+
+	ParseTest(&buffer, "{{ . | default_if_none \"none\" }}", "")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | default_if_none \"none\" }}", 0)
+	AssertEqual(t, &buffer, "0")
+
+	ParseTest(&buffer, "{{ coalesce .A .B .C }}", map[string]interface{}{"A": "", "B": 0, "C": "go"})
+	AssertEqual(t, &buffer, "go")
+
+	ParseTest(&buffer, "{{ firstof .A .B \"fallback\" }}", map[string]interface{}{"A": nilPointer, "B": []int{}})
+	AssertEqual(t, &buffer, "fallback")
+
+	ParseTest(&buffer, "{{ coalesce .A .B }}", map[string]interface{}{"A": false, "B": ""})
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | ternary \"yes\" \"no\" }}", true)
+	AssertEqual(t, &buffer, "yes")
+
+	ParseTest(&buffer, "{{ . | ternary \"yes\" \"no\" }}", 0)
+	AssertEqual(t, &buffer, "no")
+
+	ParseTest(&buffer, "{{ . | ternary \"yes\" \"no\" }}", []string{"go"})
+	AssertEqual(t, &buffer, "yes")
+
+	ParseTest(&buffer, "{{ . | compact }}", []interface{}{emptyTestCart{}, emptyTestPoint{}, emptyTestPoint{1, 2}})
+	AssertEqual(t, &buffer, "[{1 2}]")
+}
@@ -0,0 +1,112 @@
+package gtf
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// toBytes returns the bytes of a string or byte slice value.
+func toBytes(value interface{}) ([]byte, bool) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), true
+	case []byte:
+		return v, true
+	}
+
+	v := indirect(reflect.ValueOf(value))
+	switch {
+	case v.Kind() == reflect.String:
+		return []byte(v.String()), true
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return v.Bytes(), true
+	}
+
+	return nil, false
+}
+
+// encodeWith returns a function encoding strings and byte slices with
+// encode.
+func encodeWith(encode func([]byte) string) func(value interface{}) string {
+	return func(value interface{}) string {
+		defer recovery()
+
+		b, ok := toBytes(value)
+		if !ok {
+			return ""
+		}
+
+		return encode(b)
+	}
+}
+
+// decodeWith returns a function decoding strings with decode. Invalid
+// input results in ""(empty string).
+func decodeWith(decode func(string) ([]byte, error)) func(s string) string {
+	return func(s string) string {
+		defer recovery()
+
+		b, err := decode(s)
+		if err != nil {
+			return ""
+		}
+
+		return string(b)
+	}
+}
+
+var rawBase32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// dataURI builds a base64 data URI. If mimeType is empty, it is detected
+// from the content.
+func dataURI(mimeType string, value interface{}) string {
+	b, ok := toBytes(value)
+	if !ok {
+		return ""
+	}
+
+	if mimeType == "" {
+		mimeType = strings.Replace(http.DetectContentType(b), " ", "", -1)
+	}
+
+	return "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(b)
+}
+
+// isPassiveDataURI reports whether a data URI built by dataURI has a media
+// type which browsers do not run as a document or script: images other
+// than SVG, audio, video, text/plain and application/octet-stream.
+func isPassiveDataURI(uri string) bool {
+	header := strings.TrimPrefix(uri, "data:")
+	i := strings.Index(header, ";base64,")
+	if i < 0 {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(header[:i])
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		return !strings.Contains(mediaType, "svg")
+	case strings.HasPrefix(mediaType, "audio/"), strings.HasPrefix(mediaType, "video/"):
+		return true
+	}
+
+	return mediaType == "text/plain" || mediaType == "application/octet-stream"
+}
+
+// dataURIArgs splits the arguments of datauri into the optional mime type
+// and the value.
+func dataURIArgs(args []interface{}) string {
+	mimeType := ""
+	if len(args) > 1 {
+		mimeType = args[0].(string)
+	}
+
+	return dataURI(mimeType, args[len(args)-1])
+}
@@ -0,0 +1,88 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodingFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ \"안녕하세요?\" | b64enc }}", "")
+	AssertEqual(t, &buffer, "7JWI64WV7ZWY7IS47JqUPw==")
+
+	ParseTest(&buffer, "{{ . | b64enc }}", []byte{0xfb, 0xff})
+	AssertEqual(t, &buffer, "&#43;/8=")
+
+	ParseTest(&buffer, "{{ \"7JWI64WV7ZWY7IS47JqUPw==\" | b64dec }}", "")
+	AssertEqual(t, &buffer, "안녕하세요?")
+
+	ParseTest(&buffer, "{{ \"not base64!\" | b64dec }}", "")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | b64urlenc }}", []byte{0xfb, 0xff})
+	AssertEqual(t, &buffer, "-_8=")
+
+	ParseTest(&buffer, "{{ \"-_8=\" | b64urldec | b64enc }}", "")
+	AssertEqual(t, &buffer, "&#43;/8=")
+
+	ParseTest(&buffer, "{{ \"go\" | b64rawenc }}", "")
+	AssertEqual(t, &buffer, "Z28")
+
+	ParseTest(&buffer, "{{ \"Z28\" | b64rawdec }}", "")
+	AssertEqual(t, &buffer, "go")
+
+	ParseTest(&buffer, "{{ . | b64rawurlenc }}", []byte{0xfb, 0xff})
+	AssertEqual(t, &buffer, "-_8")
+
+	ParseTest(&buffer, "{{ \"-_8\" | b64rawurldec | b64enc }}", "")
+	AssertEqual(t, &buffer, "&#43;/8=")
+
+	ParseTest(&buffer, "{{ \"go\" | b32enc }}", "")
+	AssertEqual(t, &buffer, "M5XQ====")
+
+	ParseTest(&buffer, "{{ \"M5XQ====\" | b32dec }}", "")
+	AssertEqual(t, &buffer, "go")
+
+	ParseTest(&buffer, "{{ \"go\" | b32rawenc }}", "")
+	AssertEqual(t, &buffer, "M5XQ")
+
+	ParseTest(&buffer, "{{ \"M5XQ\" | b32rawdec }}", "")
+	AssertEqual(t, &buffer, "go")
+
+	ParseTest(&buffer, "{{ \"go\" | hexenc }}", "")
+	AssertEqual(t, &buffer, "676f")
+
+	ParseTest(&buffer, "{{ \"676f\" | hexdec }}", "")
+	AssertEqual(t, &buffer, "go")
+
+	ParseTest(&buffer, "{{ \"zz\" | hexdec }}", "")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | hexenc }}", 10)
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "<img src=\"{{ . | datauri \"image/svg+xml\" }}\">", "<svg/>")
+	AssertEqual(t, &buffer, "<img src=\"#ZgotmplZ\">")
+
+	TextTemplateParseTest(&buffer, "{{ . | datauri \"image/svg+xml\" }}", "<svg/>")
+	AssertEqual(t, &buffer, "data:image/svg+xml;base64,PHN2Zy8+")
+
+	ParseTest(&buffer, "<img src=\"{{ . | datauri \"image/png\" }}\">", "x")
+	AssertEqual(t, &buffer, "<img src=\"data:image/png;base64,eA==\">")
+
+	for _, input := range []string{"<html><script>alert(1)</script>", "<?xml version=\"1.0\"?><svg onload=\"alert(1)\"/>", "%PDF-1.4"} {
+		ParseTest(&buffer, "<a href=\"{{ . | datauri }}\">", input)
+		AssertEqual(t, &buffer, "<a href=\"#ZgotmplZ\">")
+	}
+	for _, mimeType := range []string{"text/html", "Text/HTML; charset=utf-8", "application/xhtml+xml", "text/javascript", "image/SVG+xml", "text/plain,<x>", ""} {
+		CustomParseTest(GtfFuncMap, &buffer, "<a href=\"{{ datauri .mime .data }}\">", map[string]string{"mime": mimeType, "data": "<b>"})
+		AssertEqual(t, &buffer, "<a href=\"#ZgotmplZ\">")
+	}
+
+	ParseTest(&buffer, "<a href=\"{{ . | datauri }}\">", "hello")
+	AssertEqual(t, &buffer, "<a href=\"data:text/plain;charset=utf-8;base64,aGVsbG8=\">")
+
+	TextTemplateParseTest(&buffer, "{{ . | datauri }}", []byte("\x89PNG\r\n\x1a\n"))
+	AssertEqual(t, &buffer, "data:image/png;base64,iVBORw0KGgo=")
+}
@@ -0,0 +1,339 @@
+package gtf
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// shellQuote quotes s as a single word for a POSIX shell. The result is
+// enclosed in single quotes, inside which no character is special. A
+// single quote in s ends the quoted string, is escaped with a backslash
+// and starts a new one. NUL bytes can not be passed to a command and are
+// removed.
+func shellQuote(s string) string {
+	s = strings.Replace(s, "\x00", "", -1)
+
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+var latexReplacer = strings.NewReplacer(
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`\`, `\textbackslash{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	`|`, `\textbar{}`,
+)
+
+// csvFormulaPrefixes lists the characters which make spreadsheet
+// applications evaluate a cell as a formula.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvEscape returns s as a single CSV field, quoted like tocsv does if
+// needed. Fields which a spreadsheet would evaluate as a formula are
+// prefixed with a single quote, unless they are plain numbers.
+func csvEscape(s string) string {
+	if s != "" && strings.IndexByte(csvFormulaPrefixes, s[0]) >= 0 {
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			s = "'" + s
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	w := csv.NewWriter(&buffer)
+	w.Write([]string{s})
+	w.Flush()
+
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// isXMLChar reports whether r is allowed in an XML 1.0 document.
+func isXMLChar(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' ||
+		r >= 0x20 && r <= 0xd7ff ||
+		r >= 0xe000 && r <= 0xfffd ||
+		r >= 0x10000 && r <= utf8.MaxRune
+}
+
+// xmlEscape escapes s for XML character data. If attr is true, tabs and
+// line breaks are written as character references, so they survive
+// attribute value normalization. Characters which are not allowed in XML
+// and invalid UTF-8 are replaced with U+FFFD.
+func xmlEscape(s string, attr bool) string {
+	var buffer bytes.Buffer
+	for _, r := range s {
+		switch r {
+		case '&':
+			buffer.WriteString("&amp;")
+		case '<':
+			buffer.WriteString("&lt;")
+		case '>':
+			buffer.WriteString("&gt;")
+		case '"':
+			buffer.WriteString("&quot;")
+		case '\'':
+			buffer.WriteString("&apos;")
+		case '\t', '\n', '\r':
+			if attr {
+				fmt.Fprintf(&buffer, "&#%d;", r)
+			} else {
+				buffer.WriteRune(r)
+			}
+		default:
+			if !isXMLChar(r) {
+				r = utf8.RuneError
+			}
+			buffer.WriteRune(r)
+		}
+	}
+
+	return buffer.String()
+}
+
+// sqlString quotes s as a standard SQL string literal, doubling single
+// quotes. Backslashes are not special in standard SQL, so the result is
+// only safe for databases which follow the standard, like PostgreSQL with
+// standard_conforming_strings or MySQL in NO_BACKSLASH_ESCAPES mode. NUL
+// bytes are removed.
+func sqlString(s string) string {
+	s = strings.Replace(s, "\x00", "", -1)
+
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+var addslashesReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `'`, `\'`)
+
+// escapeJS escapes s for use in JavaScript strings, like Django's
+// escapejs. Characters which could end a string, a script element or an
+// HTML comment are written as \u escapes.
+func escapeJS(s string) string {
+	var buffer bytes.Buffer
+	for _, r := range s {
+		switch {
+		case r < 0x20, r == 0x2028, r == 0x2029,
+			strings.ContainsRune("\\'\"<>&=-;`", r):
+			fmt.Fprintf(&buffer, `\u%04X`, r)
+		default:
+			buffer.WriteRune(r)
+		}
+	}
+
+	return buffer.String()
+}
+
+// isAttrName reports whether s can be used as an XML or HTML attribute
+// name.
+func isAttrName(s string) bool {
+	return s != "" && !strings.ContainsAny(s, " \t\n\f\r/>=\"'<&\x00") && utf8.ValidString(s)
+}
+
+// urlAttrs lists the HTML attributes whose values are URLs.
+var urlAttrs = map[string]bool{
+	"action": true, "archive": true, "background": true, "cite": true,
+	"classid": true, "codebase": true, "data": true, "formaction": true,
+	"href": true, "icon": true, "longdesc": true, "manifest": true,
+	"ping": true, "poster": true, "profile": true, "src": true,
+	"usemap": true, "xlink:href": true, "xmlns": true,
+}
+
+// urlListAttrs lists the URL attributes which hold a list of URLs
+// separated by spaces or commas.
+var urlListAttrs = map[string]bool{"archive": true, "ping": true}
+
+// Content types of HTML attribute values.
+const (
+	attrPlain = iota
+	attrURL
+	attrSrcset
+	attrCSS
+	attrHTML
+	attrScript
+)
+
+// htmlAttrType returns the content type of the value of the HTML attribute
+// name, using the same rules as html/template: the data- prefix and
+// namespace prefixes are ignored, xmlns prefixes declare URLs, on* are
+// event handlers and custom attributes mentioning src, uri or url are
+// URLs.
+func htmlAttrType(name string) int {
+	if strings.HasPrefix(name, "data-") {
+		name = name[len("data-"):]
+	} else if i := strings.IndexByte(name, ':'); i >= 0 {
+		if name[:i] == "xmlns" {
+			return attrURL
+		}
+		name = name[i+1:]
+	}
+
+	switch {
+	case urlAttrs[name]:
+		return attrURL
+	case name == "srcset":
+		return attrSrcset
+	case name == "style":
+		return attrCSS
+	case name == "srcdoc":
+		return attrHTML
+	case strings.HasPrefix(name, "on"):
+		return attrScript
+	case strings.Contains(name, "src"), strings.Contains(name, "uri"), strings.Contains(name, "url"):
+		return attrURL
+	}
+
+	return attrPlain
+}
+
+// isSafeSrcset reports whether every image candidate of a srcset value
+// has a safe URL.
+func isSafeSrcset(s string) bool {
+	for _, candidate := range strings.Split(s, ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 && !isSafeURL(fields[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSafeCSS reports whether s is a list of CSS declarations which can not
+// load resources or run code. Like html/template's CSS value filter, it
+// rejects quotes, escapes, comments, functions, at-rules, blocks and the
+// expression and -moz-binding keywords.
+func isSafeCSS(s string) bool {
+	if strings.ContainsAny(s, "\x00\"'()/@[\\]`{}<>") {
+		return false
+	}
+
+	id := strings.Map(func(r rune) rune {
+		if r < utf8.RuneSelf && (r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+
+	return !strings.Contains(id, "expression") && !strings.Contains(id, "mozbinding") && !strings.Contains(id, "moz-binding")
+}
+
+// htmlAttrValue checks the value of the HTML attribute name like
+// html/template does: unsafe URLs are replaced with "#ZgotmplZ" and
+// unsafe CSS with "ZgotmplZ". Scripts and HTML documents, the values of
+// event handlers and srcdoc, are always replaced.
+func htmlAttrValue(name, text string) string {
+	switch htmlAttrType(name) {
+	case attrURL:
+		urls := []string{strings.TrimSpace(text)}
+		if urlListAttrs[name] {
+			urls = strings.FieldsFunc(text, func(r rune) bool {
+				return r == ',' || unicode.IsSpace(r)
+			})
+		}
+		for _, u := range urls {
+			if !isSafeURL(u) {
+				return "#ZgotmplZ"
+			}
+		}
+	case attrSrcset:
+		if !isSafeSrcset(text) {
+			return "#ZgotmplZ"
+		}
+	case attrCSS:
+		if !isSafeCSS(text) {
+			return "ZgotmplZ"
+		}
+	case attrHTML, attrScript:
+		return "ZgotmplZ"
+	}
+
+	return text
+}
+
+// xmlAttrs renders the entries of a map or struct as attributes, like
+// Jinja's xmlattr. nil and false values are skipped and true values are
+// rendered as bare attributes. If html is true, values are checked by
+// htmlAttrValue and event handler and srcdoc attributes are rejected. ok
+// is false if a key is not a valid attribute name.
+func xmlAttrs(value interface{}, autospace, html bool) (result string, ok bool) {
+	var entries []keyValue
+
+	v := indirect(reflect.ValueOf(value))
+	switch v.Kind() {
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			entries = append(entries, keyValue{stringify(indirect(key)), v.MapIndex(key)})
+		}
+	case reflect.Struct:
+		kebabCase := func(s string) string {
+			return joinWords(s, "-", strings.ToLower)
+		}
+		for _, f := range structFields(v.Type(), []string{"xml", "json"}, kebabCase) {
+			item := fieldByIndex(v, f.index)
+			if f.omitEmpty && isEmpty(item) {
+				continue
+			}
+			entries = append(entries, keyValue{f.name, item})
+		}
+	default:
+		return "", false
+	}
+
+	var attrs []string
+	for _, entry := range entries {
+		item := indirect(entry.value)
+		if !item.IsValid() || (item.Kind() == reflect.Bool && !item.Bool()) {
+			continue
+		}
+		if !isAttrName(entry.key) {
+			return "", false
+		}
+
+		name := strings.ToLower(entry.key)
+		if html {
+			if t := htmlAttrType(name); t == attrScript || t == attrHTML {
+				return "", false
+			}
+		}
+
+		if item.Kind() == reflect.Bool {
+			attrs = append(attrs, entry.key)
+			continue
+		}
+
+		text := stringify(item)
+		if html {
+			text = htmlAttrValue(name, text)
+		}
+		attrs = append(attrs, entry.key+`="`+xmlEscape(text, true)+`"`)
+	}
+
+	result = strings.Join(attrs, " ")
+	if autospace && result != "" {
+		result = " " + result
+	}
+
+	return result, true
+}
+
+// xmlAttrArgs parses the arguments of xmlattr: the optional autospace
+// flag and the value.
+func xmlAttrArgs(args []interface{}) (bool, interface{}) {
+	autospace := true
+	if len(args) > 1 {
+		autospace, _ = args[0].(bool)
+	}
+
+	return autospace, args[len(args)-1]
+}
@@ -0,0 +1,319 @@
+package gtf
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"os/exec"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// hostileInputs is a corpus of strings which break naive escaping.
+var hostileInputs = []string{
+	"",
+	"plain",
+	"it's",
+	`"double" quotes`,
+	`back\slash\`,
+	`\'`,
+	"$(rm -rf /) `id` ${HOME}",
+	"a;b|c&d>e<f",
+	"new\nline",
+	"tab\tcr\r\n",
+	"<script>alert(1)</script>",
+	"</script><!--",
+	"=cmd|' /C calc'!A0",
+	"+1+1",
+	"-2",
+	"@SUM(A1:A9)",
+	"100% & $5 #1 _x_ {a} ~ ^ |",
+	"'; DROP TABLE users; --",
+	"  ",
+	"ünïcödé 한국어",
+	"nul\x00byte",
+	"\x1b[31mred\x1b[0m",
+	"]]><![CDATA[",
+	"&amp; &#x27;",
+	"-n -e",
+	"invalid \xff utf-8",
+}
+
+func TestShellQuote(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | shellquote }}", "it's $HOME")
+	AssertEqual(t, &buffer, `'it'\''s $HOME'`)
+
+	TextTemplateParseTest(&buffer, "{{ . | shellquote }}", "")
+	AssertEqual(t, &buffer, "''")
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "printf %s {{ . | shellquote }}", input)
+		output, err := exec.Command(sh, "-c", buffer.String()).Output()
+		buffer.Reset()
+		if err != nil {
+			t.Errorf("sh failed for %q: %v", input, err)
+			continue
+		}
+		if expected := strings.Replace(input, "\x00", "", -1); string(output) != expected {
+			t.Errorf("shellquote(%q) round trip = %q", input, output)
+		}
+	}
+}
+
+var latexEscapes = strings.NewReplacer(
+	`\textasciitilde{}`, "", `\textasciicircum{}`, "", `\textbackslash{}`, "",
+	`\textless{}`, "", `\textgreater{}`, "", `\textbar{}`, "",
+	`\&`, "", `\%`, "", `\$`, "", `\#`, "", `\_`, "", `\{`, "", `\}`, "",
+)
+
+func TestLatexEscape(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | latexescape }}", `100% & $5 #1 _x_ {a} ~ ^ \ <b> |`)
+	AssertEqual(t, &buffer, `100\% \& \$5 \#1 \_x\_ \{a\} \textasciitilde{} \textasciicircum{} \textbackslash{} \textless{}b\textgreater{} \textbar{}`)
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | latexescape }}", input)
+		output := buffer.String()
+		buffer.Reset()
+
+		// Nothing special may be left once the escapes are removed.
+		if rest := latexEscapes.Replace(output); strings.ContainsAny(rest, "&%$#_{}~^\\<>|") {
+			t.Errorf("latexescape(%q) = %q", input, output)
+		}
+	}
+}
+
+func TestCSVEscape(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | csvescape }}", `say "hi", go`)
+	AssertEqual(t, &buffer, `"say ""hi"", go"`)
+
+	TextTemplateParseTest(&buffer, "{{ . | csvescape }}", "=1+2")
+	AssertEqual(t, &buffer, "'=1+2")
+
+	TextTemplateParseTest(&buffer, "{{ . | csvescape }}", "-2.5")
+	AssertEqual(t, &buffer, "-2.5")
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | csvescape }},end\n", input)
+		record, err := csv.NewReader(&buffer).Read()
+		buffer.Reset()
+		if err != nil {
+			t.Errorf("csvescape(%q) is not valid CSV: %v", input, err)
+			continue
+		}
+
+		expected := strings.Replace(input, "\r\n", "\n", -1)
+		if input != "" && strings.IndexByte(csvFormulaPrefixes, input[0]) >= 0 && input != "-2" {
+			expected = "'" + expected
+		}
+		if len(record) != 2 || record[0] != expected || record[1] != "end" {
+			t.Errorf("csvescape(%q) round trip = %q", input, record)
+		}
+	}
+}
+
+func TestXMLEscape(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | xmlescape }}", `<a href="x">Tom & 'Jerry'</a>`)
+	AssertEqual(t, &buffer, "&lt;a href=&quot;x&quot;&gt;Tom &amp; &apos;Jerry&apos;&lt;/a&gt;")
+
+	TextTemplateParseTest(&buffer, "{{ . | xmlattr }}", "a\tb\nc\x01")
+	AssertEqual(t, &buffer, "a&#9;b&#10;c\uFFFD")
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, `<e a="{{ . | xmlattr }}">{{ . | xmlescape }}</e>`, input)
+		var element struct {
+			A    string `xml:"a,attr"`
+			Text string `xml:",chardata"`
+		}
+		err := xml.Unmarshal(buffer.Bytes(), &element)
+		buffer.Reset()
+		if err != nil {
+			t.Errorf("xmlescape(%q) is not valid XML: %v", input, err)
+			continue
+		}
+
+		expected := strings.Map(func(r rune) rune {
+			if !isXMLChar(r) {
+				return utf8.RuneError
+			}
+			return r
+		}, input)
+		if element.A != expected {
+			t.Errorf("xmlattr(%q) round trip = %q", input, element.A)
+		}
+		if element.Text != strings.Replace(expected, "\r\n", "\n", -1) {
+			t.Errorf("xmlescape(%q) round trip = %q", input, element.Text)
+		}
+	}
+}
+
+func TestSQLString(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | sqlstring }}", "'; DROP TABLE users; --")
+	AssertEqual(t, &buffer, "'''; DROP TABLE users; --'")
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | sqlstring }}", input)
+		output := buffer.String()
+		buffer.Reset()
+
+		// Outside of the enclosing quotes, quotes must come in pairs.
+		inner := output[1 : len(output)-1]
+		if output[0] != '\'' || output[len(output)-1] != '\'' || strings.Replace(inner, "''", "", -1) != strings.Replace(inner, "'", "", -1) {
+			t.Errorf("sqlstring(%q) = %q", input, output)
+		}
+		if strings.ContainsRune(output, 0) {
+			t.Errorf("sqlstring(%q) = %q contains NUL", input, output)
+		}
+	}
+}
+
+func TestAddslashes(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | addslashes }}", `I'm "using" \ Django`)
+	AssertEqual(t, &buffer, `I\'m \"using\" \\ Django`)
+
+	ParseTest(&buffer, "{{ . | addslashes }}", `it's`)
+	AssertEqual(t, &buffer, `it\&#39;s`)
+}
+
+func TestEscapeJS(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | escapejs }}", "testing\r\njavascript 'string\" <b>escaping</b>")
+	AssertEqual(t, &buffer, `testing\u000D\u000Ajavascript \u0027string\u0022 \u003Cb\u003Eescaping\u003C/b\u003E`)
+
+	ParseTest(&buffer, `<script>var s = "{{ . | escapejs }}";</script>`, "</script>'")
+	AssertEqual(t, &buffer, `<script>var s = "\u003C\/script\u003E\u0027";</script>`)
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | escapejs }}", input)
+		output := buffer.String()
+		buffer.Reset()
+
+		if strings.ContainsAny(output, "'\"<>&\n\r\u2028\u2029`") {
+			t.Errorf("escapejs(%q) = %q", input, output)
+		}
+
+		// \u escapes are valid in JSON strings too.
+		var decoded string
+		if err := json.Unmarshal([]byte(`"`+output+`"`), &decoded); err != nil {
+			t.Errorf("escapejs(%q) = %q: %v", input, output, err)
+		} else if decoded != string([]rune(input)) {
+			t.Errorf("escapejs(%q) round trip = %q", input, decoded)
+		}
+	}
+}
+
+type xmlAttrTestLink struct {
+	Href     string
+	DataID   int
+	Hidden   bool
+	Disabled bool
+	Title    *string `xml:"title"`
+	Rel      string  `json:"rel,omitempty"`
+	Internal string  `json:"-"`
+}
+
+func TestXMLAttrs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	attrs := map[string]interface{}{
+		"class":   "list <main>",
+		"id":      "list-42",
+		"missing": nil,
+		"checked": true,
+		"off":     false,
+		"title":   "Tom & \"Jerry\"\n",
+	}
+
+	TextTemplateParseTest(&buffer, "<ul{{ . | xmlattr }}>", attrs)
+	AssertEqual(t, &buffer, `<ul checked class="list &lt;main&gt;" id="list-42" title="Tom &amp; &quot;Jerry&quot;&#10;">`)
+
+	ParseTest(&buffer, "<ul{{ . | xmlattr }}>", attrs)
+	AssertEqual(t, &buffer, `<ul checked class="list &lt;main&gt;" id="list-42" title="Tom &amp; &quot;Jerry&quot;&#10;">`)
+
+	ParseTest(&buffer, "<ul {{ . | xmlattr false }}>", map[string]int{"b": 2, "a": 1})
+	AssertEqual(t, &buffer, `<ul a="1" b="2">`)
+
+	ParseTest(&buffer, "<ul{{ . | xmlattr }}>", map[string]bool{"off": false})
+	AssertEqual(t, &buffer, `<ul>`)
+
+	link := xmlAttrTestLink{Href: "/docs", DataID: 7, Hidden: true, Internal: "x"}
+	ParseTest(&buffer, "<a{{ . | xmlattr }}>", &link)
+	AssertEqual(t, &buffer, `<a href="/docs" data-id="7" hidden>`)
+
+	ParseTest(&buffer, "<a{{ . | xmlattr }}>", map[string]string{"href": " javascript:alert(1)", "src": "https://go.dev/x.png"})
+	AssertEqual(t, &buffer, `<a href="#ZgotmplZ" src="https://go.dev/x.png">`)
+
+	TextTemplateParseTest(&buffer, "<a{{ . | xmlattr }}>", map[string]string{"href": "javascript:alert(1)"})
+	AssertEqual(t, &buffer, `<a href="javascript:alert(1)">`)
+
+	ParseTest(&buffer, "<a{{ . | xmlattr }}>", map[string]string{"onclick": "alert(1)"})
+	AssertEqual(t, &buffer, `<a>`)
+
+	hostile := map[string]string{
+		`{"data": "javascript:alert(1)"}`:                           `<object data="#ZgotmplZ">`,
+		`{"data": "/movie.swf"}`:                                    `<object data="/movie.swf">`,
+		`{"srcset": "javascript:alert(1) 1x"}`:                      `<object srcset="#ZgotmplZ">`,
+		`{"srcset": "/a.png 1x, javascript:alert(1) 2x"}`:           `<object srcset="#ZgotmplZ">`,
+		`{"srcset": "/a.png 1x, https://go.dev/b.png 2x"}`:          `<object srcset="/a.png 1x, https://go.dev/b.png 2x">`,
+		`{"ping": "/log javascript:alert(1)"}`:                      `<object ping="#ZgotmplZ">`,
+		`{"archive": "a.jar,javascript:alert(1)"}`:                  `<object archive="#ZgotmplZ">`,
+		`{"codebase": "vbscript:x", "classid": "javascript:x"}`:     `<object classid="#ZgotmplZ" codebase="#ZgotmplZ">`,
+		`{"profile": "javascript:x", "formaction": "javascript:x"}`: `<object formaction="#ZgotmplZ" profile="#ZgotmplZ">`,
+		`{"data-url": "javascript:x", "svg:href": "javascript:x"}`:  `<object data-url="#ZgotmplZ" svg:href="#ZgotmplZ">`,
+		`{"xmlns:x": "javascript:x", "imgsrc": "javascript:x"}`:     `<object imgsrc="#ZgotmplZ" xmlns:x="#ZgotmplZ">`,
+		`{"style": "x:expression(alert(1))"}`:                       `<object style="ZgotmplZ">`,
+		`{"style": "background: url(javascript:alert(1))"}`:         `<object style="ZgotmplZ">`,
+		`{"style": "width: e\\78pression"}`:                         `<object style="ZgotmplZ">`,
+		`{"style": "-moz-binding: x"}`:                              `<object style="ZgotmplZ">`,
+		`{"style": "color: red; margin: 0 auto"}`:                   `<object style="color: red; margin: 0 auto">`,
+		`{"srcdoc": "<script>alert(1)</script>"}`:                   `<object>`,
+		`{"data-onclick": "alert(1)"}`:                              `<object>`,
+	}
+	for input, expected := range hostile {
+		var attrs map[string]string
+		if err := json.Unmarshal([]byte(input), &attrs); err != nil {
+			t.Fatal(err)
+		}
+		ParseTest(&buffer, "<object{{ . | xmlattr }}>", attrs)
+		AssertEqual(t, &buffer, expected)
+	}
+
+	for _, input := range hostileInputs {
+		for _, name := range []string{"href", "data", "srcset", "ping", "archive", "data-src"} {
+			ParseTest(&buffer, "<a{{ . | xmlattr }}>", map[string]string{name: "JavaScript:" + input})
+			AssertEqual(t, &buffer, `<a `+name+`="#ZgotmplZ">`)
+		}
+		ParseTest(&buffer, "<a{{ . | xmlattr }}>", map[string]string{"style": "x:expression(" + input + ")"})
+		AssertEqual(t, &buffer, `<a style="ZgotmplZ">`)
+	}
+
+	for _, key := range []string{"a b", "a>", "a=b", `a"`, "a/", ""} {
+		TextTemplateParseTest(&buffer, "<a{{ . | xmlattr }}>", map[string]string{key: "x"})
+		AssertEqual(t, &buffer, `<a>`)
+	}
+
+	ParseTest(&buffer, `<p title="{{ . | xmlattr }}">`, "a&b")
+	AssertEqual(t, &buffer, `<p title="a&amp;b">`)
+
+	ParseTest(&buffer, "<a{{ . | xmlattr }}>", 42)
+	AssertEqual(t, &buffer, `<a>`)
+}
@@ -0,0 +1,322 @@
+//go:build ignore
+// +build ignore
+
+// This program generates idna_tables.go, the Unicode data used by the
+// IDNA functions, from the UTS #46 IDNA mapping table and the Unicode
+// Character Database. Run it with
+//
+//	go run gen_idna.go [-version 15.1.0] [-data dir]
+//
+// The data files are downloaded from unicode.org, unless -data names a
+// directory containing IdnaMappingTable.txt, UnicodeData.txt,
+// CompositionExclusions.txt and DerivedJoiningType.txt.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	version = flag.String("version", "15.1.0", "Unicode version of the data files")
+	data    = flag.String("data", "", "directory containing the data files, instead of downloading them")
+	output  = flag.String("output", "idna_tables.go", "output file")
+)
+
+// paths are the locations of the data files below https://www.unicode.org/Public/.
+var paths = map[string]string{
+	"IdnaMappingTable.txt":      "idna/%s/IdnaMappingTable.txt",
+	"UnicodeData.txt":           "%s/ucd/UnicodeData.txt",
+	"CompositionExclusions.txt": "%s/ucd/CompositionExclusions.txt",
+	"DerivedJoiningType.txt":    "%s/ucd/extracted/DerivedJoiningType.txt",
+}
+
+// bidiClasses are the names of the Bidi_Class constants in idna.go. Other
+// classes than L, which is the default, are bidiOther.
+var bidiClasses = map[string]string{
+	"R": "bidiR", "AL": "bidiAL", "AN": "bidiAN", "EN": "bidiEN",
+	"ES": "bidiES", "CS": "bidiCS", "ET": "bidiET", "ON": "bidiON",
+	"BN": "bidiBN", "NSM": "bidiNSM",
+}
+
+// open returns the content of a data file.
+func open(name string) io.ReadCloser {
+	if *data != "" {
+		f, err := os.Open(filepath.Join(*data, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+
+	url := "https://www.unicode.org/Public/" + fmt.Sprintf(paths[name], *version)
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s: %s", url, resp.Status)
+	}
+
+	return resp.Body
+}
+
+// parse calls fn with the fields of every data line of a file.
+func parse(name string, fn func(fields []string)) {
+	f := open(name)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return rune(n)
+}
+
+// parseRange parses a code point or a range like "0041..005A".
+func parseRange(s string) (rune, rune) {
+	if i := strings.Index(s, ".."); i >= 0 {
+		return parseRune(s[:i]), parseRune(s[i+2:])
+	}
+	r := parseRune(s)
+
+	return r, r
+}
+
+func parseRunes(s string) []rune {
+	var runes []rune
+	for _, field := range strings.Fields(s) {
+		runes = append(runes, parseRune(field))
+	}
+
+	return runes
+}
+
+// idnaEntry is the processed UTS #46 status of a code point.
+type idnaEntry struct {
+	status  string
+	mapping []rune
+}
+
+// readIDNA reads the mapping table for nontransitional processing with
+// the STD3 ASCII rules.
+func readIDNA() []idnaEntry {
+	entries := make([]idnaEntry, 0x110000)
+	for i := range entries {
+		entries[i].status = "idnaDisallowed"
+	}
+
+	parse("IdnaMappingTable.txt", func(fields []string) {
+		lo, hi := parseRange(fields[0])
+
+		var entry idnaEntry
+		switch fields[1] {
+		case "valid":
+			entry.status = "idnaValid"
+			if len(fields) > 3 && (fields[3] == "NV8" || fields[3] == "XV8") {
+				entry.status = "idnaValidNV8"
+			}
+		case "deviation":
+			entry.status = "idnaValid"
+		case "mapped":
+			entry.status = "idnaMapped"
+			entry.mapping = parseRunes(fields[2])
+		case "ignored":
+			entry.status = "idnaIgnored"
+		case "disallowed", "disallowed_STD3_valid", "disallowed_STD3_mapped":
+			entry.status = "idnaDisallowed"
+		default:
+			log.Fatalf("unknown IDNA status %q", fields[1])
+		}
+
+		for r := lo; r <= hi; r++ {
+			entries[r] = entry
+		}
+	})
+
+	return entries
+}
+
+// writeIDNATable writes the ranges of code points with the same status.
+// Consecutive code points mapped to a single code point at the same
+// distance share a range.
+func writeIDNATable(w io.Writer, entries []idnaEntry) int {
+	fmt.Fprintln(w, "var idnaTable = []idnaRange{")
+
+	count := 0
+	var last string
+	for r, entry := range entries {
+		var row string
+		switch {
+		case entry.status == "idnaMapped" && len(entry.mapping) == 1:
+			row = fmt.Sprintf("%s, %d, \"\"", entry.status, entry.mapping[0]-rune(r))
+		case entry.status == "idnaMapped":
+			row = fmt.Sprintf("%s, 0, %+q", entry.status, string(entry.mapping))
+		default:
+			row = fmt.Sprintf("%s, 0, \"\"", entry.status)
+		}
+		if row == last {
+			continue
+		}
+		last = row
+
+		fmt.Fprintf(w, "\t{0x%04X, %s},\n", r, row)
+		count++
+	}
+	fmt.Fprintln(w, "}")
+
+	return count
+}
+
+// writeProperty writes the ranges of consecutive code points with the
+// same value. Code points without a value are left out.
+func writeProperty(w io.Writer, name string, values []string) int {
+	fmt.Fprintf(w, "var %s = []propertyRange{\n", name)
+
+	count := 0
+	for lo := 0; lo < len(values); {
+		hi := lo
+		for hi+1 < len(values) && values[hi+1] == values[lo] {
+			hi++
+		}
+		if values[lo] != "" {
+			fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %s},\n", lo, hi, values[lo])
+			count++
+		}
+		lo = hi + 1
+	}
+	fmt.Fprintln(w, "}")
+
+	return count
+}
+
+func main() {
+	flag.Parse()
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by gen_idna.go from Unicode %s. DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&buffer, "package gtf\n\n")
+	fmt.Fprintf(&buffer, "// idnaUnicodeVersion is the version of the Unicode data in the tables.\n")
+	fmt.Fprintf(&buffer, "const idnaUnicodeVersion = %q\n\n", *version)
+
+	fmt.Fprintf(&buffer, "// idnaTable lists the UTS #46 status of every code point, for\n")
+	fmt.Fprintf(&buffer, "// nontransitional processing with the STD3 ASCII rules.\n")
+	idnaCount := writeIDNATable(&buffer, readIDNA())
+
+	ccc := make([]string, 0x110000)
+	bidi := make([]string, 0x110000)
+	decompositions := map[rune][]rune{}
+	var first rune = -1
+	parse("UnicodeData.txt", func(fields []string) {
+		r := parseRune(fields[0])
+		lo := r
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = r
+			return
+		case strings.HasSuffix(fields[1], ", Last>"):
+			lo = first
+		}
+
+		for c := lo; c <= r; c++ {
+			if fields[3] != "0" {
+				ccc[c] = fields[3]
+			}
+			if fields[4] != "L" {
+				bidi[c] = "bidiOther"
+				if name, ok := bidiClasses[fields[4]]; ok {
+					bidi[c] = name
+				}
+			}
+		}
+		if fields[5] != "" && !strings.HasPrefix(fields[5], "<") {
+			decompositions[r] = parseRunes(fields[5])
+		}
+	})
+
+	fmt.Fprintf(&buffer, "\n// cccTable lists the code points with a non-zero Canonical_Combining_Class.\n")
+	cccCount := writeProperty(&buffer, "cccTable", ccc)
+
+	var runes []int
+	for r := range decompositions {
+		runes = append(runes, int(r))
+	}
+	sort.Ints(runes)
+	fmt.Fprintf(&buffer, "\n// nfcDecompositions are the canonical decompositions of the Unicode\n")
+	fmt.Fprintf(&buffer, "// Character Database. The second code point is 0 for singletons.\n")
+	fmt.Fprintln(&buffer, "var nfcDecompositions = map[rune][2]rune{")
+	for _, r := range runes {
+		d := decompositions[rune(r)]
+		if len(d) == 1 {
+			d = append(d, 0)
+		}
+		fmt.Fprintf(&buffer, "\t0x%04X: {0x%04X, 0x%04X},\n", r, d[0], d[1])
+	}
+	fmt.Fprintln(&buffer, "}")
+
+	fmt.Fprintf(&buffer, "\n// nfcExclusions are the script specific and post composition version\n")
+	fmt.Fprintf(&buffer, "// exclusions, which are not composed by NFC.\n")
+	fmt.Fprintln(&buffer, "var nfcExclusions = []rune{")
+	parse("CompositionExclusions.txt", func(fields []string) {
+		fmt.Fprintf(&buffer, "\t0x%s,\n", fields[0])
+	})
+	fmt.Fprintln(&buffer, "}")
+
+	fmt.Fprintf(&buffer, "\n// bidiTable lists the Bidi_Class of the code points which are not L.\n")
+	bidiCount := writeProperty(&buffer, "bidiTable", bidi)
+
+	joining := make([]string, 0x110000)
+	parse("DerivedJoiningType.txt", func(fields []string) {
+		lo, hi := parseRange(fields[0])
+		for r := lo; r <= hi; r++ {
+			joining[r] = strconv.QuoteRune(rune(fields[1][0]))
+		}
+	})
+	fmt.Fprintf(&buffer, "\n// joiningTable lists the Joining_Type of the code points which are not U.\n")
+	joiningCount := writeProperty(&buffer, "joiningTable", joining)
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%d IDNA ranges, %d combining class ranges, %d decompositions, %d bidi ranges, %d joining ranges",
+		idnaCount, cccCount, len(runes), bidiCount, joiningCount)
+}
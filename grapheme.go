@@ -0,0 +1,117 @@
+package gtf
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const zeroWidthJoiner = '\u200d'
+
+// Hangul_Syllable_Type values used by the grapheme cluster rules.
+const (
+	hangulNone = iota
+	hangulL
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+// hangulType classifies r according to the Hangul_Syllable_Type property.
+func hangulType(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return hangulV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return hangulT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+
+	return hangulNone
+}
+
+// isGraphemeExtend reports whether r never starts a new grapheme cluster.
+func isGraphemeExtend(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner:
+		return true
+	case r >= 0xfe00 && r <= 0xfe0f: // variation selectors
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff: // emoji skin tone modifiers
+		return true
+	case r >= 0xe0020 && r <= 0xe007f: // tag characters
+		return true
+	}
+
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// continuesGrapheme reports whether r belongs to the grapheme cluster
+// ending with prev. riCount is the number of consecutive regional
+// indicators ending with prev.
+func continuesGrapheme(prev, r rune, riCount int) bool {
+	switch {
+	case prev == '\r':
+		return r == '\n'
+	case prev == '\n' || r == '\r' || r == '\n':
+		return false
+	case isGraphemeExtend(r):
+		return true
+	case prev == zeroWidthJoiner:
+		return true
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		return riCount%2 == 1
+	}
+
+	switch hangulType(prev) {
+	case hangulL:
+		t := hangulType(r)
+		return t == hangulL || t == hangulV || t == hangulLV || t == hangulLVT
+	case hangulV, hangulLV:
+		t := hangulType(r)
+		return t == hangulV || t == hangulT
+	case hangulT, hangulLVT:
+		return hangulType(r) == hangulT
+	}
+
+	return false
+}
+
+// graphemes splits s into user-perceived characters, keeping combining
+// marks, emoji sequences, flags and decomposed Hangul syllables together.
+func graphemes(s string) []string {
+	var clusters []string
+
+	start := 0
+	prev := utf8.RuneError
+	riCount := 0
+	for i, r := range s {
+		if i > 0 && !continuesGrapheme(prev, r, riCount) {
+			clusters = append(clusters, s[start:i])
+			start = i
+		}
+
+		if isRegionalIndicator(r) {
+			riCount++
+		} else {
+			riCount = 0
+		}
+		prev = r
+	}
+
+	if start < len(s) {
+		clusters = append(clusters, s[start:])
+	}
+
+	return clusters
+}
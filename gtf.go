@@ -1,6 +1,9 @@
 package gtf
 
 import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	htmlTemplate "html/template"
 	"math"
@@ -31,29 +34,48 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 
 		return strings.Replace(s3, s1, s2, -1)
 	},
-	"title": func(s string) string {
+	"title": func(args ...string) string {
 		defer recovery()
-		return strings.Title(s)
+
+		return titleCase(langArgs(args))
 	},
 	"default": func(arg interface{}, value interface{}) interface{} {
 		defer recovery()
 
-		v := reflect.ValueOf(value)
-		switch v.Kind() {
-		case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
-			if v.Len() == 0 {
-				return arg
-			}
-		case reflect.Bool:
-			if !v.Bool() {
-				return arg
-			}
-		default:
-			return value
+		if isEmpty(reflect.ValueOf(value)) {
+			return arg
 		}
 
 		return value
 	},
+	"default_if_none": func(arg interface{}, value interface{}) interface{} {
+		defer recovery()
+
+		if isNil(reflect.ValueOf(value)) {
+			return arg
+		}
+
+		return value
+	},
+	"coalesce": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return coalesce(args...)
+	},
+	"firstof": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return coalesce(args...)
+	},
+	"ternary": func(yes interface{}, no interface{}, value interface{}) interface{} {
+		defer recovery()
+
+		if isEmpty(reflect.ValueOf(value)) {
+			return no
+		}
+
+		return yes
+	},
 	"length": func(value interface{}) int {
 		defer recovery()
 
@@ -62,7 +84,7 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 		case reflect.Slice, reflect.Array, reflect.Map:
 			return v.Len()
 		case reflect.String:
-			return len([]rune(v.String()))
+			return textLength(v.String())
 		}
 
 		return 0
@@ -144,7 +166,7 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 		case reflect.Slice, reflect.Array, reflect.Map:
 			return v.Len() == arg
 		case reflect.String:
-			return len([]rune(v.String())) == arg
+			return textLength(v.String()) == arg
 		}
 
 		return false
@@ -154,10 +176,10 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 
 		return strings.TrimSpace(s)
 	},
-	"capfirst": func(s string) string {
+	"capfirst": func(args ...string) string {
 		defer recovery()
 
-		return strings.ToUpper(string(s[0])) + s[1:]
+		return capFirst(langArgs(args))
 	},
 	"pluralize": func(arg string, value interface{}) string {
 		defer recovery()
@@ -201,7 +223,7 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 	"rjust": func(arg int, value string) string {
 		defer recovery()
 
-		n := arg - len([]rune(value))
+		n := arg - textLength(value)
 
 		if n > 0 {
 			value = strings.Repeat(" ", n) + value
@@ -212,7 +234,7 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 	"ljust": func(arg int, value string) string {
 		defer recovery()
 
-		n := arg - len([]rune(value))
+		n := arg - textLength(value)
 
 		if n > 0 {
 			value = value + strings.Repeat(" ", n)
@@ -223,7 +245,7 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 	"center": func(arg int, value string) string {
 		defer recovery()
 
-		n := arg - len([]rune(value))
+		n := arg - textLength(value)
 
 		if n > 0 {
 			left := n / 2
@@ -387,14 +409,29 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 
 		return ""
 	},
-	"join": func(arg string, value []string) string {
+	"join": func(arg string, args ...interface{}) string {
 		defer recovery()
 
-		return strings.Join(value, arg)
+		return join(arg, args...)
 	},
-	"slice": func(start int, end int, value interface{}) interface{} {
+	"joinnatural": func(args ...interface{}) string {
 		defer recovery()
 
+		return joinNatural(args...)
+	},
+	"slice": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		value := args[len(args)-1]
+
+		if len(args) == 2 {
+			n, _ := toInt(args[0])
+			return sliceColumns(n, value)
+		}
+
+		start, _ := toInt(args[0])
+		end, _ := toInt(args[1])
+
 		v := reflect.ValueOf(value)
 
 		if start < 0 {
@@ -441,9 +478,717 @@ var GtfTextFuncMap = textTemplate.FuncMap{
 	"striptags": func(s string) string {
 		return strings.TrimSpace(striptagsRegexp.ReplaceAllString(s, ""))
 	},
+	"unique": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return unique(args...)
+	},
+	"reverse": func(value interface{}) interface{} {
+		defer recovery()
+
+		return reverse(value)
+	},
+	"flatten": func(value interface{}) interface{} {
+		defer recovery()
+
+		return flatten(value)
+	},
+	"batch": func(n int, args ...interface{}) interface{} {
+		defer recovery()
+
+		return batch(n, args...)
+	},
+	"chunk": func(n int, value interface{}) interface{} {
+		defer recovery()
+
+		return batch(n, value)
+	},
+	"zip": func(lists ...interface{}) interface{} {
+		defer recovery()
+
+		return zip(lists...)
+	},
+	"compact": func(value interface{}) interface{} {
+		defer recovery()
+
+		return compact(value)
+	},
+	"without": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return without(args...)
+	},
+	"attr": func(name string, args ...interface{}) interface{} {
+		defer recovery()
+
+		return lookup([]string{name}, args)
+	},
+	"get": func(path interface{}, args ...interface{}) interface{} {
+		defer recovery()
+
+		segments, ok := pathSegments(path)
+		if !ok {
+			return ""
+		}
+
+		return lookup(segments, args)
+	},
+	"dict": func(pairs ...interface{}) map[string]interface{} {
+		defer recovery()
+
+		return dict(pairs...)
+	},
+	"list": func(items ...interface{}) []interface{} {
+		defer recovery()
+
+		return append([]interface{}{}, items...)
+	},
+	"set": func(key interface{}, value interface{}, m interface{}) interface{} {
+		defer recovery()
+
+		return setKey(key, value, m)
+	},
+	"unset": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return filterKeys(false, args)
+	},
+	"merge": func(maps ...interface{}) interface{} {
+		defer recovery()
+
+		return mergeMaps(false, maps...)
+	},
+	"deepmerge": func(maps ...interface{}) interface{} {
+		defer recovery()
+
+		return mergeMaps(true, maps...)
+	},
+	"keys": func(value interface{}) interface{} {
+		defer recovery()
+
+		return keys(value)
+	},
+	"values": func(value interface{}) interface{} {
+		defer recovery()
+
+		return values(value)
+	},
+	"pick": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return filterKeys(true, args)
+	},
+	"omit": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		return filterKeys(false, args)
+	},
+	"haskey": func(key interface{}, value interface{}) bool {
+		defer recovery()
+
+		return hasKey(key, value)
+	},
+	"tojson": func(value interface{}) string {
+		defer recovery()
+
+		result, err := toJSON(value, "", false)
+		if err != nil {
+			return ""
+		}
+
+		return result
+	},
+	"toprettyjson": func(value interface{}) string {
+		defer recovery()
+
+		result, err := toJSON(value, "  ", false)
+		if err != nil {
+			return ""
+		}
+
+		return result
+	},
+	"json_script": func(id string, value interface{}) string {
+		defer recovery()
+
+		result, err := jsonScript(id, value)
+		if err != nil {
+			return ""
+		}
+
+		return result
+	},
+	"fromjson": func(s string) interface{} {
+		defer recovery()
+
+		return fromJSON(s)
+	},
+	"toyaml": func(value interface{}) string {
+		defer recovery()
+
+		return toYAML(value)
+	},
+	"fromyaml": func(s string) interface{} {
+		defer recovery()
+
+		result, err := fromYAML(s)
+		if err != nil {
+			return ""
+		}
+
+		return result
+	},
+	"totoml": func(value interface{}) string {
+		defer recovery()
+
+		result, err := toTOML(value)
+		if err != nil {
+			return ""
+		}
+
+		return result
+	},
+	"tocsv": func(value interface{}) string {
+		defer recovery()
+
+		return toCSV(value)
+	},
+	"csvrow": func(value interface{}) string {
+		defer recovery()
+
+		return csvRow(value)
+	},
+	"b64enc":       encodeWith(base64.StdEncoding.EncodeToString),
+	"b64dec":       decodeWith(base64.StdEncoding.DecodeString),
+	"b64urlenc":    encodeWith(base64.URLEncoding.EncodeToString),
+	"b64urldec":    decodeWith(base64.URLEncoding.DecodeString),
+	"b64rawenc":    encodeWith(base64.RawStdEncoding.EncodeToString),
+	"b64rawdec":    decodeWith(base64.RawStdEncoding.DecodeString),
+	"b64rawurlenc": encodeWith(base64.RawURLEncoding.EncodeToString),
+	"b64rawurldec": decodeWith(base64.RawURLEncoding.DecodeString),
+	"b32enc":       encodeWith(base32.StdEncoding.EncodeToString),
+	"b32dec":       decodeWith(base32.StdEncoding.DecodeString),
+	"b32rawenc":    encodeWith(rawBase32Encoding.EncodeToString),
+	"b32rawdec":    decodeWith(rawBase32Encoding.DecodeString),
+	"hexenc":       encodeWith(hex.EncodeToString),
+	"hexdec":       decodeWith(hex.DecodeString),
+	"datauri": func(args ...interface{}) string {
+		defer recovery()
+
+		return dataURIArgs(args)
+	},
+	"qpencode": encodeWith(qpEncode),
+	"qpdecode": decodeWith(qpDecode),
+	"mimeheader": func(args ...interface{}) string {
+		defer recovery()
+
+		return mimeHeaderArgs(args)
+	},
+	"mimeaddress": func(name string, address string) string {
+		defer recovery()
+
+		return mimeAddress(name, address)
+	},
+	"emailobfuscate": func(s string) string {
+		defer recovery()
+
+		return emailObfuscate(s)
+	},
+	"regexmatch": func(pattern string, s string) bool {
+		defer recovery()
+
+		re, err := regexpCache.compile(pattern)
+		if err != nil {
+			return false
+		}
+
+		return re.MatchString(s)
+	},
+	"regexfind": func(pattern string, s string) string {
+		defer recovery()
+
+		re, err := regexpCache.compile(pattern)
+		if err != nil {
+			return ""
+		}
+
+		return re.FindString(s)
+	},
+	"regexfindall": func(pattern string, args ...interface{}) []string {
+		defer recovery()
+
+		re, err := regexpCache.compile(pattern)
+		if err != nil {
+			return nil
+		}
+
+		n, s := limitArgs(args)
+
+		return re.FindAllString(s, n)
+	},
+	"regexreplace": func(pattern string, repl string, s string) string {
+		defer recovery()
+
+		re, err := regexpCache.compile(pattern)
+		if err != nil {
+			return ""
+		}
+
+		return re.ReplaceAllString(s, repl)
+	},
+	"regexsplit": func(pattern string, args ...interface{}) []string {
+		defer recovery()
+
+		re, err := regexpCache.compile(pattern)
+		if err != nil {
+			return nil
+		}
+
+		n, s := limitArgs(args)
+
+		return re.Split(s, n)
+	},
+	"regexquote": func(s string) string {
+		defer recovery()
+
+		return regexp.QuoteMeta(s)
+	},
+	"camelcase": func(s string) string {
+		defer recovery()
+
+		return camelCase(s)
+	},
+	"pascalcase": func(s string) string {
+		defer recovery()
+
+		return pascalCase(s)
+	},
+	"snakecase": func(s string) string {
+		defer recovery()
+
+		return joinWords(s, "_", strings.ToLower)
+	},
+	"kebabcase": func(s string) string {
+		defer recovery()
+
+		return joinWords(s, "-", strings.ToLower)
+	},
+	"screamingsnake": func(s string) string {
+		defer recovery()
+
+		return joinWords(s, "_", strings.ToUpper)
+	},
+	"dotcase": func(s string) string {
+		defer recovery()
+
+		return joinWords(s, ".", strings.ToLower)
+	},
+	"traincase": func(s string) string {
+		defer recovery()
+
+		return joinWords(s, "-", capitalize)
+	},
+	"pluralword": func(args ...interface{}) string {
+		defer recovery()
+
+		word := args[len(args)-1].(string)
+		if len(args) > 1 && isOne(args[0]) {
+			return word
+		}
+
+		return pluralWord(word)
+	},
+	"singularize": func(s string) string {
+		defer recovery()
+
+		return singularize(s)
+	},
+	"article": func(s string) string {
+		defer recovery()
+
+		return article(s)
+	},
+	"countnoun": func(word string, count interface{}) string {
+		defer recovery()
+
+		return countNoun(word, count)
+	},
+	"indent": func(args ...interface{}) string {
+		defer recovery()
+
+		prefix, first, blank := indentArgs(args[:len(args)-1])
+
+		return indent(args[len(args)-1].(string), prefix, first, blank)
+	},
+	"nindent": func(arg interface{}, value string) string {
+		defer recovery()
+
+		return "\n" + indent(value, indentArg(arg), true, true)
+	},
+	"dedent": func(s string) string {
+		defer recovery()
+
+		return dedent(s)
+	},
+	"wordwrap": func(width int, value string) string {
+		defer recovery()
+
+		if width <= 0 {
+			return value
+		}
+
+		return wordWrap(width, value)
+	},
+	"wrap": func(args ...interface{}) string {
+		defer recovery()
+
+		if len(args) < 2 {
+			return ""
+		}
+		value := args[len(args)-1].(string)
+
+		width, ok := toInt(args[0])
+		if !ok || width <= 0 {
+			return value
+		}
+
+		hang := ""
+		if len(args) > 2 {
+			hang = indentArg(args[1])
+		}
+
+		return wrap(width, hang, value)
+	},
+	"linebreaks": func(s string) string {
+		defer recovery()
+
+		return linebreaks(s, false)
+	},
+	"linebreaksbr": func(s string) string {
+		defer recovery()
+
+		return linebreaksBR(s, false)
+	},
+	"linenumbers": func(s string) string {
+		defer recovery()
+
+		return lineNumbers(s, false)
+	},
+	"urlize": func(args ...interface{}) string {
+		defer recovery()
+
+		options, s := urlizeArgs(args)
+
+		return urlize(s, options)
+	},
+	"urlizetrunc": func(limit int, args ...interface{}) string {
+		defer recovery()
+
+		options, s := urlizeArgs(args)
+		options.limit = limit
+
+		return urlize(s, options)
+	},
+	"urlpathescape": func(s string) string {
+		defer recovery()
+
+		return url.PathEscape(s)
+	},
+	"iriencode": func(s string) string {
+		defer recovery()
+
+		return iriEncode(s)
+	},
+	"urlparse": func(s string) interface{} {
+		defer recovery()
+
+		return urlParse(s)
+	},
+	"urljoin": func(ref string, base string) string {
+		defer recovery()
+
+		return urlJoin(ref, base)
+	},
+	"urlsetquery": func(args ...interface{}) string {
+		defer recovery()
+
+		return setURLQuery(args)
+	},
+	"urldecode": func(s string) string {
+		defer recovery()
+
+		return urlDecode(s)
+	},
+	"idnatoascii": func(s string) string {
+		defer recovery()
+
+		result, _ := idnaToASCII(s)
+
+		return result
+	},
+	"idnatounicode": func(s string) string {
+		defer recovery()
+
+		return idnaToUnicode(s)
+	},
+	"shellquote": func(s string) string {
+		defer recovery()
+
+		return shellQuote(s)
+	},
+	"latexescape": func(s string) string {
+		defer recovery()
+
+		return latexReplacer.Replace(s)
+	},
+	"csvescape": func(s string) string {
+		defer recovery()
+
+		return csvEscape(s)
+	},
+	"xmlescape": func(s string) string {
+		defer recovery()
+
+		return xmlEscape(s, false)
+	},
+	"xmlattr": func(args ...interface{}) string {
+		defer recovery()
+
+		autospace, value := xmlAttrArgs(args)
+		if s, ok := value.(string); ok {
+			return xmlEscape(s, true)
+		}
+
+		result, _ := xmlAttrs(value, autospace, false)
+
+		return result
+	},
+	"sqlstring": func(s string) string {
+		defer recovery()
+
+		return sqlString(s)
+	},
+	"addslashes": func(s string) string {
+		defer recovery()
+
+		return addslashesReplacer.Replace(s)
+	},
+	"escapejs": func(s string) string {
+		defer recovery()
+
+		return escapeJS(s)
+	},
+	"markdown": func(args ...interface{}) string {
+		defer recovery()
+
+		ext, s := markdownArgs(args)
+
+		return markdown(s, ext)
+	},
+	"markdowntext": func(args ...interface{}) string {
+		defer recovery()
+
+		ext, s := markdownArgs(args)
+
+		return markdownText(s, ext)
+	},
+	"htmltotext": func(args ...interface{}) string {
+		defer recovery()
+
+		width, s := htmlToTextArgs(args)
+
+		return htmlToText(s, width)
+	},
+	"color": func(color interface{}, value interface{}) string {
+		defer recovery()
+
+		return colorize(color, false, toText(value))
+	},
+	"bgcolor": func(color interface{}, value interface{}) string {
+		defer recovery()
+
+		return colorize(color, true, toText(value))
+	},
+	"bold": func(value interface{}) string {
+		defer recovery()
+
+		return sgr("1", "22", toText(value))
+	},
+	"dim": func(value interface{}) string {
+		defer recovery()
+
+		return sgr("2", "22", toText(value))
+	},
+	"italic": func(value interface{}) string {
+		defer recovery()
+
+		return sgr("3", "23", toText(value))
+	},
+	"underline": func(value interface{}) string {
+		defer recovery()
+
+		return sgr("4", "24", toText(value))
+	},
+	"strikethrough": func(value interface{}) string {
+		defer recovery()
+
+		return sgr("9", "29", toText(value))
+	},
+	"stripansi": func(s string) string {
+		defer recovery()
+
+		return stripANSI(s)
+	},
 }
 
-var GtfFuncMap = htmlTemplate.FuncMap(GtfTextFuncMap)
+// gtfHtmlFuncMap holds the html/template versions of gtf functions.
+// They return html/template's typed strings (template.JS, template.HTML, ...)
+// and replace the functions of the same name in GtfFuncMap.
+var gtfHtmlFuncMap = htmlTemplate.FuncMap{
+	"tojson": func(value interface{}) htmlTemplate.JS {
+		defer recovery()
+
+		result, err := toJSON(value, "", true)
+		if err != nil {
+			return ""
+		}
+
+		return htmlTemplate.JS(result)
+	},
+	"toprettyjson": func(value interface{}) htmlTemplate.JS {
+		defer recovery()
+
+		result, err := toJSON(value, "  ", true)
+		if err != nil {
+			return ""
+		}
+
+		return htmlTemplate.JS(result)
+	},
+	"json_script": func(id string, value interface{}) htmlTemplate.HTML {
+		defer recovery()
+
+		result, err := jsonScript(id, value)
+		if err != nil {
+			return ""
+		}
+
+		return htmlTemplate.HTML(result)
+	},
+	"datauri": func(args ...interface{}) htmlTemplate.URL {
+		defer recovery()
+
+		uri := dataURIArgs(args)
+		if !isPassiveDataURI(uri) {
+			return "#ZgotmplZ"
+		}
+
+		return htmlTemplate.URL(uri)
+	},
+	"linebreaks": func(s string) htmlTemplate.HTML {
+		defer recovery()
+
+		return htmlTemplate.HTML(linebreaks(s, true))
+	},
+	"linebreaksbr": func(s string) htmlTemplate.HTML {
+		defer recovery()
+
+		return htmlTemplate.HTML(linebreaksBR(s, true))
+	},
+	"linenumbers": func(s string) htmlTemplate.HTML {
+		defer recovery()
+
+		return htmlTemplate.HTML(lineNumbers(s, true))
+	},
+	"urlize": func(args ...interface{}) htmlTemplate.HTML {
+		defer recovery()
+
+		options, s := urlizeArgs(args)
+		options.escape = true
+
+		return htmlTemplate.HTML(urlize(s, options))
+	},
+	"urlizetrunc": func(limit int, args ...interface{}) htmlTemplate.HTML {
+		defer recovery()
+
+		options, s := urlizeArgs(args)
+		options.limit = limit
+		options.escape = true
+
+		return htmlTemplate.HTML(urlize(s, options))
+	},
+	"iriencode": func(s string) htmlTemplate.URL {
+		defer recovery()
+
+		return trustedURL(iriEncode(s))
+	},
+	"urljoin": func(ref string, base string) htmlTemplate.URL {
+		defer recovery()
+
+		return trustedURL(urlJoin(ref, base))
+	},
+	"urlsetquery": func(args ...interface{}) htmlTemplate.URL {
+		defer recovery()
+
+		return trustedURL(setURLQuery(args))
+	},
+	"escapejs": func(s string) htmlTemplate.JSStr {
+		defer recovery()
+
+		return htmlTemplate.JSStr(escapeJS(s))
+	},
+	"xmlattr": func(args ...interface{}) interface{} {
+		defer recovery()
+
+		// Strings are left to html/template, which escapes them for the
+		// context they are used in.
+		autospace, value := xmlAttrArgs(args)
+		if s, ok := value.(string); ok {
+			return s
+		}
+
+		result, _ := xmlAttrs(value, autospace, true)
+
+		return htmlTemplate.HTMLAttr(result)
+	},
+	"emailobfuscate": func(s string) htmlTemplate.HTML {
+		defer recovery()
+
+		return htmlTemplate.HTML(emailObfuscate(s))
+	},
+	"markdown": func(args ...interface{}) htmlTemplate.HTML {
+		defer recovery()
+
+		ext, s := markdownArgs(args)
+
+		return htmlTemplate.HTML(markdown(s, ext))
+	},
+}
+
+var GtfFuncMap = newGtfFuncMap()
+
+// newGtfFuncMap builds the html/template function map from the text
+// functions and their html/template versions.
+func newGtfFuncMap() htmlTemplate.FuncMap {
+	funcs := htmlTemplate.FuncMap{}
+	for k, v := range GtfTextFuncMap {
+		funcs[k] = v
+	}
+	for k, v := range gtfHtmlFuncMap {
+		funcs[k] = v
+	}
+
+	return funcs
+}
+
+// registerFuncs adds an optional category of functions to GtfTextFuncMap
+// and GtfFuncMap.
+func registerFuncs(funcs map[string]interface{}) {
+	for k, v := range funcs {
+		GtfTextFuncMap[k] = v
+		GtfFuncMap[k] = v
+	}
+}
 
 // gtf.New is a wrapper function of template.New(https://golang.org/pkg/html/template/#New).
 // It automatically adds the gtf functions to the template's function map
@@ -25,6 +25,19 @@ func AssertIntInRange(t *testing.T, buffer *bytes.Buffer, min, max int) {
 	buffer.Reset()
 }
 
+// AssertRegistered checks that the functions of an optional category are
+// in GtfTextFuncMap and GtfFuncMap exactly when the category is enabled
+// by the build tags.
+func AssertRegistered(t *testing.T, funcs map[string]interface{}, enabled bool) {
+	for name := range funcs {
+		_, inText := GtfTextFuncMap[name]
+		_, inHtml := GtfFuncMap[name]
+		if inText != enabled || inHtml != enabled {
+			t.Errorf("%s: expected to be registered: %v, in GtfTextFuncMap: %v, in GtfFuncMap: %v", name, enabled, inText, inHtml)
+		}
+	}
+}
+
 func ParseTest(buffer *bytes.Buffer, body string, data interface{}) {
 	tpl := New("test")
 	tpl.Parse(body)
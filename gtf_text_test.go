@@ -27,3 +27,13 @@ func TestTextTemplateGtfFuncMap(t *testing.T) {
 	TextTemplateParseTest(&buffer, "{{ 21 | divisibleby 3 }}", "")
 	AssertEqual(t, &buffer, "true")
 }
+
+func TestTextTemplateSerializeFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | tojson }}", map[string]string{"html": "<b>&</b>"})
+	AssertEqual(t, &buffer, `{"html":"<b>&</b>"}`)
+
+	TextTemplateParseTest(&buffer, "{{ . | toprettyjson }}", []int{1, 2})
+	AssertEqual(t, &buffer, "[\n  1,\n  2\n]")
+}
@@ -0,0 +1,101 @@
+package gtf
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"hash/fnv"
+	textTemplate "text/template"
+)
+
+// hashAlgorithms are the hash functions available to the hmac function.
+var hashAlgorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// formatDigest encodes sum as "hex" (the default) or "base64".
+func formatDigest(format string, sum []byte) string {
+	switch format {
+	case "", "hex":
+		return hex.EncodeToString(sum)
+	case "base64":
+		return base64.StdEncoding.EncodeToString(sum)
+	}
+
+	return ""
+}
+
+// hashWith returns a function which hashes strings and byte slices. The
+// optional argument selects the output format.
+func hashWith(newHash func() hash.Hash) func(args ...interface{}) string {
+	return func(args ...interface{}) string {
+		defer recovery()
+
+		b, ok := toBytes(args[len(args)-1])
+		if !ok {
+			return ""
+		}
+
+		format := ""
+		if len(args) > 1 {
+			format = args[0].(string)
+		}
+
+		h := newHash()
+		h.Write(b)
+
+		return formatDigest(format, h.Sum(nil))
+	}
+}
+
+func newCRC32() hash.Hash {
+	return crc32.NewIEEE()
+}
+
+func newFNV() hash.Hash {
+	return fnv.New64a()
+}
+
+// GtfHashFuncMap contains the hashing functions. They are registered in
+// GtfFuncMap and GtfTextFuncMap unless gtf is built with the gtf_nohash
+// build tag.
+var GtfHashFuncMap = textTemplate.FuncMap{
+	"md5":    hashWith(md5.New),
+	"sha1":   hashWith(sha1.New),
+	"sha256": hashWith(sha256.New),
+	"sha512": hashWith(sha512.New),
+	"crc32":  hashWith(newCRC32),
+	"fnv":    hashWith(newFNV),
+	"hmac": func(algorithm string, key string, args ...interface{}) string {
+		defer recovery()
+
+		newHash, ok := hashAlgorithms[algorithm]
+		if !ok {
+			return ""
+		}
+
+		b, ok := toBytes(args[len(args)-1])
+		if !ok {
+			return ""
+		}
+
+		format := ""
+		if len(args) > 1 {
+			format = args[0].(string)
+		}
+
+		h := hmac.New(newHash, []byte(key))
+		h.Write(b)
+
+		return formatDigest(format, h.Sum(nil))
+	},
+}
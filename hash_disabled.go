@@ -0,0 +1,6 @@
+//go:build gtf_nohash
+// +build gtf_nohash
+
+package gtf
+
+const hashFuncsEnabled = false
@@ -0,0 +1,10 @@
+//go:build !gtf_nohash
+// +build !gtf_nohash
+
+package gtf
+
+const hashFuncsEnabled = true
+
+func init() {
+	registerFuncs(GtfHashFuncMap)
+}
@@ -0,0 +1,53 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHashFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"gopher@example.com\" | md5 }}", "")
+	AssertEqual(t, &buffer, "163bd06e886dd88d6800b75606cbe5cb")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ . | md5 }}", []byte("gopher@example.com"))
+	AssertEqual(t, &buffer, "163bd06e886dd88d6800b75606cbe5cb")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | sha1 }}", "")
+	AssertEqual(t, &buffer, "1ec558a60b5dda24597816c924776716018caf8b")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | sha256 }}", "")
+	AssertEqual(t, &buffer, "4cd0e21a9a0795a14ec9aa5f0e7d1abff0492565770e43eafdf1e3e8afed1f33")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | sha256 \"base64\" }}", "")
+	AssertEqual(t, &buffer, "TNDiGpoHlaFOyapfDn0av/BJJWV3DkPq/fHj6K/tHzM=")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | sha512 }}", "")
+	AssertEqual(t, &buffer, "2f273778835c6467e52ceed449bf82250f7072655f5db8ccf12946ed52f90529d50b5479859beb3429f89558e56ccef0c5c4d0ba8a13cc60f0ab202b99793a83")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | crc32 }}", "")
+	AssertEqual(t, &buffer, "b6689356")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | fnv }}", "")
+	AssertEqual(t, &buffer, "08953907b53f670b")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | hmac \"sha256\" \"secret\" }}", "")
+	AssertEqual(t, &buffer, "3dc2b907d44f3401689b99501e803a8d9745ea3211b5091db1801058f8bf3678")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | hmac \"sha256\" \"secret\" \"base64\" }}", "")
+	AssertEqual(t, &buffer, "PcK5B9RPNAFom5lQHoA6jZdF6jIRtQkdsYAQWPi/Nng=")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | hmac \"md4\" \"secret\" }}", "")
+	AssertEqual(t, &buffer, "")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ \"go\" | sha1 \"base32\" }}", "")
+	AssertEqual(t, &buffer, "")
+
+	CustomParseTest(GtfHashFuncMap, &buffer, "{{ 10 | sha1 }}", "")
+	AssertEqual(t, &buffer, "")
+}
+
+func TestHashFuncsRegistered(t *testing.T) {
+	AssertRegistered(t, GtfHashFuncMap, hashFuncsEnabled)
+}
@@ -0,0 +1,457 @@
+package gtf
+
+import (
+	"bytes"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// htmlTextWidth is the default line width of htmltotext, the length
+// recommended for email by RFC 5322.
+const htmlTextWidth = 78
+
+// htmlNode is an element or a piece of text of a parsed HTML document.
+type htmlNode struct {
+	tag      string // lower case element name, "" for text
+	text     string // decoded text
+	attrs    map[string]string
+	children []*htmlNode
+}
+
+// htmlBlockElements are rendered on lines of their own by htmltotext.
+var htmlBlockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"body": true, "caption": true, "center": true, "dd": true,
+	"details": true, "div": true, "dl": true, "dt": true,
+	"fieldset": true, "figcaption": true, "figure": true, "footer": true,
+	"form": true, "h1": true, "h2": true, "h3": true, "h4": true,
+	"h5": true, "h6": true, "header": true, "hr": true, "html": true,
+	"li": true, "main": true, "nav": true, "ol": true, "p": true,
+	"pre": true, "section": true, "summary": true, "table": true,
+	"tbody": true, "td": true, "tfoot": true, "th": true, "thead": true,
+	"tr": true, "ul": true,
+}
+
+// htmlAutoClose lists the open elements which are implicitly closed by a
+// start tag, like a <li> closes the previous <li>.
+var htmlAutoClose = map[string][]string{
+	"li": {"li", "p"},
+	"dt": {"dt", "dd", "p"},
+	"dd": {"dt", "dd", "p"},
+	"tr": {"tr", "td", "th"},
+	"td": {"td", "th"},
+	"th": {"td", "th"},
+}
+
+// parseHTML parses s into a tree of elements. Like browsers, it closes
+// elements which are left open and ignores unmatched end tags.
+func parseHTML(s string) *htmlNode {
+	root := &htmlNode{}
+	stack := []*htmlNode{root}
+
+	// openCount counts the open elements by name, so unmatched end tags
+	// are ignored without searching the stack.
+	openCount := map[string]int{}
+	pop := func() {
+		openCount[stack[len(stack)-1].tag]--
+		stack = stack[:len(stack)-1]
+	}
+
+	for _, token := range tokenizeHTML(s) {
+		top := stack[len(stack)-1]
+		switch token.kind {
+		case htmlText:
+			top.children = append(top.children, &htmlNode{text: html.UnescapeString(token.text)})
+		case htmlEndTag:
+			if openCount[token.name] == 0 {
+				continue
+			}
+			for stack[len(stack)-1].tag != token.name {
+				pop()
+			}
+			pop()
+		case htmlStartTag:
+			closes := htmlAutoClose[token.name]
+			if htmlBlockElements[token.name] && closes == nil {
+				closes = []string{"p"}
+			}
+			for closed := true; closed && len(stack) > 1; {
+				closed = false
+				for _, name := range closes {
+					if stack[len(stack)-1].tag == name {
+						pop()
+						closed = true
+						break
+					}
+				}
+			}
+
+			node := &htmlNode{tag: token.name, attrs: map[string]string{}}
+			for _, m := range htmlAttrPattern.FindAllStringSubmatch(token.attrs, -1) {
+				key := strings.ToLower(m[1])
+				if _, ok := node.attrs[key]; !ok {
+					node.attrs[key] = html.UnescapeString(m[2] + m[3] + m[4])
+				}
+			}
+
+			top = stack[len(stack)-1]
+			top.children = append(top.children, node)
+			if !voidElements[token.name] {
+				stack = append(stack, node)
+				openCount[token.name]++
+			}
+		}
+	}
+
+	return root
+}
+
+// collapseSpace collapses the whitespace of every line of s, removing
+// leading and trailing blank lines.
+func collapseSpace(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// prefixLines adds first to the first line of s and rest to the others.
+// Prefixes of empty lines are trimmed.
+func prefixLines(s string, first, rest string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			prefix = strings.TrimRight(prefix, " ")
+		}
+		lines[i] = prefix + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// narrow returns the width left after a prefix of n columns. A width of
+// 0 or less disables wrapping and is kept.
+func narrow(width, n int) int {
+	switch {
+	case width <= 0:
+		return width
+	case width-n < 1:
+		return 1
+	}
+
+	return width - n
+}
+
+// textInline writes the text of an inline node. Whitespace is collapsed
+// later, <br> becomes a line break and links are followed by their URL.
+func textInline(buffer *bytes.Buffer, node *htmlNode) {
+	switch node.tag {
+	case "":
+		buffer.WriteString(strings.Map(func(r rune) rune {
+			if r == '\n' || r == '\r' || r == '\t' || r == '\f' {
+				return ' '
+			}
+			return r
+		}, node.text))
+	case "br":
+		buffer.WriteString("\n")
+	case "img":
+		buffer.WriteString(node.attrs["alt"])
+	case "a":
+		var content bytes.Buffer
+		for _, child := range node.children {
+			textInline(&content, child)
+		}
+		buffer.Write(content.Bytes())
+
+		text := collapseSpace(content.String())
+		href := strings.TrimSpace(node.attrs["href"])
+		if href != "" && !strings.HasPrefix(href, "#") && isSafeURL(href) &&
+			href != text && strings.TrimPrefix(href, "mailto:") != text {
+			buffer.WriteString(" (" + href + ")")
+		}
+	default:
+		for _, child := range node.children {
+			textInline(buffer, child)
+		}
+	}
+}
+
+// textInlineText returns the collapsed text of the children of node.
+func textInlineText(node *htmlNode) string {
+	var buffer bytes.Buffer
+	for _, child := range node.children {
+		textInline(&buffer, child)
+	}
+
+	return collapseSpace(buffer.String())
+}
+
+// wrapIf wraps s at width unless wrapping is disabled.
+func wrapIf(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	return wrap(width, "", s)
+}
+
+// textBlocks renders nodes as a list of blocks. Runs of inline nodes become
+// wrapped paragraphs.
+func textBlocks(nodes []*htmlNode, width int) []string {
+	var blocks []string
+	var buffer bytes.Buffer
+
+	flush := func() {
+		if text := collapseSpace(buffer.String()); text != "" {
+			blocks = append(blocks, wrapIf(text, width))
+		}
+		buffer.Reset()
+	}
+
+	for _, node := range nodes {
+		if !htmlBlockElements[node.tag] {
+			textInline(&buffer, node)
+			continue
+		}
+		flush()
+		if block := textBlock(node, width); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// textBlock renders a block element.
+func textBlock(node *htmlNode, width int) string {
+	switch node.tag {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return wrapIf(textInlineText(node), width)
+	case "hr":
+		if width <= 0 {
+			return "---"
+		}
+		return strings.Repeat("-", width)
+	case "pre":
+		var buffer bytes.Buffer
+		textPre(&buffer, node)
+		text := strings.TrimPrefix(normalizeNewlines(buffer.String()), "\n")
+		return strings.TrimRight(text, "\n")
+	case "blockquote":
+		text := strings.Join(textBlocks(node.children, narrow(width, 2)), "\n\n")
+		if text == "" {
+			return ""
+		}
+		return prefixLines(text, "> ", "> ")
+	case "ul", "ol":
+		return textList(node, width)
+	case "dl":
+		var items []string
+		for _, child := range node.children {
+			text := strings.Join(textBlocks(child.children, narrow(width, 4)), "\n")
+			switch {
+			case text == "":
+			case child.tag == "dd":
+				items = append(items, prefixLines(text, "    ", "    "))
+			default:
+				items = append(items, text)
+			}
+		}
+		return strings.Join(items, "\n")
+	case "table":
+		return textTable(node, width)
+	case "li", "dt", "dd", "tr", "td", "th":
+		return strings.Join(textBlocks(node.children, width), "\n")
+	}
+
+	return strings.Join(textBlocks(node.children, width), "\n\n")
+}
+
+// textPre writes the text of preformatted content as it is.
+func textPre(buffer *bytes.Buffer, node *htmlNode) {
+	switch node.tag {
+	case "":
+		buffer.WriteString(node.text)
+	case "br":
+		buffer.WriteString("\n")
+	case "img":
+		buffer.WriteString(node.attrs["alt"])
+	default:
+		for _, child := range node.children {
+			textPre(buffer, child)
+		}
+	}
+}
+
+// textList renders the items of a list with bullets or numbers. The content
+// of items is indented under the marker.
+func textList(node *htmlNode, width int) string {
+	number := 1
+	if start, err := strconv.Atoi(node.attrs["start"]); err == nil {
+		number = start
+	}
+
+	var items []string
+	for _, child := range node.children {
+		if child.tag == "" && strings.TrimSpace(child.text) == "" {
+			continue
+		}
+
+		marker := "* "
+		if node.tag == "ol" {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+
+		children := child.children
+		if child.tag != "li" {
+			children = []*htmlNode{child}
+		}
+		text := strings.Join(textBlocks(children, narrow(width, len(marker))), "\n")
+		items = append(items, prefixLines(text, marker, strings.Repeat(" ", len(marker))))
+	}
+
+	return strings.Join(items, "\n")
+}
+
+// tableRows collects the rows of a table, including the rows of its
+// header, body and footer.
+func tableRows(node *htmlNode) []*htmlNode {
+	var rows []*htmlNode
+	for _, child := range node.children {
+		switch child.tag {
+		case "tr":
+			rows = append(rows, child)
+		case "thead", "tbody", "tfoot":
+			rows = append(rows, tableRows(child)...)
+		}
+	}
+
+	return rows
+}
+
+// tableCells returns the <td> and <th> cells of a row.
+func tableCells(row *htmlNode) []*htmlNode {
+	var cells []*htmlNode
+	for _, cell := range row.children {
+		if cell.tag == "td" || cell.tag == "th" {
+			cells = append(cells, cell)
+		}
+	}
+
+	return cells
+}
+
+// isLayoutTable reports whether a table has a single column of <td> cells,
+// like the tables which lay out HTML emails.
+func isLayoutTable(rows []*htmlNode) bool {
+	for _, row := range rows {
+		cells := tableCells(row)
+		if len(cells) > 1 || (len(cells) == 1 && cells[0].tag == "th") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// textCell returns the text of a table cell on a single line. Blocks in
+// the cell are separated by spaces.
+func textCell(cell *htmlNode) string {
+	return strings.Replace(strings.Join(textBlocks(cell.children, 0), "\n"), "\n", " ", -1)
+}
+
+// textTable renders a table with aligned columns. A header row of <th> cells
+// is underlined. Tables are not wrapped, except layout tables with a single
+// column, whose cells are rendered as blocks.
+func textTable(node *htmlNode, width int) string {
+	if rows := tableRows(node); isLayoutTable(rows) {
+		var blocks []string
+		for _, row := range rows {
+			for _, cell := range tableCells(row) {
+				blocks = append(blocks, textBlocks(cell.children, width)...)
+			}
+		}
+		return strings.Join(blocks, "\n\n")
+	}
+
+	var rows [][]string
+	var widths []int
+	header := false
+
+	for i, row := range tableRows(node) {
+		var cells []string
+		heading := true
+		for _, cell := range tableCells(row) {
+			heading = heading && cell.tag == "th"
+			text := textCell(cell)
+			cells = append(cells, text)
+
+			if j := len(cells) - 1; j >= len(widths) {
+				widths = append(widths, displayWidth(text))
+			} else if w := displayWidth(text); w > widths[j] {
+				widths[j] = w
+			}
+		}
+		if len(cells) == 0 {
+			continue
+		}
+		if i == 0 {
+			header = heading
+		}
+		rows = append(rows, cells)
+	}
+
+	var lines []string
+	for i, cells := range rows {
+		var line bytes.Buffer
+		for j, cell := range cells {
+			if j > 0 {
+				line.WriteString("  ")
+			}
+			line.WriteString(cell + strings.Repeat(" ", widths[j]-displayWidth(cell)))
+		}
+		lines = append(lines, strings.TrimRight(line.String(), " "))
+
+		if i == 0 && header {
+			var rule []string
+			for _, w := range widths[:len(cells)] {
+				rule = append(rule, strings.Repeat("-", w))
+			}
+			lines = append(lines, strings.Join(rule, "  "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// htmlToText converts HTML into readable plain text. Paragraphs and
+// other blocks are separated by blank lines, <br> becomes a line break,
+// lists get bullets or numbers, links are followed by their URL and
+// tables are rendered with aligned columns. Text is wrapped at width
+// columns; a width of 0 or less disables wrapping.
+func htmlToText(s string, width int) string {
+	return strings.Join(textBlocks(parseHTML(s).children, width), "\n\n")
+}
+
+// htmlToTextArgs parses the optional width argument of htmltotext, which
+// precedes the value. A width which is not a number is ignored.
+func htmlToTextArgs(args []interface{}) (int, string) {
+	width := htmlTextWidth
+	if len(args) > 1 {
+		if n, ok := toInt(args[0]); ok {
+			width = n
+		}
+	}
+
+	return width, toText(args[len(args)-1])
+}
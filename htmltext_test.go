@@ -0,0 +1,104 @@
+package gtf
+
+import (
+	"bytes"
+	htmlTemplate "html/template"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTMLToText(t *testing.T) {
+	var buffer bytes.Buffer
+
+	input := `<html><head><title>Welcome</title><style>p { color: red }</style></head><body>
+<h1>Welcome, Tom &amp; Jerry</h1>
+<p>Thanks for signing up. Please <a href="https://example.com/confirm?id=42">confirm your address</a>
+within <b>24 hours</b>.<br>Questions? Mail <a href="mailto:help@example.com">help@example.com</a>.</p>
+<ul><li>First item<li>Second item, which is long enough to wrap
+<ol start="3"><li>three<li>four</ol></ul>
+<blockquote><p>Quoted text which wraps at the given width.</p></blockquote>
+<table><tr><th>Product</th><th>Qty</th></tr>
+<tr><td>Widget</td><td>2</td></tr><tr><td>한국어</td><td>10</td></tr></table>
+<pre>
+  code
+    indented
+</pre>
+<hr>
+<p>&copy; 2024 <img src="logo.png" alt="Logo"> <a href="javascript:alert(1)">x</a></p>
+<script>alert(1)</script>
+</body></html>`
+
+	expected := "Welcome, Tom & Jerry\n\n" +
+		"Thanks for signing up. Please confirm\nyour address\n(https://example.com/confirm?id=42)\nwithin 24 hours.\nQuestions? Mail help@example.com.\n\n" +
+		"* First item\n* Second item, which is long enough to\n  wrap\n  3. three\n  4. four\n\n" +
+		"> Quoted text which wraps at the given\n> width.\n\n" +
+		"Product  Qty\n-------  ---\nWidget   2\n한국어   10\n\n" +
+		"  code\n    indented\n\n" +
+		"----------------------------------------\n\n" +
+		"© 2024 Logo x"
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext 40 }}", input)
+	AssertEqual(t, &buffer, expected)
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext }}", "<div>one</div><div>two<p>three</div>  four")
+	AssertEqual(t, &buffer, "one\n\ntwo\n\nthree\n\nfour")
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext 0 }}", "<p>a very long line which is not wrapped because the width is zero, however long it gets</p><hr>")
+	AssertEqual(t, &buffer, "a very long line which is not wrapped because the width is zero, however long it gets\n\n---")
+
+	for _, width := range []interface{}{int64(10), uint(10), int8(10)} {
+		CustomParseTest(GtfTextFuncMap, &buffer, "{{ htmltotext .width .html }}", map[string]interface{}{"width": width, "html": "<p>one two three four</p>"})
+		AssertEqual(t, &buffer, "one two\nthree four")
+	}
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext \"10\" }}", "<p>one two three four</p>")
+	AssertEqual(t, &buffer, "one two three four")
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext 20 }}", htmlTemplate.HTML("<p>a <a href=\"#top\">top</a> <a href=\"https://go.dev\">https://go.dev</a></p>"))
+	AssertEqual(t, &buffer, "a top https://go.dev")
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext }}", "<dl><dt>Term</dt><dd>Definition</dd></dl><p>x &lt; y<br><br>z</p>")
+	AssertEqual(t, &buffer, "Term\n    Definition\n\nx < y\n\nz")
+
+	ParseTest(&buffer, "{{ . | htmltotext }}", "<p>Tom &amp; Jerry</p>")
+	AssertEqual(t, &buffer, "Tom &amp; Jerry")
+
+	TextTemplateParseTest(&buffer, "{{ . | markdown | htmltotext }}", "# Title\n\n- [Go](https://go.dev)")
+	AssertEqual(t, &buffer, "Title\n\n* Go (https://go.dev)")
+}
+
+func TestHTMLToTextTables(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := map[string]string{
+		"<table><tr><td><p>a</p><p>b</p></td><td>c</td></tr></table>":                          "a b  c",
+		"<table><tr><td>x<div>y</div></td><td>z<br>w</td></tr></table>":                        "x y  z w",
+		"<table><tr><td><ul><li>one</li><li>two</li></ul></td><td>3</td></tr></table>":         "* one * two  3",
+		"<table><tr><td><p>Hello</p><p>World</p></td></tr><tr><td>Bye</td></tr></table>":       "Hello\n\nWorld\n\nBye",
+		"<table><tr><td><table><tr><td><h1>Title</h1>text</td></tr></table></td></tr></table>": "Title\n\ntext",
+		"<table><tr><th>Name</th></tr><tr><td>Go</td></tr></table>":                            "Name\n----\nGo",
+	}
+
+	for input, expected := range cases {
+		TextTemplateParseTest(&buffer, "{{ . | htmltotext }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext 10 }}", "<table><tr><td>one two three four</td></tr></table>")
+	AssertEqual(t, &buffer, "one two\nthree four")
+}
+
+func TestHTMLToTextUnmatchedEndTags(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext }}", "<p>a <b>b</i> c</b></p></div><p>d</p>")
+	AssertEqual(t, &buffer, "a b c\n\nd")
+
+	start := time.Now()
+	TextTemplateParseTest(&buffer, "{{ . | htmltotext }}", strings.Repeat("<b>", 20000)+strings.Repeat("</i>", 20000)+"x")
+	AssertEqual(t, &buffer, "x")
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("htmltotext of 20000 unmatched end tags took %v", elapsed)
+	}
+}
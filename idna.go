@@ -0,0 +1,586 @@
+package gtf
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run gen_idna.go
+
+// Punycode parameters from RFC 3492.
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// acePrefix starts the ASCII form of labels with non-ASCII characters.
+const acePrefix = "xn--"
+
+// maxLabelLength and maxDomainLength are the DNS limits for the ASCII
+// form of a domain name.
+const (
+	maxLabelLength  = 63
+	maxDomainLength = 253
+)
+
+var errPunycode = errors.New("gtf: invalid punycode")
+
+func punyAdapt(delta, points int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / points
+
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+
+	return k - bias
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+
+	return byte('0' + d - 26)
+}
+
+func punyDigitValue(c byte) (int, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c-'0') + 26, true
+	case 'a' <= c && c <= 'z':
+		return int(c - 'a'), true
+	case 'A' <= c && c <= 'Z':
+		return int(c - 'A'), true
+	}
+
+	return 0, false
+}
+
+// punyEncode encodes a label with the Punycode algorithm of RFC 3492.
+func punyEncode(label string) (string, error) {
+	runes := []rune(label)
+
+	var output []byte
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			output = append(output, byte(r))
+		}
+	}
+	basic := len(output)
+	handled := basic
+	if basic > 0 {
+		output = append(output, '-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for handled < len(runes) {
+		m := int(unicode.MaxRune) + 1
+		for _, r := range runes {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+
+		delta += (m - n) * (handled + 1)
+		if delta < 0 {
+			return "", errPunycode
+		}
+		n = m
+
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+			}
+			if int(r) != n {
+				continue
+			}
+
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				output = append(output, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			output = append(output, punyDigit(q))
+
+			bias = punyAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+
+		delta++
+		n++
+	}
+
+	return string(output), nil
+}
+
+// punyDecode decodes a label encoded with the Punycode algorithm.
+func punyDecode(s string) (string, error) {
+	var output []rune
+
+	rest := s
+	if i := strings.LastIndex(s, "-"); i >= 0 {
+		for j := 0; j < i; j++ {
+			if s[j] >= utf8.RuneSelf {
+				return "", errPunycode
+			}
+			output = append(output, rune(s[j]))
+		}
+		rest = s[i+1:]
+	}
+
+	n, i, bias := punyInitialN, 0, punyInitialBias
+	for pos := 0; pos < len(rest); {
+		oldI, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if pos >= len(rest) {
+				return "", errPunycode
+			}
+			digit, ok := punyDigitValue(rest[pos])
+			pos++
+			if !ok {
+				return "", errPunycode
+			}
+
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			w *= punyBase - t
+			if i < 0 || w <= 0 || i > unicode.MaxRune*(len(output)+1) {
+				return "", errPunycode
+			}
+		}
+
+		bias = punyAdapt(i-oldI, len(output)+1, oldI == 0)
+		n += i / (len(output) + 1)
+		i %= len(output) + 1
+		if n > unicode.MaxRune || n < punyInitialN {
+			return "", errPunycode
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+		i++
+	}
+
+	return string(output), nil
+}
+
+// idnaRange is a range of code points with the same UTS #46 status. It
+// ends where the next range starts.
+type idnaRange struct {
+	lo      rune
+	status  uint8
+	delta   int32  // distance to the code point mapped to, if it is one
+	mapping string // mapping to several code points
+}
+
+// UTS #46 status values of idnaTable. idnaValidNV8 code points are kept
+// by the mapping, but are not valid in IDNA2008 labels.
+const (
+	idnaValid = iota
+	idnaValidNV8
+	idnaMapped
+	idnaIgnored
+	idnaDisallowed
+)
+
+// Bidi_Class values of bidiTable. L is the default.
+const (
+	bidiL = iota
+	bidiR
+	bidiAL
+	bidiAN
+	bidiEN
+	bidiES
+	bidiCS
+	bidiET
+	bidiON
+	bidiBN
+	bidiNSM
+	bidiOther
+)
+
+// viramaClass is the Canonical_Combining_Class of viramas.
+const viramaClass = 9
+
+// idnaLookup returns the UTS #46 status of r.
+func idnaLookup(r rune) idnaRange {
+	i := sort.Search(len(idnaTable), func(i int) bool {
+		return idnaTable[i].lo > r
+	})
+
+	return idnaTable[i-1]
+}
+
+// idnaMap applies the UTS #46 mapping to a domain name and normalizes it
+// to NFC: full stop variants become ".", letters are case folded,
+// compatibility characters are replaced and ignored characters are
+// removed. Like IDNA2008, ß and ς are kept. ok is false if the domain
+// name contains disallowed characters, which are kept as they are.
+func idnaMap(domain string) (string, bool) {
+	var buffer bytes.Buffer
+	ok := true
+	for _, r := range domain {
+		entry := idnaLookup(r)
+		switch entry.status {
+		case idnaMapped:
+			if entry.mapping != "" {
+				buffer.WriteString(entry.mapping)
+			} else {
+				buffer.WriteRune(r + rune(entry.delta))
+			}
+		case idnaIgnored:
+		case idnaDisallowed:
+			ok = false
+			buffer.WriteRune(r)
+		default:
+			buffer.WriteRune(r)
+		}
+	}
+
+	return nfc(buffer.String()), ok
+}
+
+// joinsWith reports whether the first code point from runes[i] in the
+// direction of step, skipping transparent ones, has one of the joining
+// types.
+func joinsWith(runes []rune, i, step int, types string) bool {
+	for ; i >= 0 && i < len(runes); i += step {
+		t := lookupProperty(joiningTable, runes[i])
+		if t != 'T' {
+			return t != 0 && strings.IndexByte(types, t) >= 0
+		}
+	}
+
+	return false
+}
+
+// containsRange reports whether runes contain a code point from lo to hi.
+func containsRange(runes []rune, lo, hi rune) bool {
+	for _, r := range runes {
+		if r >= lo && r <= hi {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isValidContext checks the code point runes[i] against the CONTEXTJ and
+// CONTEXTO rules of IDNA2008 (RFC 5892, Appendix A).
+func isValidContext(runes []rune, i int) bool {
+	r := runes[i]
+	afterVirama := i > 0 && lookupProperty(cccTable, runes[i-1]) == viramaClass
+
+	switch {
+	case r == '\u200c':
+		return afterVirama || joinsWith(runes, i-1, -1, "LD") && joinsWith(runes, i+1, 1, "RD")
+	case r == '\u200d':
+		return afterVirama
+	case r == '\u00b7':
+		return i > 0 && i+1 < len(runes) && runes[i-1] == 'l' && runes[i+1] == 'l'
+	case r == '\u0375':
+		return i+1 < len(runes) && unicode.Is(unicode.Greek, runes[i+1])
+	case r == '\u05f3' || r == '\u05f4':
+		return i > 0 && unicode.Is(unicode.Hebrew, runes[i-1])
+	case r == '\u30fb':
+		for _, c := range runes {
+			if unicode.In(c, unicode.Hiragana, unicode.Katakana, unicode.Han) {
+				return true
+			}
+		}
+		return false
+	case r >= '\u0660' && r <= '\u0669':
+		return !containsRange(runes, '\u06f0', '\u06f9')
+	case r >= '\u06f0' && r <= '\u06f9':
+		return !containsRange(runes, '\u0660', '\u0669')
+	}
+
+	return true
+}
+
+// isValidLabel checks a Unicode label against the UTS #46 validity
+// criteria: it must be in NFC, must not start or end with a hyphen or
+// have hyphens in the third and fourth position, must not start with a
+// combining mark and must only contain valid code points. The contextual
+// rules of IDNA2008 are checked too.
+func isValidLabel(label string) bool {
+	if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return false
+	}
+	runes := []rune(label)
+	if len(runes) >= 4 && runes[2] == '-' && runes[3] == '-' {
+		return false
+	}
+	if nfc(label) != label || unicode.IsMark(runes[0]) {
+		return false
+	}
+
+	for i, r := range runes {
+		if idnaLookup(r).status != idnaValid || !isValidContext(runes, i) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasRTL reports whether a label contains right-to-left characters, which
+// makes the domain name a Bidi domain name.
+func hasRTL(label string) bool {
+	for _, r := range label {
+		switch lookupProperty(bidiTable, r) {
+		case bidiR, bidiAL, bidiAN:
+			return true
+		}
+	}
+
+	return false
+}
+
+// satisfiesBidiRule checks a label against the Bidi rule of RFC 5893.
+func satisfiesBidiRule(label string) bool {
+	var classes []uint8
+	for _, r := range label {
+		classes = append(classes, lookupProperty(bidiTable, r))
+	}
+
+	end := len(classes)
+	for end > 0 && classes[end-1] == bidiNSM {
+		end--
+	}
+	if end == 0 {
+		return false
+	}
+	last := classes[end-1]
+
+	switch classes[0] {
+	case bidiR, bidiAL:
+		en, an := false, false
+		for _, class := range classes {
+			switch class {
+			case bidiR, bidiAL, bidiES, bidiCS, bidiET, bidiON, bidiBN, bidiNSM:
+			case bidiEN:
+				en = true
+			case bidiAN:
+				an = true
+			default:
+				return false
+			}
+		}
+		return !(en && an) && (last == bidiR || last == bidiAL || last == bidiEN || last == bidiAN)
+	case bidiL:
+		for _, class := range classes {
+			switch class {
+			case bidiL, bidiEN, bidiES, bidiCS, bidiET, bidiON, bidiBN, bidiNSM:
+			default:
+				return false
+			}
+		}
+		return last == bidiL || last == bidiEN
+	}
+
+	return false
+}
+
+// decodeLabel decodes an A-label, a label starting with "xn--". The
+// decoded label must be valid, must not change when it is mapped and
+// must encode to the same A-label again.
+func decodeLabel(label string) (string, bool) {
+	if !strings.HasPrefix(label, acePrefix) {
+		return "", false
+	}
+
+	decoded, err := punyDecode(label[len(acePrefix):])
+	if err != nil || isASCII(decoded) || !isValidLabel(decoded) {
+		return "", false
+	}
+	if mapped, ok := idnaMap(decoded); !ok || mapped != decoded {
+		return "", false
+	}
+	if encoded, err := punyEncode(decoded); err != nil || acePrefix+encoded != label {
+		return "", false
+	}
+	if hasRTL(decoded) && !satisfiesBidiRule(decoded) {
+		return "", false
+	}
+
+	return decoded, true
+}
+
+// isASCII reports whether s only contains ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// idnaToASCII converts a domain name into its ASCII form following the
+// UTS #46 nontransitional processing of IDNA2008, with the STD3 ASCII
+// rules and the hyphen, Bidi and joiner checks. ok is false if the domain
+// name is not valid.
+func idnaToASCII(domain string) (string, bool) {
+	domain, ok := idnaMap(domain)
+	if !ok {
+		return "", false
+	}
+	root := strings.HasSuffix(domain, ".")
+	labels := strings.Split(strings.TrimSuffix(domain, "."), ".")
+
+	bidi := false
+	for i, label := range labels {
+		if strings.HasPrefix(label, acePrefix) {
+			if label, ok = decodeLabel(label); !ok {
+				return "", false
+			}
+			labels[i] = label
+		} else if !isValidLabel(label) {
+			return "", false
+		}
+		bidi = bidi || hasRTL(label)
+	}
+
+	for i, label := range labels {
+		if bidi && !satisfiesBidiRule(label) {
+			return "", false
+		}
+		if !isASCII(label) {
+			encoded, err := punyEncode(label)
+			if err != nil {
+				return "", false
+			}
+			label = acePrefix + encoded
+		}
+
+		if len(label) > maxLabelLength {
+			return "", false
+		}
+		labels[i] = label
+	}
+
+	result := strings.Join(labels, ".")
+	if len(result) > maxDomainLength {
+		return "", false
+	}
+	if root {
+		result += "."
+	}
+
+	return result, true
+}
+
+// idnaToUnicode converts the ASCII labels of a domain name into Unicode.
+// Labels which are not valid A-labels are left unchanged.
+func idnaToUnicode(domain string) string {
+	domain, _ = idnaMap(domain)
+	labels := strings.Split(domain, ".")
+	for i, label := range labels {
+		if decoded, ok := decodeLabel(label); ok {
+			labels[i] = decoded
+		}
+	}
+
+	return strings.Join(labels, ".")
+}
+
+// idnaDisplay decodes the A-labels of a domain name for display, like
+// the text of links. Other labels are kept exactly as they are written.
+func idnaDisplay(domain string) (string, bool) {
+	labels := strings.Split(domain, ".")
+	for i, label := range labels {
+		if !strings.HasPrefix(strings.ToLower(label), acePrefix) {
+			continue
+		}
+		if decoded, ok := decodeLabel(strings.ToLower(label)); ok {
+			labels[i] = decoded
+		}
+	}
+
+	return strings.Join(labels, "."), true
+}
+
+// mapURLHost converts the host name of an absolute URL with convert,
+// leaving the rest of the URL unchanged. IP addresses and URLs whose host
+// can not be converted are returned as they are.
+func mapURLHost(s string, convert func(string) (string, bool)) string {
+	i := strings.Index(s, "//")
+	if i < 0 || strings.ContainsAny(s[:i], "/?#") || (i > 0 && s[i-1] != ':') {
+		return s
+	}
+
+	start := i + 2
+	end := len(s)
+	if j := strings.IndexAny(s[start:], "/?#"); j >= 0 {
+		end = start + j
+	}
+	if j := strings.LastIndex(s[start:end], "@"); j >= 0 {
+		start += j + 1
+	}
+	if strings.HasPrefix(s[start:end], "[") {
+		return s
+	}
+	if j := strings.LastIndex(s[start:end], ":"); j >= 0 {
+		end = start + j
+	}
+
+	host, ok := convert(s[start:end])
+	if !ok || s[start:end] == "" {
+		return s
+	}
+
+	return s[:start] + host + s[end:]
+}
+
+// mapEmailDomain converts the domain of an email address with convert.
+func mapEmailDomain(s string, convert func(string) (string, bool)) string {
+	i := strings.LastIndex(s, "@")
+	if i < 0 {
+		return s
+	}
+
+	domain, ok := convert(s[i+1:])
+	if !ok {
+		return s
+	}
+
+	return s[:i+1] + domain
+}
@@ -0,0 +1,10069 @@
+// Code generated by gen_idna.go from Unicode 15.1.0. DO NOT EDIT.
+
+package gtf
+
+// idnaUnicodeVersion is the version of the Unicode data in the tables.
+const idnaUnicodeVersion = "15.1.0"
+
+// idnaTable lists the UTS #46 status of every code point, for
+// nontransitional processing with the STD3 ASCII rules.
+var idnaTable = []idnaRange{
+	{0x0000, idnaDisallowed, 0, ""},
+	{0x002D, idnaValid, 0, ""},
+	{0x002F, idnaDisallowed, 0, ""},
+	{0x0030, idnaValid, 0, ""},
+	{0x003A, idnaDisallowed, 0, ""},
+	{0x0041, idnaMapped, 32, ""},
+	{0x005B, idnaDisallowed, 0, ""},
+	{0x0061, idnaValid, 0, ""},
+	{0x007B, idnaDisallowed, 0, ""},
+	{0x00A1, idnaValidNV8, 0, ""},
+	{0x00A8, idnaDisallowed, 0, ""},
+	{0x00A9, idnaValidNV8, 0, ""},
+	{0x00AA, idnaMapped, -73, ""},
+	{0x00AB, idnaValidNV8, 0, ""},
+	{0x00AD, idnaIgnored, 0, ""},
+	{0x00AE, idnaValidNV8, 0, ""},
+	{0x00AF, idnaDisallowed, 0, ""},
+	{0x00B0, idnaValidNV8, 0, ""},
+	{0x00B2, idnaMapped, -128, ""},
+	{0x00B4, idnaDisallowed, 0, ""},
+	{0x00B5, idnaMapped, 775, ""},
+	{0x00B6, idnaValidNV8, 0, ""},
+	{0x00B7, idnaValid, 0, ""},
+	{0x00B8, idnaDisallowed, 0, ""},
+	{0x00B9, idnaMapped, -136, ""},
+	{0x00BA, idnaMapped, -75, ""},
+	{0x00BB, idnaValidNV8, 0, ""},
+	{0x00BC, idnaMapped, 0, "1\u20444"},
+	{0x00BD, idnaMapped, 0, "1\u20442"},
+	{0x00BE, idnaMapped, 0, "3\u20444"},
+	{0x00BF, idnaValidNV8, 0, ""},
+	{0x00C0, idnaMapped, 32, ""},
+	{0x00D7, idnaValidNV8, 0, ""},
+	{0x00D8, idnaMapped, 32, ""},
+	{0x00DF, idnaValid, 0, ""},
+	{0x00F7, idnaValidNV8, 0, ""},
+	{0x00F8, idnaValid, 0, ""},
+	{0x0100, idnaMapped, 1, ""},
+	{0x0101, idnaValid, 0, ""},
+	{0x0102, idnaMapped, 1, ""},
+	{0x0103, idnaValid, 0, ""},
+	{0x0104, idnaMapped, 1, ""},
+	{0x0105, idnaValid, 0, ""},
+	{0x0106, idnaMapped, 1, ""},
+	{0x0107, idnaValid, 0, ""},
+	{0x0108, idnaMapped, 1, ""},
+	{0x0109, idnaValid, 0, ""},
+	{0x010A, idnaMapped, 1, ""},
+	{0x010B, idnaValid, 0, ""},
+	{0x010C, idnaMapped, 1, ""},
+	{0x010D, idnaValid, 0, ""},
+	{0x010E, idnaMapped, 1, ""},
+	{0x010F, idnaValid, 0, ""},
+	{0x0110, idnaMapped, 1, ""},
+	{0x0111, idnaValid, 0, ""},
+	{0x0112, idnaMapped, 1, ""},
+	{0x0113, idnaValid, 0, ""},
+	{0x0114, idnaMapped, 1, ""},
+	{0x0115, idnaValid, 0, ""},
+	{0x0116, idnaMapped, 1, ""},
+	{0x0117, idnaValid, 0, ""},
+	{0x0118, idnaMapped, 1, ""},
+	{0x0119, idnaValid, 0, ""},
+	{0x011A, idnaMapped, 1, ""},
+	{0x011B, idnaValid, 0, ""},
+	{0x011C, idnaMapped, 1, ""},
+	{0x011D, idnaValid, 0, ""},
+	{0x011E, idnaMapped, 1, ""},
+	{0x011F, idnaValid, 0, ""},
+	{0x0120, idnaMapped, 1, ""},
+	{0x0121, idnaValid, 0, ""},
+	{0x0122, idnaMapped, 1, ""},
+	{0x0123, idnaValid, 0, ""},
+	{0x0124, idnaMapped, 1, ""},
+	{0x0125, idnaValid, 0, ""},
+	{0x0126, idnaMapped, 1, ""},
+	{0x0127, idnaValid, 0, ""},
+	{0x0128, idnaMapped, 1, ""},
+	{0x0129, idnaValid, 0, ""},
+	{0x012A, idnaMapped, 1, ""},
+	{0x012B, idnaValid, 0, ""},
+	{0x012C, idnaMapped, 1, ""},
+	{0x012D, idnaValid, 0, ""},
+	{0x012E, idnaMapped, 1, ""},
+	{0x012F, idnaValid, 0, ""},
+	{0x0130, idnaMapped, 0, "i\u0307"},
+	{0x0131, idnaValid, 0, ""},
+	{0x0132, idnaMapped, 0, "ij"},
+	{0x0134, idnaMapped, 1, ""},
+	{0x0135, idnaValid, 0, ""},
+	{0x0136, idnaMapped, 1, ""},
+	{0x0137, idnaValid, 0, ""},
+	{0x0139, idnaMapped, 1, ""},
+	{0x013A, idnaValid, 0, ""},
+	{0x013B, idnaMapped, 1, ""},
+	{0x013C, idnaValid, 0, ""},
+	{0x013D, idnaMapped, 1, ""},
+	{0x013E, idnaValid, 0, ""},
+	{0x013F, idnaMapped, 0, "l\u00b7"},
+	{0x0141, idnaMapped, 1, ""},
+	{0x0142, idnaValid, 0, ""},
+	{0x0143, idnaMapped, 1, ""},
+	{0x0144, idnaValid, 0, ""},
+	{0x0145, idnaMapped, 1, ""},
+	{0x0146, idnaValid, 0, ""},
+	{0x0147, idnaMapped, 1, ""},
+	{0x0148, idnaValid, 0, ""},
+	{0x0149, idnaMapped, 0, "\u02bcn"},
+	{0x014A, idnaMapped, 1, ""},
+	{0x014B, idnaValid, 0, ""},
+	{0x014C, idnaMapped, 1, ""},
+	{0x014D, idnaValid, 0, ""},
+	{0x014E, idnaMapped, 1, ""},
+	{0x014F, idnaValid, 0, ""},
+	{0x0150, idnaMapped, 1, ""},
+	{0x0151, idnaValid, 0, ""},
+	{0x0152, idnaMapped, 1, ""},
+	{0x0153, idnaValid, 0, ""},
+	{0x0154, idnaMapped, 1, ""},
+	{0x0155, idnaValid, 0, ""},
+	{0x0156, idnaMapped, 1, ""},
+	{0x0157, idnaValid, 0, ""},
+	{0x0158, idnaMapped, 1, ""},
+	{0x0159, idnaValid, 0, ""},
+	{0x015A, idnaMapped, 1, ""},
+	{0x015B, idnaValid, 0, ""},
+	{0x015C, idnaMapped, 1, ""},
+	{0x015D, idnaValid, 0, ""},
+	{0x015E, idnaMapped, 1, ""},
+	{0x015F, idnaValid, 0, ""},
+	{0x0160, idnaMapped, 1, ""},
+	{0x0161, idnaValid, 0, ""},
+	{0x0162, idnaMapped, 1, ""},
+	{0x0163, idnaValid, 0, ""},
+	{0x0164, idnaMapped, 1, ""},
+	{0x0165, idnaValid, 0, ""},
+	{0x0166, idnaMapped, 1, ""},
+	{0x0167, idnaValid, 0, ""},
+	{0x0168, idnaMapped, 1, ""},
+	{0x0169, idnaValid, 0, ""},
+	{0x016A, idnaMapped, 1, ""},
+	{0x016B, idnaValid, 0, ""},
+	{0x016C, idnaMapped, 1, ""},
+	{0x016D, idnaValid, 0, ""},
+	{0x016E, idnaMapped, 1, ""},
+	{0x016F, idnaValid, 0, ""},
+	{0x0170, idnaMapped, 1, ""},
+	{0x0171, idnaValid, 0, ""},
+	{0x0172, idnaMapped, 1, ""},
+	{0x0173, idnaValid, 0, ""},
+	{0x0174, idnaMapped, 1, ""},
+	{0x0175, idnaValid, 0, ""},
+	{0x0176, idnaMapped, 1, ""},
+	{0x0177, idnaValid, 0, ""},
+	{0x0178, idnaMapped, -121, ""},
+	{0x0179, idnaMapped, 1, ""},
+	{0x017A, idnaValid, 0, ""},
+	{0x017B, idnaMapped, 1, ""},
+	{0x017C, idnaValid, 0, ""},
+	{0x017D, idnaMapped, 1, ""},
+	{0x017E, idnaValid, 0, ""},
+	{0x017F, idnaMapped, -268, ""},
+	{0x0180, idnaValid, 0, ""},
+	{0x0181, idnaMapped, 210, ""},
+	{0x0182, idnaMapped, 1, ""},
+	{0x0183, idnaValid, 0, ""},
+	{0x0184, idnaMapped, 1, ""},
+	{0x0185, idnaValid, 0, ""},
+	{0x0186, idnaMapped, 206, ""},
+	{0x0187, idnaMapped, 1, ""},
+	{0x0188, idnaValid, 0, ""},
+	{0x0189, idnaMapped, 205, ""},
+	{0x018B, idnaMapped, 1, ""},
+	{0x018C, idnaValid, 0, ""},
+	{0x018E, idnaMapped, 79, ""},
+	{0x018F, idnaMapped, 202, ""},
+	{0x0190, idnaMapped, 203, ""},
+	{0x0191, idnaMapped, 1, ""},
+	{0x0192, idnaValid, 0, ""},
+	{0x0193, idnaMapped, 205, ""},
+	{0x0194, idnaMapped, 207, ""},
+	{0x0195, idnaValid, 0, ""},
+	{0x0196, idnaMapped, 211, ""},
+	{0x0197, idnaMapped, 209, ""},
+	{0x0198, idnaMapped, 1, ""},
+	{0x0199, idnaValid, 0, ""},
+	{0x019C, idnaMapped, 211, ""},
+	{0x019D, idnaMapped, 213, ""},
+	{0x019E, idnaValid, 0, ""},
+	{0x019F, idnaMapped, 214, ""},
+	{0x01A0, idnaMapped, 1, ""},
+	{0x01A1, idnaValid, 0, ""},
+	{0x01A2, idnaMapped, 1, ""},
+	{0x01A3, idnaValid, 0, ""},
+	{0x01A4, idnaMapped, 1, ""},
+	{0x01A5, idnaValid, 0, ""},
+	{0x01A6, idnaMapped, 218, ""},
+	{0x01A7, idnaMapped, 1, ""},
+	{0x01A8, idnaValid, 0, ""},
+	{0x01A9, idnaMapped, 218, ""},
+	{0x01AA, idnaValid, 0, ""},
+	{0x01AC, idnaMapped, 1, ""},
+	{0x01AD, idnaValid, 0, ""},
+	{0x01AE, idnaMapped, 218, ""},
+	{0x01AF, idnaMapped, 1, ""},
+	{0x01B0, idnaValid, 0, ""},
+	{0x01B1, idnaMapped, 217, ""},
+	{0x01B3, idnaMapped, 1, ""},
+	{0x01B4, idnaValid, 0, ""},
+	{0x01B5, idnaMapped, 1, ""},
+	{0x01B6, idnaValid, 0, ""},
+	{0x01B7, idnaMapped, 219, ""},
+	{0x01B8, idnaMapped, 1, ""},
+	{0x01B9, idnaValid, 0, ""},
+	{0x01BC, idnaMapped, 1, ""},
+	{0x01BD, idnaValid, 0, ""},
+	{0x01C4, idnaMapped, 0, "d\u017e"},
+	{0x01C7, idnaMapped, 0, "lj"},
+	{0x01CA, idnaMapped, 0, "nj"},
+	{0x01CD, idnaMapped, 1, ""},
+	{0x01CE, idnaValid, 0, ""},
+	{0x01CF, idnaMapped, 1, ""},
+	{0x01D0, idnaValid, 0, ""},
+	{0x01D1, idnaMapped, 1, ""},
+	{0x01D2, idnaValid, 0, ""},
+	{0x01D3, idnaMapped, 1, ""},
+	{0x01D4, idnaValid, 0, ""},
+	{0x01D5, idnaMapped, 1, ""},
+	{0x01D6, idnaValid, 0, ""},
+	{0x01D7, idnaMapped, 1, ""},
+	{0x01D8, idnaValid, 0, ""},
+	{0x01D9, idnaMapped, 1, ""},
+	{0x01DA, idnaValid, 0, ""},
+	{0x01DB, idnaMapped, 1, ""},
+	{0x01DC, idnaValid, 0, ""},
+	{0x01DE, idnaMapped, 1, ""},
+	{0x01DF, idnaValid, 0, ""},
+	{0x01E0, idnaMapped, 1, ""},
+	{0x01E1, idnaValid, 0, ""},
+	{0x01E2, idnaMapped, 1, ""},
+	{0x01E3, idnaValid, 0, ""},
+	{0x01E4, idnaMapped, 1, ""},
+	{0x01E5, idnaValid, 0, ""},
+	{0x01E6, idnaMapped, 1, ""},
+	{0x01E7, idnaValid, 0, ""},
+	{0x01E8, idnaMapped, 1, ""},
+	{0x01E9, idnaValid, 0, ""},
+	{0x01EA, idnaMapped, 1, ""},
+	{0x01EB, idnaValid, 0, ""},
+	{0x01EC, idnaMapped, 1, ""},
+	{0x01ED, idnaValid, 0, ""},
+	{0x01EE, idnaMapped, 1, ""},
+	{0x01EF, idnaValid, 0, ""},
+	{0x01F1, idnaMapped, 0, "dz"},
+	{0x01F4, idnaMapped, 1, ""},
+	{0x01F5, idnaValid, 0, ""},
+	{0x01F6, idnaMapped, -97, ""},
+	{0x01F7, idnaMapped, -56, ""},
+	{0x01F8, idnaMapped, 1, ""},
+	{0x01F9, idnaValid, 0, ""},
+	{0x01FA, idnaMapped, 1, ""},
+	{0x01FB, idnaValid, 0, ""},
+	{0x01FC, idnaMapped, 1, ""},
+	{0x01FD, idnaValid, 0, ""},
+	{0x01FE, idnaMapped, 1, ""},
+	{0x01FF, idnaValid, 0, ""},
+	{0x0200, idnaMapped, 1, ""},
+	{0x0201, idnaValid, 0, ""},
+	{0x0202, idnaMapped, 1, ""},
+	{0x0203, idnaValid, 0, ""},
+	{0x0204, idnaMapped, 1, ""},
+	{0x0205, idnaValid, 0, ""},
+	{0x0206, idnaMapped, 1, ""},
+	{0x0207, idnaValid, 0, ""},
+	{0x0208, idnaMapped, 1, ""},
+	{0x0209, idnaValid, 0, ""},
+	{0x020A, idnaMapped, 1, ""},
+	{0x020B, idnaValid, 0, ""},
+	{0x020C, idnaMapped, 1, ""},
+	{0x020D, idnaValid, 0, ""},
+	{0x020E, idnaMapped, 1, ""},
+	{0x020F, idnaValid, 0, ""},
+	{0x0210, idnaMapped, 1, ""},
+	{0x0211, idnaValid, 0, ""},
+	{0x0212, idnaMapped, 1, ""},
+	{0x0213, idnaValid, 0, ""},
+	{0x0214, idnaMapped, 1, ""},
+	{0x0215, idnaValid, 0, ""},
+	{0x0216, idnaMapped, 1, ""},
+	{0x0217, idnaValid, 0, ""},
+	{0x0218, idnaMapped, 1, ""},
+	{0x0219, idnaValid, 0, ""},
+	{0x021A, idnaMapped, 1, ""},
+	{0x021B, idnaValid, 0, ""},
+	{0x021C, idnaMapped, 1, ""},
+	{0x021D, idnaValid, 0, ""},
+	{0x021E, idnaMapped, 1, ""},
+	{0x021F, idnaValid, 0, ""},
+	{0x0220, idnaMapped, -130, ""},
+	{0x0221, idnaValid, 0, ""},
+	{0x0222, idnaMapped, 1, ""},
+	{0x0223, idnaValid, 0, ""},
+	{0x0224, idnaMapped, 1, ""},
+	{0x0225, idnaValid, 0, ""},
+	{0x0226, idnaMapped, 1, ""},
+	{0x0227, idnaValid, 0, ""},
+	{0x0228, idnaMapped, 1, ""},
+	{0x0229, idnaValid, 0, ""},
+	{0x022A, idnaMapped, 1, ""},
+	{0x022B, idnaValid, 0, ""},
+	{0x022C, idnaMapped, 1, ""},
+	{0x022D, idnaValid, 0, ""},
+	{0x022E, idnaMapped, 1, ""},
+	{0x022F, idnaValid, 0, ""},
+	{0x0230, idnaMapped, 1, ""},
+	{0x0231, idnaValid, 0, ""},
+	{0x0232, idnaMapped, 1, ""},
+	{0x0233, idnaValid, 0, ""},
+	{0x023A, idnaMapped, 10795, ""},
+	{0x023B, idnaMapped, 1, ""},
+	{0x023C, idnaValid, 0, ""},
+	{0x023D, idnaMapped, -163, ""},
+	{0x023E, idnaMapped, 10792, ""},
+	{0x023F, idnaValid, 0, ""},
+	{0x0241, idnaMapped, 1, ""},
+	{0x0242, idnaValid, 0, ""},
+	{0x0243, idnaMapped, -195, ""},
+	{0x0244, idnaMapped, 69, ""},
+	{0x0245, idnaMapped, 71, ""},
+	{0x0246, idnaMapped, 1, ""},
+	{0x0247, idnaValid, 0, ""},
+	{0x0248, idnaMapped, 1, ""},
+	{0x0249, idnaValid, 0, ""},
+	{0x024A, idnaMapped, 1, ""},
+	{0x024B, idnaValid, 0, ""},
+	{0x024C, idnaMapped, 1, ""},
+	{0x024D, idnaValid, 0, ""},
+	{0x024E, idnaMapped, 1, ""},
+	{0x024F, idnaValid, 0, ""},
+	{0x02B0, idnaMapped, -584, ""},
+	{0x02B1, idnaMapped, -75, ""},
+	{0x02B2, idnaMapped, -584, ""},
+	{0x02B3, idnaMapped, -577, ""},
+	{0x02B4, idnaMapped, -59, ""},
+	{0x02B5, idnaMapped, -58, ""},
+	{0x02B6, idnaMapped, -53, ""},
+	{0x02B7, idnaMapped, -576, ""},
+	{0x02B8, idnaMapped, -575, ""},
+	{0x02B9, idnaValid, 0, ""},
+	{0x02C2, idnaValidNV8, 0, ""},
+	{0x02C6, idnaValid, 0, ""},
+	{0x02D2, idnaValidNV8, 0, ""},
+	{0x02D8, idnaDisallowed, 0, ""},
+	{0x02DE, idnaValidNV8, 0, ""},
+	{0x02E0, idnaMapped, -125, ""},
+	{0x02E1, idnaMapped, -629, ""},
+	{0x02E2, idnaMapped, -623, ""},
+	{0x02E3, idnaMapped, -619, ""},
+	{0x02E4, idnaMapped, -79, ""},
+	{0x02E5, idnaValidNV8, 0, ""},
+	{0x02EC, idnaValid, 0, ""},
+	{0x02ED, idnaValidNV8, 0, ""},
+	{0x02EE, idnaValid, 0, ""},
+	{0x02EF, idnaValidNV8, 0, ""},
+	{0x0300, idnaValid, 0, ""},
+	{0x0340, idnaMapped, -64, ""},
+	{0x0342, idnaValid, 0, ""},
+	{0x0343, idnaMapped, -48, ""},
+	{0x0344, idnaMapped, 0, "\u0308\u0301"},
+	{0x0345, idnaMapped, 116, ""},
+	{0x0346, idnaValid, 0, ""},
+	{0x034F, idnaIgnored, 0, ""},
+	{0x0350, idnaValid, 0, ""},
+	{0x0370, idnaMapped, 1, ""},
+	{0x0371, idnaValid, 0, ""},
+	{0x0372, idnaMapped, 1, ""},
+	{0x0373, idnaValid, 0, ""},
+	{0x0374, idnaMapped, -187, ""},
+	{0x0375, idnaValid, 0, ""},
+	{0x0376, idnaMapped, 1, ""},
+	{0x0377, idnaValid, 0, ""},
+	{0x0378, idnaDisallowed, 0, ""},
+	{0x037B, idnaValid, 0, ""},
+	{0x037E, idnaDisallowed, 0, ""},
+	{0x037F, idnaMapped, 116, ""},
+	{0x0380, idnaDisallowed, 0, ""},
+	{0x0386, idnaMapped, 38, ""},
+	{0x0387, idnaMapped, -720, ""},
+	{0x0388, idnaMapped, 37, ""},
+	{0x038B, idnaDisallowed, 0, ""},
+	{0x038C, idnaMapped, 64, ""},
+	{0x038D, idnaDisallowed, 0, ""},
+	{0x038E, idnaMapped, 63, ""},
+	{0x0390, idnaValid, 0, ""},
+	{0x0391, idnaMapped, 32, ""},
+	{0x03A2, idnaDisallowed, 0, ""},
+	{0x03A3, idnaMapped, 32, ""},
+	{0x03AC, idnaValid, 0, ""},
+	{0x03CF, idnaMapped, 8, ""},
+	{0x03D0, idnaMapped, -30, ""},
+	{0x03D1, idnaMapped, -25, ""},
+	{0x03D2, idnaMapped, -13, ""},
+	{0x03D3, idnaMapped, -6, ""},
+	{0x03D4, idnaMapped, -9, ""},
+	{0x03D5, idnaMapped, -15, ""},
+	{0x03D6, idnaMapped, -22, ""},
+	{0x03D7, idnaValid, 0, ""},
+	{0x03D8, idnaMapped, 1, ""},
+	{0x03D9, idnaValid, 0, ""},
+	{0x03DA, idnaMapped, 1, ""},
+	{0x03DB, idnaValid, 0, ""},
+	{0x03DC, idnaMapped, 1, ""},
+	{0x03DD, idnaValid, 0, ""},
+	{0x03DE, idnaMapped, 1, ""},
+	{0x03DF, idnaValid, 0, ""},
+	{0x03E0, idnaMapped, 1, ""},
+	{0x03E1, idnaValid, 0, ""},
+	{0x03E2, idnaMapped, 1, ""},
+	{0x03E3, idnaValid, 0, ""},
+	{0x03E4, idnaMapped, 1, ""},
+	{0x03E5, idnaValid, 0, ""},
+	{0x03E6, idnaMapped, 1, ""},
+	{0x03E7, idnaValid, 0, ""},
+	{0x03E8, idnaMapped, 1, ""},
+	{0x03E9, idnaValid, 0, ""},
+	{0x03EA, idnaMapped, 1, ""},
+	{0x03EB, idnaValid, 0, ""},
+	{0x03EC, idnaMapped, 1, ""},
+	{0x03ED, idnaValid, 0, ""},
+	{0x03EE, idnaMapped, 1, ""},
+	{0x03EF, idnaValid, 0, ""},
+	{0x03F0, idnaMapped, -54, ""},
+	{0x03F1, idnaMapped, -48, ""},
+	{0x03F2, idnaMapped, -47, ""},
+	{0x03F3, idnaValid, 0, ""},
+	{0x03F4, idnaMapped, -60, ""},
+	{0x03F5, idnaMapped, -64, ""},
+	{0x03F6, idnaValidNV8, 0, ""},
+	{0x03F7, idnaMapped, 1, ""},
+	{0x03F8, idnaValid, 0, ""},
+	{0x03F9, idnaMapped, -54, ""},
+	{0x03FA, idnaMapped, 1, ""},
+	{0x03FB, idnaValid, 0, ""},
+	{0x03FD, idnaMapped, -130, ""},
+	{0x0400, idnaMapped, 80, ""},
+	{0x0410, idnaMapped, 32, ""},
+	{0x0430, idnaValid, 0, ""},
+	{0x0460, idnaMapped, 1, ""},
+	{0x0461, idnaValid, 0, ""},
+	{0x0462, idnaMapped, 1, ""},
+	{0x0463, idnaValid, 0, ""},
+	{0x0464, idnaMapped, 1, ""},
+	{0x0465, idnaValid, 0, ""},
+	{0x0466, idnaMapped, 1, ""},
+	{0x0467, idnaValid, 0, ""},
+	{0x0468, idnaMapped, 1, ""},
+	{0x0469, idnaValid, 0, ""},
+	{0x046A, idnaMapped, 1, ""},
+	{0x046B, idnaValid, 0, ""},
+	{0x046C, idnaMapped, 1, ""},
+	{0x046D, idnaValid, 0, ""},
+	{0x046E, idnaMapped, 1, ""},
+	{0x046F, idnaValid, 0, ""},
+	{0x0470, idnaMapped, 1, ""},
+	{0x0471, idnaValid, 0, ""},
+	{0x0472, idnaMapped, 1, ""},
+	{0x0473, idnaValid, 0, ""},
+	{0x0474, idnaMapped, 1, ""},
+	{0x0475, idnaValid, 0, ""},
+	{0x0476, idnaMapped, 1, ""},
+	{0x0477, idnaValid, 0, ""},
+	{0x0478, idnaMapped, 1, ""},
+	{0x0479, idnaValid, 0, ""},
+	{0x047A, idnaMapped, 1, ""},
+	{0x047B, idnaValid, 0, ""},
+	{0x047C, idnaMapped, 1, ""},
+	{0x047D, idnaValid, 0, ""},
+	{0x047E, idnaMapped, 1, ""},
+	{0x047F, idnaValid, 0, ""},
+	{0x0480, idnaMapped, 1, ""},
+	{0x0481, idnaValid, 0, ""},
+	{0x0482, idnaValidNV8, 0, ""},
+	{0x0483, idnaValid, 0, ""},
+	{0x0488, idnaValidNV8, 0, ""},
+	{0x048A, idnaMapped, 1, ""},
+	{0x048B, idnaValid, 0, ""},
+	{0x048C, idnaMapped, 1, ""},
+	{0x048D, idnaValid, 0, ""},
+	{0x048E, idnaMapped, 1, ""},
+	{0x048F, idnaValid, 0, ""},
+	{0x0490, idnaMapped, 1, ""},
+	{0x0491, idnaValid, 0, ""},
+	{0x0492, idnaMapped, 1, ""},
+	{0x0493, idnaValid, 0, ""},
+	{0x0494, idnaMapped, 1, ""},
+	{0x0495, idnaValid, 0, ""},
+	{0x0496, idnaMapped, 1, ""},
+	{0x0497, idnaValid, 0, ""},
+	{0x0498, idnaMapped, 1, ""},
+	{0x0499, idnaValid, 0, ""},
+	{0x049A, idnaMapped, 1, ""},
+	{0x049B, idnaValid, 0, ""},
+	{0x049C, idnaMapped, 1, ""},
+	{0x049D, idnaValid, 0, ""},
+	{0x049E, idnaMapped, 1, ""},
+	{0x049F, idnaValid, 0, ""},
+	{0x04A0, idnaMapped, 1, ""},
+	{0x04A1, idnaValid, 0, ""},
+	{0x04A2, idnaMapped, 1, ""},
+	{0x04A3, idnaValid, 0, ""},
+	{0x04A4, idnaMapped, 1, ""},
+	{0x04A5, idnaValid, 0, ""},
+	{0x04A6, idnaMapped, 1, ""},
+	{0x04A7, idnaValid, 0, ""},
+	{0x04A8, idnaMapped, 1, ""},
+	{0x04A9, idnaValid, 0, ""},
+	{0x04AA, idnaMapped, 1, ""},
+	{0x04AB, idnaValid, 0, ""},
+	{0x04AC, idnaMapped, 1, ""},
+	{0x04AD, idnaValid, 0, ""},
+	{0x04AE, idnaMapped, 1, ""},
+	{0x04AF, idnaValid, 0, ""},
+	{0x04B0, idnaMapped, 1, ""},
+	{0x04B1, idnaValid, 0, ""},
+	{0x04B2, idnaMapped, 1, ""},
+	{0x04B3, idnaValid, 0, ""},
+	{0x04B4, idnaMapped, 1, ""},
+	{0x04B5, idnaValid, 0, ""},
+	{0x04B6, idnaMapped, 1, ""},
+	{0x04B7, idnaValid, 0, ""},
+	{0x04B8, idnaMapped, 1, ""},
+	{0x04B9, idnaValid, 0, ""},
+	{0x04BA, idnaMapped, 1, ""},
+	{0x04BB, idnaValid, 0, ""},
+	{0x04BC, idnaMapped, 1, ""},
+	{0x04BD, idnaValid, 0, ""},
+	{0x04BE, idnaMapped, 1, ""},
+	{0x04BF, idnaValid, 0, ""},
+	{0x04C0, idnaDisallowed, 0, ""},
+	{0x04C1, idnaMapped, 1, ""},
+	{0x04C2, idnaValid, 0, ""},
+	{0x04C3, idnaMapped, 1, ""},
+	{0x04C4, idnaValid, 0, ""},
+	{0x04C5, idnaMapped, 1, ""},
+	{0x04C6, idnaValid, 0, ""},
+	{0x04C7, idnaMapped, 1, ""},
+	{0x04C8, idnaValid, 0, ""},
+	{0x04C9, idnaMapped, 1, ""},
+	{0x04CA, idnaValid, 0, ""},
+	{0x04CB, idnaMapped, 1, ""},
+	{0x04CC, idnaValid, 0, ""},
+	{0x04CD, idnaMapped, 1, ""},
+	{0x04CE, idnaValid, 0, ""},
+	{0x04D0, idnaMapped, 1, ""},
+	{0x04D1, idnaValid, 0, ""},
+	{0x04D2, idnaMapped, 1, ""},
+	{0x04D3, idnaValid, 0, ""},
+	{0x04D4, idnaMapped, 1, ""},
+	{0x04D5, idnaValid, 0, ""},
+	{0x04D6, idnaMapped, 1, ""},
+	{0x04D7, idnaValid, 0, ""},
+	{0x04D8, idnaMapped, 1, ""},
+	{0x04D9, idnaValid, 0, ""},
+	{0x04DA, idnaMapped, 1, ""},
+	{0x04DB, idnaValid, 0, ""},
+	{0x04DC, idnaMapped, 1, ""},
+	{0x04DD, idnaValid, 0, ""},
+	{0x04DE, idnaMapped, 1, ""},
+	{0x04DF, idnaValid, 0, ""},
+	{0x04E0, idnaMapped, 1, ""},
+	{0x04E1, idnaValid, 0, ""},
+	{0x04E2, idnaMapped, 1, ""},
+	{0x04E3, idnaValid, 0, ""},
+	{0x04E4, idnaMapped, 1, ""},
+	{0x04E5, idnaValid, 0, ""},
+	{0x04E6, idnaMapped, 1, ""},
+	{0x04E7, idnaValid, 0, ""},
+	{0x04E8, idnaMapped, 1, ""},
+	{0x04E9, idnaValid, 0, ""},
+	{0x04EA, idnaMapped, 1, ""},
+	{0x04EB, idnaValid, 0, ""},
+	{0x04EC, idnaMapped, 1, ""},
+	{0x04ED, idnaValid, 0, ""},
+	{0x04EE, idnaMapped, 1, ""},
+	{0x04EF, idnaValid, 0, ""},
+	{0x04F0, idnaMapped, 1, ""},
+	{0x04F1, idnaValid, 0, ""},
+	{0x04F2, idnaMapped, 1, ""},
+	{0x04F3, idnaValid, 0, ""},
+	{0x04F4, idnaMapped, 1, ""},
+	{0x04F5, idnaValid, 0, ""},
+	{0x04F6, idnaMapped, 1, ""},
+	{0x04F7, idnaValid, 0, ""},
+	{0x04F8, idnaMapped, 1, ""},
+	{0x04F9, idnaValid, 0, ""},
+	{0x04FA, idnaMapped, 1, ""},
+	{0x04FB, idnaValid, 0, ""},
+	{0x04FC, idnaMapped, 1, ""},
+	{0x04FD, idnaValid, 0, ""},
+	{0x04FE, idnaMapped, 1, ""},
+	{0x04FF, idnaValid, 0, ""},
+	{0x0500, idnaMapped, 1, ""},
+	{0x0501, idnaValid, 0, ""},
+	{0x0502, idnaMapped, 1, ""},
+	{0x0503, idnaValid, 0, ""},
+	{0x0504, idnaMapped, 1, ""},
+	{0x0505, idnaValid, 0, ""},
+	{0x0506, idnaMapped, 1, ""},
+	{0x0507, idnaValid, 0, ""},
+	{0x0508, idnaMapped, 1, ""},
+	{0x0509, idnaValid, 0, ""},
+	{0x050A, idnaMapped, 1, ""},
+	{0x050B, idnaValid, 0, ""},
+	{0x050C, idnaMapped, 1, ""},
+	{0x050D, idnaValid, 0, ""},
+	{0x050E, idnaMapped, 1, ""},
+	{0x050F, idnaValid, 0, ""},
+	{0x0510, idnaMapped, 1, ""},
+	{0x0511, idnaValid, 0, ""},
+	{0x0512, idnaMapped, 1, ""},
+	{0x0513, idnaValid, 0, ""},
+	{0x0514, idnaMapped, 1, ""},
+	{0x0515, idnaValid, 0, ""},
+	{0x0516, idnaMapped, 1, ""},
+	{0x0517, idnaValid, 0, ""},
+	{0x0518, idnaMapped, 1, ""},
+	{0x0519, idnaValid, 0, ""},
+	{0x051A, idnaMapped, 1, ""},
+	{0x051B, idnaValid, 0, ""},
+	{0x051C, idnaMapped, 1, ""},
+	{0x051D, idnaValid, 0, ""},
+	{0x051E, idnaMapped, 1, ""},
+	{0x051F, idnaValid, 0, ""},
+	{0x0520, idnaMapped, 1, ""},
+	{0x0521, idnaValid, 0, ""},
+	{0x0522, idnaMapped, 1, ""},
+	{0x0523, idnaValid, 0, ""},
+	{0x0524, idnaMapped, 1, ""},
+	{0x0525, idnaValid, 0, ""},
+	{0x0526, idnaMapped, 1, ""},
+	{0x0527, idnaValid, 0, ""},
+	{0x0528, idnaMapped, 1, ""},
+	{0x0529, idnaValid, 0, ""},
+	{0x052A, idnaMapped, 1, ""},
+	{0x052B, idnaValid, 0, ""},
+	{0x052C, idnaMapped, 1, ""},
+	{0x052D, idnaValid, 0, ""},
+	{0x052E, idnaMapped, 1, ""},
+	{0x052F, idnaValid, 0, ""},
+	{0x0530, idnaDisallowed, 0, ""},
+	{0x0531, idnaMapped, 48, ""},
+	{0x0557, idnaDisallowed, 0, ""},
+	{0x0559, idnaValid, 0, ""},
+	{0x055A, idnaValidNV8, 0, ""},
+	{0x0560, idnaValid, 0, ""},
+	{0x0587, idnaMapped, 0, "\u0565\u0582"},
+	{0x0588, idnaValid, 0, ""},
+	{0x0589, idnaValidNV8, 0, ""},
+	{0x058B, idnaDisallowed, 0, ""},
+	{0x058D, idnaValidNV8, 0, ""},
+	{0x0590, idnaDisallowed, 0, ""},
+	{0x0591, idnaValid, 0, ""},
+	{0x05BE, idnaValidNV8, 0, ""},
+	{0x05BF, idnaValid, 0, ""},
+	{0x05C0, idnaValidNV8, 0, ""},
+	{0x05C1, idnaValid, 0, ""},
+	{0x05C3, idnaValidNV8, 0, ""},
+	{0x05C4, idnaValid, 0, ""},
+	{0x05C6, idnaValidNV8, 0, ""},
+	{0x05C7, idnaValid, 0, ""},
+	{0x05C8, idnaDisallowed, 0, ""},
+	{0x05D0, idnaValid, 0, ""},
+	{0x05EB, idnaDisallowed, 0, ""},
+	{0x05EF, idnaValid, 0, ""},
+	{0x05F5, idnaDisallowed, 0, ""},
+	{0x0606, idnaValidNV8, 0, ""},
+	{0x0610, idnaValid, 0, ""},
+	{0x061B, idnaValidNV8, 0, ""},
+	{0x061C, idnaDisallowed, 0, ""},
+	{0x061D, idnaValidNV8, 0, ""},
+	{0x0620, idnaValid, 0, ""},
+	{0x0640, idnaValidNV8, 0, ""},
+	{0x0641, idnaValid, 0, ""},
+	{0x066A, idnaValidNV8, 0, ""},
+	{0x066E, idnaValid, 0, ""},
+	{0x0675, idnaMapped, 0, "\u0627\u0674"},
+	{0x0676, idnaMapped, 0, "\u0648\u0674"},
+	{0x0677, idnaMapped, 0, "\u06c7\u0674"},
+	{0x0678, idnaMapped, 0, "\u064a\u0674"},
+	{0x0679, idnaValid, 0, ""},
+	{0x06D4, idnaValidNV8, 0, ""},
+	{0x06D5, idnaValid, 0, ""},
+	{0x06DD, idnaDisallowed, 0, ""},
+	{0x06DE, idnaValidNV8, 0, ""},
+	{0x06DF, idnaValid, 0, ""},
+	{0x06E9, idnaValidNV8, 0, ""},
+	{0x06EA, idnaValid, 0, ""},
+	{0x0700, idnaValidNV8, 0, ""},
+	{0x070E, idnaDisallowed, 0, ""},
+	{0x0710, idnaValid, 0, ""},
+	{0x074B, idnaDisallowed, 0, ""},
+	{0x074D, idnaValid, 0, ""},
+	{0x07B2, idnaDisallowed, 0, ""},
+	{0x07C0, idnaValid, 0, ""},
+	{0x07F6, idnaValidNV8, 0, ""},
+	{0x07FB, idnaDisallowed, 0, ""},
+	{0x07FD, idnaValid, 0, ""},
+	{0x07FE, idnaValidNV8, 0, ""},
+	{0x0800, idnaValid, 0, ""},
+	{0x082E, idnaDisallowed, 0, ""},
+	{0x0830, idnaValidNV8, 0, ""},
+	{0x083F, idnaDisallowed, 0, ""},
+	{0x0840, idnaValid, 0, ""},
+	{0x085C, idnaDisallowed, 0, ""},
+	{0x085E, idnaValidNV8, 0, ""},
+	{0x085F, idnaDisallowed, 0, ""},
+	{0x0860, idnaValid, 0, ""},
+	{0x086B, idnaDisallowed, 0, ""},
+	{0x0870, idnaValid, 0, ""},
+	{0x0888, idnaValidNV8, 0, ""},
+	{0x0889, idnaValid, 0, ""},
+	{0x088F, idnaDisallowed, 0, ""},
+	{0x0898, idnaValid, 0, ""},
+	{0x08E2, idnaDisallowed, 0, ""},
+	{0x08E3, idnaValid, 0, ""},
+	{0x0958, idnaMapped, 0, "\u0915\u093c"},
+	{0x0959, idnaMapped, 0, "\u0916\u093c"},
+	{0x095A, idnaMapped, 0, "\u0917\u093c"},
+	{0x095B, idnaMapped, 0, "\u091c\u093c"},
+	{0x095C, idnaMapped, 0, "\u0921\u093c"},
+	{0x095D, idnaMapped, 0, "\u0922\u093c"},
+	{0x095E, idnaMapped, 0, "\u092b\u093c"},
+	{0x095F, idnaMapped, 0, "\u092f\u093c"},
+	{0x0960, idnaValid, 0, ""},
+	{0x0964, idnaValidNV8, 0, ""},
+	{0x0966, idnaValid, 0, ""},
+	{0x0970, idnaValidNV8, 0, ""},
+	{0x0971, idnaValid, 0, ""},
+	{0x0984, idnaDisallowed, 0, ""},
+	{0x0985, idnaValid, 0, ""},
+	{0x098D, idnaDisallowed, 0, ""},
+	{0x098F, idnaValid, 0, ""},
+	{0x0991, idnaDisallowed, 0, ""},
+	{0x0993, idnaValid, 0, ""},
+	{0x09A9, idnaDisallowed, 0, ""},
+	{0x09AA, idnaValid, 0, ""},
+	{0x09B1, idnaDisallowed, 0, ""},
+	{0x09B2, idnaValid, 0, ""},
+	{0x09B3, idnaDisallowed, 0, ""},
+	{0x09B6, idnaValid, 0, ""},
+	{0x09BA, idnaDisallowed, 0, ""},
+	{0x09BC, idnaValid, 0, ""},
+	{0x09C5, idnaDisallowed, 0, ""},
+	{0x09C7, idnaValid, 0, ""},
+	{0x09C9, idnaDisallowed, 0, ""},
+	{0x09CB, idnaValid, 0, ""},
+	{0x09CF, idnaDisallowed, 0, ""},
+	{0x09D7, idnaValid, 0, ""},
+	{0x09D8, idnaDisallowed, 0, ""},
+	{0x09DC, idnaMapped, 0, "\u09a1\u09bc"},
+	{0x09DD, idnaMapped, 0, "\u09a2\u09bc"},
+	{0x09DE, idnaDisallowed, 0, ""},
+	{0x09DF, idnaMapped, 0, "\u09af\u09bc"},
+	{0x09E0, idnaValid, 0, ""},
+	{0x09E4, idnaDisallowed, 0, ""},
+	{0x09E6, idnaValid, 0, ""},
+	{0x09F2, idnaValidNV8, 0, ""},
+	{0x09FC, idnaValid, 0, ""},
+	{0x09FD, idnaValidNV8, 0, ""},
+	{0x09FE, idnaValid, 0, ""},
+	{0x09FF, idnaDisallowed, 0, ""},
+	{0x0A01, idnaValid, 0, ""},
+	{0x0A04, idnaDisallowed, 0, ""},
+	{0x0A05, idnaValid, 0, ""},
+	{0x0A0B, idnaDisallowed, 0, ""},
+	{0x0A0F, idnaValid, 0, ""},
+	{0x0A11, idnaDisallowed, 0, ""},
+	{0x0A13, idnaValid, 0, ""},
+	{0x0A29, idnaDisallowed, 0, ""},
+	{0x0A2A, idnaValid, 0, ""},
+	{0x0A31, idnaDisallowed, 0, ""},
+	{0x0A32, idnaValid, 0, ""},
+	{0x0A33, idnaMapped, 0, "\u0a32\u0a3c"},
+	{0x0A34, idnaDisallowed, 0, ""},
+	{0x0A35, idnaValid, 0, ""},
+	{0x0A36, idnaMapped, 0, "\u0a38\u0a3c"},
+	{0x0A37, idnaDisallowed, 0, ""},
+	{0x0A38, idnaValid, 0, ""},
+	{0x0A3A, idnaDisallowed, 0, ""},
+	{0x0A3C, idnaValid, 0, ""},
+	{0x0A3D, idnaDisallowed, 0, ""},
+	{0x0A3E, idnaValid, 0, ""},
+	{0x0A43, idnaDisallowed, 0, ""},
+	{0x0A47, idnaValid, 0, ""},
+	{0x0A49, idnaDisallowed, 0, ""},
+	{0x0A4B, idnaValid, 0, ""},
+	{0x0A4E, idnaDisallowed, 0, ""},
+	{0x0A51, idnaValid, 0, ""},
+	{0x0A52, idnaDisallowed, 0, ""},
+	{0x0A59, idnaMapped, 0, "\u0a16\u0a3c"},
+	{0x0A5A, idnaMapped, 0, "\u0a17\u0a3c"},
+	{0x0A5B, idnaMapped, 0, "\u0a1c\u0a3c"},
+	{0x0A5C, idnaValid, 0, ""},
+	{0x0A5D, idnaDisallowed, 0, ""},
+	{0x0A5E, idnaMapped, 0, "\u0a2b\u0a3c"},
+	{0x0A5F, idnaDisallowed, 0, ""},
+	{0x0A66, idnaValid, 0, ""},
+	{0x0A76, idnaValidNV8, 0, ""},
+	{0x0A77, idnaDisallowed, 0, ""},
+	{0x0A81, idnaValid, 0, ""},
+	{0x0A84, idnaDisallowed, 0, ""},
+	{0x0A85, idnaValid, 0, ""},
+	{0x0A8E, idnaDisallowed, 0, ""},
+	{0x0A8F, idnaValid, 0, ""},
+	{0x0A92, idnaDisallowed, 0, ""},
+	{0x0A93, idnaValid, 0, ""},
+	{0x0AA9, idnaDisallowed, 0, ""},
+	{0x0AAA, idnaValid, 0, ""},
+	{0x0AB1, idnaDisallowed, 0, ""},
+	{0x0AB2, idnaValid, 0, ""},
+	{0x0AB4, idnaDisallowed, 0, ""},
+	{0x0AB5, idnaValid, 0, ""},
+	{0x0ABA, idnaDisallowed, 0, ""},
+	{0x0ABC, idnaValid, 0, ""},
+	{0x0AC6, idnaDisallowed, 0, ""},
+	{0x0AC7, idnaValid, 0, ""},
+	{0x0ACA, idnaDisallowed, 0, ""},
+	{0x0ACB, idnaValid, 0, ""},
+	{0x0ACE, idnaDisallowed, 0, ""},
+	{0x0AD0, idnaValid, 0, ""},
+	{0x0AD1, idnaDisallowed, 0, ""},
+	{0x0AE0, idnaValid, 0, ""},
+	{0x0AE4, idnaDisallowed, 0, ""},
+	{0x0AE6, idnaValid, 0, ""},
+	{0x0AF0, idnaValidNV8, 0, ""},
+	{0x0AF2, idnaDisallowed, 0, ""},
+	{0x0AF9, idnaValid, 0, ""},
+	{0x0B00, idnaDisallowed, 0, ""},
+	{0x0B01, idnaValid, 0, ""},
+	{0x0B04, idnaDisallowed, 0, ""},
+	{0x0B05, idnaValid, 0, ""},
+	{0x0B0D, idnaDisallowed, 0, ""},
+	{0x0B0F, idnaValid, 0, ""},
+	{0x0B11, idnaDisallowed, 0, ""},
+	{0x0B13, idnaValid, 0, ""},
+	{0x0B29, idnaDisallowed, 0, ""},
+	{0x0B2A, idnaValid, 0, ""},
+	{0x0B31, idnaDisallowed, 0, ""},
+	{0x0B32, idnaValid, 0, ""},
+	{0x0B34, idnaDisallowed, 0, ""},
+	{0x0B35, idnaValid, 0, ""},
+	{0x0B3A, idnaDisallowed, 0, ""},
+	{0x0B3C, idnaValid, 0, ""},
+	{0x0B45, idnaDisallowed, 0, ""},
+	{0x0B47, idnaValid, 0, ""},
+	{0x0B49, idnaDisallowed, 0, ""},
+	{0x0B4B, idnaValid, 0, ""},
+	{0x0B4E, idnaDisallowed, 0, ""},
+	{0x0B55, idnaValid, 0, ""},
+	{0x0B58, idnaDisallowed, 0, ""},
+	{0x0B5C, idnaMapped, 0, "\u0b21\u0b3c"},
+	{0x0B5D, idnaMapped, 0, "\u0b22\u0b3c"},
+	{0x0B5E, idnaDisallowed, 0, ""},
+	{0x0B5F, idnaValid, 0, ""},
+	{0x0B64, idnaDisallowed, 0, ""},
+	{0x0B66, idnaValid, 0, ""},
+	{0x0B70, idnaValidNV8, 0, ""},
+	{0x0B71, idnaValid, 0, ""},
+	{0x0B72, idnaValidNV8, 0, ""},
+	{0x0B78, idnaDisallowed, 0, ""},
+	{0x0B82, idnaValid, 0, ""},
+	{0x0B84, idnaDisallowed, 0, ""},
+	{0x0B85, idnaValid, 0, ""},
+	{0x0B8B, idnaDisallowed, 0, ""},
+	{0x0B8E, idnaValid, 0, ""},
+	{0x0B91, idnaDisallowed, 0, ""},
+	{0x0B92, idnaValid, 0, ""},
+	{0x0B96, idnaDisallowed, 0, ""},
+	{0x0B99, idnaValid, 0, ""},
+	{0x0B9B, idnaDisallowed, 0, ""},
+	{0x0B9C, idnaValid, 0, ""},
+	{0x0B9D, idnaDisallowed, 0, ""},
+	{0x0B9E, idnaValid, 0, ""},
+	{0x0BA0, idnaDisallowed, 0, ""},
+	{0x0BA3, idnaValid, 0, ""},
+	{0x0BA5, idnaDisallowed, 0, ""},
+	{0x0BA8, idnaValid, 0, ""},
+	{0x0BAB, idnaDisallowed, 0, ""},
+	{0x0BAE, idnaValid, 0, ""},
+	{0x0BBA, idnaDisallowed, 0, ""},
+	{0x0BBE, idnaValid, 0, ""},
+	{0x0BC3, idnaDisallowed, 0, ""},
+	{0x0BC6, idnaValid, 0, ""},
+	{0x0BC9, idnaDisallowed, 0, ""},
+	{0x0BCA, idnaValid, 0, ""},
+	{0x0BCE, idnaDisallowed, 0, ""},
+	{0x0BD0, idnaValid, 0, ""},
+	{0x0BD1, idnaDisallowed, 0, ""},
+	{0x0BD7, idnaValid, 0, ""},
+	{0x0BD8, idnaDisallowed, 0, ""},
+	{0x0BE6, idnaValid, 0, ""},
+	{0x0BF0, idnaValidNV8, 0, ""},
+	{0x0BFB, idnaDisallowed, 0, ""},
+	{0x0C00, idnaValid, 0, ""},
+	{0x0C0D, idnaDisallowed, 0, ""},
+	{0x0C0E, idnaValid, 0, ""},
+	{0x0C11, idnaDisallowed, 0, ""},
+	{0x0C12, idnaValid, 0, ""},
+	{0x0C29, idnaDisallowed, 0, ""},
+	{0x0C2A, idnaValid, 0, ""},
+	{0x0C3A, idnaDisallowed, 0, ""},
+	{0x0C3C, idnaValid, 0, ""},
+	{0x0C45, idnaDisallowed, 0, ""},
+	{0x0C46, idnaValid, 0, ""},
+	{0x0C49, idnaDisallowed, 0, ""},
+	{0x0C4A, idnaValid, 0, ""},
+	{0x0C4E, idnaDisallowed, 0, ""},
+	{0x0C55, idnaValid, 0, ""},
+	{0x0C57, idnaDisallowed, 0, ""},
+	{0x0C58, idnaValid, 0, ""},
+	{0x0C5B, idnaDisallowed, 0, ""},
+	{0x0C5D, idnaValid, 0, ""},
+	{0x0C5E, idnaDisallowed, 0, ""},
+	{0x0C60, idnaValid, 0, ""},
+	{0x0C64, idnaDisallowed, 0, ""},
+	{0x0C66, idnaValid, 0, ""},
+	{0x0C70, idnaDisallowed, 0, ""},
+	{0x0C77, idnaValidNV8, 0, ""},
+	{0x0C80, idnaValid, 0, ""},
+	{0x0C84, idnaValidNV8, 0, ""},
+	{0x0C85, idnaValid, 0, ""},
+	{0x0C8D, idnaDisallowed, 0, ""},
+	{0x0C8E, idnaValid, 0, ""},
+	{0x0C91, idnaDisallowed, 0, ""},
+	{0x0C92, idnaValid, 0, ""},
+	{0x0CA9, idnaDisallowed, 0, ""},
+	{0x0CAA, idnaValid, 0, ""},
+	{0x0CB4, idnaDisallowed, 0, ""},
+	{0x0CB5, idnaValid, 0, ""},
+	{0x0CBA, idnaDisallowed, 0, ""},
+	{0x0CBC, idnaValid, 0, ""},
+	{0x0CC5, idnaDisallowed, 0, ""},
+	{0x0CC6, idnaValid, 0, ""},
+	{0x0CC9, idnaDisallowed, 0, ""},
+	{0x0CCA, idnaValid, 0, ""},
+	{0x0CCE, idnaDisallowed, 0, ""},
+	{0x0CD5, idnaValid, 0, ""},
+	{0x0CD7, idnaDisallowed, 0, ""},
+	{0x0CDD, idnaValid, 0, ""},
+	{0x0CDF, idnaDisallowed, 0, ""},
+	{0x0CE0, idnaValid, 0, ""},
+	{0x0CE4, idnaDisallowed, 0, ""},
+	{0x0CE6, idnaValid, 0, ""},
+	{0x0CF0, idnaDisallowed, 0, ""},
+	{0x0CF1, idnaValid, 0, ""},
+	{0x0CF4, idnaDisallowed, 0, ""},
+	{0x0D00, idnaValid, 0, ""},
+	{0x0D0D, idnaDisallowed, 0, ""},
+	{0x0D0E, idnaValid, 0, ""},
+	{0x0D11, idnaDisallowed, 0, ""},
+	{0x0D12, idnaValid, 0, ""},
+	{0x0D45, idnaDisallowed, 0, ""},
+	{0x0D46, idnaValid, 0, ""},
+	{0x0D49, idnaDisallowed, 0, ""},
+	{0x0D4A, idnaValid, 0, ""},
+	{0x0D4F, idnaValidNV8, 0, ""},
+	{0x0D50, idnaDisallowed, 0, ""},
+	{0x0D54, idnaValid, 0, ""},
+	{0x0D58, idnaValidNV8, 0, ""},
+	{0x0D5F, idnaValid, 0, ""},
+	{0x0D64, idnaDisallowed, 0, ""},
+	{0x0D66, idnaValid, 0, ""},
+	{0x0D70, idnaValidNV8, 0, ""},
+	{0x0D7A, idnaValid, 0, ""},
+	{0x0D80, idnaDisallowed, 0, ""},
+	{0x0D81, idnaValid, 0, ""},
+	{0x0D84, idnaDisallowed, 0, ""},
+	{0x0D85, idnaValid, 0, ""},
+	{0x0D97, idnaDisallowed, 0, ""},
+	{0x0D9A, idnaValid, 0, ""},
+	{0x0DB2, idnaDisallowed, 0, ""},
+	{0x0DB3, idnaValid, 0, ""},
+	{0x0DBC, idnaDisallowed, 0, ""},
+	{0x0DBD, idnaValid, 0, ""},
+	{0x0DBE, idnaDisallowed, 0, ""},
+	{0x0DC0, idnaValid, 0, ""},
+	{0x0DC7, idnaDisallowed, 0, ""},
+	{0x0DCA, idnaValid, 0, ""},
+	{0x0DCB, idnaDisallowed, 0, ""},
+	{0x0DCF, idnaValid, 0, ""},
+	{0x0DD5, idnaDisallowed, 0, ""},
+	{0x0DD6, idnaValid, 0, ""},
+	{0x0DD7, idnaDisallowed, 0, ""},
+	{0x0DD8, idnaValid, 0, ""},
+	{0x0DE0, idnaDisallowed, 0, ""},
+	{0x0DE6, idnaValid, 0, ""},
+	{0x0DF0, idnaDisallowed, 0, ""},
+	{0x0DF2, idnaValid, 0, ""},
+	{0x0DF4, idnaValidNV8, 0, ""},
+	{0x0DF5, idnaDisallowed, 0, ""},
+	{0x0E01, idnaValid, 0, ""},
+	{0x0E33, idnaMapped, 0, "\u0e4d\u0e32"},
+	{0x0E34, idnaValid, 0, ""},
+	{0x0E3B, idnaDisallowed, 0, ""},
+	{0x0E3F, idnaValidNV8, 0, ""},
+	{0x0E40, idnaValid, 0, ""},
+	{0x0E4F, idnaValidNV8, 0, ""},
+	{0x0E50, idnaValid, 0, ""},
+	{0x0E5A, idnaValidNV8, 0, ""},
+	{0x0E5C, idnaDisallowed, 0, ""},
+	{0x0E81, idnaValid, 0, ""},
+	{0x0E83, idnaDisallowed, 0, ""},
+	{0x0E84, idnaValid, 0, ""},
+	{0x0E85, idnaDisallowed, 0, ""},
+	{0x0E86, idnaValid, 0, ""},
+	{0x0E8B, idnaDisallowed, 0, ""},
+	{0x0E8C, idnaValid, 0, ""},
+	{0x0EA4, idnaDisallowed, 0, ""},
+	{0x0EA5, idnaValid, 0, ""},
+	{0x0EA6, idnaDisallowed, 0, ""},
+	{0x0EA7, idnaValid, 0, ""},
+	{0x0EB3, idnaMapped, 0, "\u0ecd\u0eb2"},
+	{0x0EB4, idnaValid, 0, ""},
+	{0x0EBE, idnaDisallowed, 0, ""},
+	{0x0EC0, idnaValid, 0, ""},
+	{0x0EC5, idnaDisallowed, 0, ""},
+	{0x0EC6, idnaValid, 0, ""},
+	{0x0EC7, idnaDisallowed, 0, ""},
+	{0x0EC8, idnaValid, 0, ""},
+	{0x0ECF, idnaDisallowed, 0, ""},
+	{0x0ED0, idnaValid, 0, ""},
+	{0x0EDA, idnaDisallowed, 0, ""},
+	{0x0EDC, idnaMapped, 0, "\u0eab\u0e99"},
+	{0x0EDD, idnaMapped, 0, "\u0eab\u0ea1"},
+	{0x0EDE, idnaValid, 0, ""},
+	{0x0EE0, idnaDisallowed, 0, ""},
+	{0x0F00, idnaValid, 0, ""},
+	{0x0F01, idnaValidNV8, 0, ""},
+	{0x0F0B, idnaValid, 0, ""},
+	{0x0F0C, idnaMapped, -1, ""},
+	{0x0F0D, idnaValidNV8, 0, ""},
+	{0x0F18, idnaValid, 0, ""},
+	{0x0F1A, idnaValidNV8, 0, ""},
+	{0x0F20, idnaValid, 0, ""},
+	{0x0F2A, idnaValidNV8, 0, ""},
+	{0x0F35, idnaValid, 0, ""},
+	{0x0F36, idnaValidNV8, 0, ""},
+	{0x0F37, idnaValid, 0, ""},
+	{0x0F38, idnaValidNV8, 0, ""},
+	{0x0F39, idnaValid, 0, ""},
+	{0x0F3A, idnaValidNV8, 0, ""},
+	{0x0F3E, idnaValid, 0, ""},
+	{0x0F43, idnaMapped, 0, "\u0f42\u0fb7"},
+	{0x0F44, idnaValid, 0, ""},
+	{0x0F48, idnaDisallowed, 0, ""},
+	{0x0F49, idnaValid, 0, ""},
+	{0x0F4D, idnaMapped, 0, "\u0f4c\u0fb7"},
+	{0x0F4E, idnaValid, 0, ""},
+	{0x0F52, idnaMapped, 0, "\u0f51\u0fb7"},
+	{0x0F53, idnaValid, 0, ""},
+	{0x0F57, idnaMapped, 0, "\u0f56\u0fb7"},
+	{0x0F58, idnaValid, 0, ""},
+	{0x0F5C, idnaMapped, 0, "\u0f5b\u0fb7"},
+	{0x0F5D, idnaValid, 0, ""},
+	{0x0F69, idnaMapped, 0, "\u0f40\u0fb5"},
+	{0x0F6A, idnaValid, 0, ""},
+	{0x0F6D, idnaDisallowed, 0, ""},
+	{0x0F71, idnaValid, 0, ""},
+	{0x0F73, idnaMapped, 0, "\u0f71\u0f72"},
+	{0x0F74, idnaValid, 0, ""},
+	{0x0F75, idnaMapped, 0, "\u0f71\u0f74"},
+	{0x0F76, idnaMapped, 0, "\u0fb2\u0f80"},
+	{0x0F77, idnaMapped, 0, "\u0fb2\u0f71\u0f80"},
+	{0x0F78, idnaMapped, 0, "\u0fb3\u0f80"},
+	{0x0F79, idnaMapped, 0, "\u0fb3\u0f71\u0f80"},
+	{0x0F7A, idnaValid, 0, ""},
+	{0x0F81, idnaMapped, 0, "\u0f71\u0f80"},
+	{0x0F82, idnaValid, 0, ""},
+	{0x0F85, idnaValidNV8, 0, ""},
+	{0x0F86, idnaValid, 0, ""},
+	{0x0F93, idnaMapped, 0, "\u0f92\u0fb7"},
+	{0x0F94, idnaValid, 0, ""},
+	{0x0F98, idnaDisallowed, 0, ""},
+	{0x0F99, idnaValid, 0, ""},
+	{0x0F9D, idnaMapped, 0, "\u0f9c\u0fb7"},
+	{0x0F9E, idnaValid, 0, ""},
+	{0x0FA2, idnaMapped, 0, "\u0fa1\u0fb7"},
+	{0x0FA3, idnaValid, 0, ""},
+	{0x0FA7, idnaMapped, 0, "\u0fa6\u0fb7"},
+	{0x0FA8, idnaValid, 0, ""},
+	{0x0FAC, idnaMapped, 0, "\u0fab\u0fb7"},
+	{0x0FAD, idnaValid, 0, ""},
+	{0x0FB9, idnaMapped, 0, "\u0f90\u0fb5"},
+	{0x0FBA, idnaValid, 0, ""},
+	{0x0FBD, idnaDisallowed, 0, ""},
+	{0x0FBE, idnaValidNV8, 0, ""},
+	{0x0FC6, idnaValid, 0, ""},
+	{0x0FC7, idnaValidNV8, 0, ""},
+	{0x0FCD, idnaDisallowed, 0, ""},
+	{0x0FCE, idnaValidNV8, 0, ""},
+	{0x0FDB, idnaDisallowed, 0, ""},
+	{0x1000, idnaValid, 0, ""},
+	{0x104A, idnaValidNV8, 0, ""},
+	{0x1050, idnaValid, 0, ""},
+	{0x109E, idnaValidNV8, 0, ""},
+	{0x10A0, idnaDisallowed, 0, ""},
+	{0x10C7, idnaMapped, 7264, ""},
+	{0x10C8, idnaDisallowed, 0, ""},
+	{0x10CD, idnaMapped, 7264, ""},
+	{0x10CE, idnaDisallowed, 0, ""},
+	{0x10D0, idnaValid, 0, ""},
+	{0x10FB, idnaValidNV8, 0, ""},
+	{0x10FC, idnaMapped, -32, ""},
+	{0x10FD, idnaValid, 0, ""},
+	{0x1100, idnaValidNV8, 0, ""},
+	{0x115F, idnaDisallowed, 0, ""},
+	{0x1161, idnaValidNV8, 0, ""},
+	{0x1200, idnaValid, 0, ""},
+	{0x1249, idnaDisallowed, 0, ""},
+	{0x124A, idnaValid, 0, ""},
+	{0x124E, idnaDisallowed, 0, ""},
+	{0x1250, idnaValid, 0, ""},
+	{0x1257, idnaDisallowed, 0, ""},
+	{0x1258, idnaValid, 0, ""},
+	{0x1259, idnaDisallowed, 0, ""},
+	{0x125A, idnaValid, 0, ""},
+	{0x125E, idnaDisallowed, 0, ""},
+	{0x1260, idnaValid, 0, ""},
+	{0x1289, idnaDisallowed, 0, ""},
+	{0x128A, idnaValid, 0, ""},
+	{0x128E, idnaDisallowed, 0, ""},
+	{0x1290, idnaValid, 0, ""},
+	{0x12B1, idnaDisallowed, 0, ""},
+	{0x12B2, idnaValid, 0, ""},
+	{0x12B6, idnaDisallowed, 0, ""},
+	{0x12B8, idnaValid, 0, ""},
+	{0x12BF, idnaDisallowed, 0, ""},
+	{0x12C0, idnaValid, 0, ""},
+	{0x12C1, idnaDisallowed, 0, ""},
+	{0x12C2, idnaValid, 0, ""},
+	{0x12C6, idnaDisallowed, 0, ""},
+	{0x12C8, idnaValid, 0, ""},
+	{0x12D7, idnaDisallowed, 0, ""},
+	{0x12D8, idnaValid, 0, ""},
+	{0x1311, idnaDisallowed, 0, ""},
+	{0x1312, idnaValid, 0, ""},
+	{0x1316, idnaDisallowed, 0, ""},
+	{0x1318, idnaValid, 0, ""},
+	{0x135B, idnaDisallowed, 0, ""},
+	{0x135D, idnaValid, 0, ""},
+	{0x1360, idnaValidNV8, 0, ""},
+	{0x137D, idnaDisallowed, 0, ""},
+	{0x1380, idnaValid, 0, ""},
+	{0x1390, idnaValidNV8, 0, ""},
+	{0x139A, idnaDisallowed, 0, ""},
+	{0x13A0, idnaValid, 0, ""},
+	{0x13F6, idnaDisallowed, 0, ""},
+	{0x13F8, idnaMapped, -8, ""},
+	{0x13FE, idnaDisallowed, 0, ""},
+	{0x1400, idnaValidNV8, 0, ""},
+	{0x1401, idnaValid, 0, ""},
+	{0x166D, idnaValidNV8, 0, ""},
+	{0x166F, idnaValid, 0, ""},
+	{0x1680, idnaDisallowed, 0, ""},
+	{0x1681, idnaValid, 0, ""},
+	{0x169B, idnaValidNV8, 0, ""},
+	{0x169D, idnaDisallowed, 0, ""},
+	{0x16A0, idnaValid, 0, ""},
+	{0x16EB, idnaValidNV8, 0, ""},
+	{0x16F1, idnaValid, 0, ""},
+	{0x16F9, idnaDisallowed, 0, ""},
+	{0x1700, idnaValid, 0, ""},
+	{0x1716, idnaDisallowed, 0, ""},
+	{0x171F, idnaValid, 0, ""},
+	{0x1735, idnaValidNV8, 0, ""},
+	{0x1737, idnaDisallowed, 0, ""},
+	{0x1740, idnaValid, 0, ""},
+	{0x1754, idnaDisallowed, 0, ""},
+	{0x1760, idnaValid, 0, ""},
+	{0x176D, idnaDisallowed, 0, ""},
+	{0x176E, idnaValid, 0, ""},
+	{0x1771, idnaDisallowed, 0, ""},
+	{0x1772, idnaValid, 0, ""},
+	{0x1774, idnaDisallowed, 0, ""},
+	{0x1780, idnaValid, 0, ""},
+	{0x17B4, idnaDisallowed, 0, ""},
+	{0x17B6, idnaValid, 0, ""},
+	{0x17D4, idnaValidNV8, 0, ""},
+	{0x17D7, idnaValid, 0, ""},
+	{0x17D8, idnaValidNV8, 0, ""},
+	{0x17DC, idnaValid, 0, ""},
+	{0x17DE, idnaDisallowed, 0, ""},
+	{0x17E0, idnaValid, 0, ""},
+	{0x17EA, idnaDisallowed, 0, ""},
+	{0x17F0, idnaValidNV8, 0, ""},
+	{0x17FA, idnaDisallowed, 0, ""},
+	{0x1800, idnaValidNV8, 0, ""},
+	{0x1806, idnaDisallowed, 0, ""},
+	{0x1807, idnaValidNV8, 0, ""},
+	{0x180B, idnaIgnored, 0, ""},
+	{0x180E, idnaDisallowed, 0, ""},
+	{0x180F, idnaIgnored, 0, ""},
+	{0x1810, idnaValid, 0, ""},
+	{0x181A, idnaDisallowed, 0, ""},
+	{0x1820, idnaValid, 0, ""},
+	{0x1879, idnaDisallowed, 0, ""},
+	{0x1880, idnaValid, 0, ""},
+	{0x18AB, idnaDisallowed, 0, ""},
+	{0x18B0, idnaValid, 0, ""},
+	{0x18F6, idnaDisallowed, 0, ""},
+	{0x1900, idnaValid, 0, ""},
+	{0x191F, idnaDisallowed, 0, ""},
+	{0x1920, idnaValid, 0, ""},
+	{0x192C, idnaDisallowed, 0, ""},
+	{0x1930, idnaValid, 0, ""},
+	{0x193C, idnaDisallowed, 0, ""},
+	{0x1940, idnaValidNV8, 0, ""},
+	{0x1941, idnaDisallowed, 0, ""},
+	{0x1944, idnaValidNV8, 0, ""},
+	{0x1946, idnaValid, 0, ""},
+	{0x196E, idnaDisallowed, 0, ""},
+	{0x1970, idnaValid, 0, ""},
+	{0x1975, idnaDisallowed, 0, ""},
+	{0x1980, idnaValid, 0, ""},
+	{0x19AC, idnaDisallowed, 0, ""},
+	{0x19B0, idnaValid, 0, ""},
+	{0x19CA, idnaDisallowed, 0, ""},
+	{0x19D0, idnaValid, 0, ""},
+	{0x19DA, idnaValidNV8, 0, ""},
+	{0x19DB, idnaDisallowed, 0, ""},
+	{0x19DE, idnaValidNV8, 0, ""},
+	{0x1A00, idnaValid, 0, ""},
+	{0x1A1C, idnaDisallowed, 0, ""},
+	{0x1A1E, idnaValidNV8, 0, ""},
+	{0x1A20, idnaValid, 0, ""},
+	{0x1A5F, idnaDisallowed, 0, ""},
+	{0x1A60, idnaValid, 0, ""},
+	{0x1A7D, idnaDisallowed, 0, ""},
+	{0x1A7F, idnaValid, 0, ""},
+	{0x1A8A, idnaDisallowed, 0, ""},
+	{0x1A90, idnaValid, 0, ""},
+	{0x1A9A, idnaDisallowed, 0, ""},
+	{0x1AA0, idnaValidNV8, 0, ""},
+	{0x1AA7, idnaValid, 0, ""},
+	{0x1AA8, idnaValidNV8, 0, ""},
+	{0x1AAE, idnaDisallowed, 0, ""},
+	{0x1AB0, idnaValid, 0, ""},
+	{0x1ABE, idnaValidNV8, 0, ""},
+	{0x1ABF, idnaValid, 0, ""},
+	{0x1ACF, idnaDisallowed, 0, ""},
+	{0x1B00, idnaValid, 0, ""},
+	{0x1B4D, idnaDisallowed, 0, ""},
+	{0x1B50, idnaValid, 0, ""},
+	{0x1B5A, idnaValidNV8, 0, ""},
+	{0x1B6B, idnaValid, 0, ""},
+	{0x1B74, idnaValidNV8, 0, ""},
+	{0x1B7F, idnaDisallowed, 0, ""},
+	{0x1B80, idnaValid, 0, ""},
+	{0x1BF4, idnaDisallowed, 0, ""},
+	{0x1BFC, idnaValidNV8, 0, ""},
+	{0x1C00, idnaValid, 0, ""},
+	{0x1C38, idnaDisallowed, 0, ""},
+	{0x1C3B, idnaValidNV8, 0, ""},
+	{0x1C40, idnaValid, 0, ""},
+	{0x1C4A, idnaDisallowed, 0, ""},
+	{0x1C4D, idnaValid, 0, ""},
+	{0x1C7E, idnaValidNV8, 0, ""},
+	{0x1C80, idnaMapped, -6222, ""},
+	{0x1C81, idnaMapped, -6221, ""},
+	{0x1C82, idnaMapped, -6212, ""},
+	{0x1C83, idnaMapped, -6210, ""},
+	{0x1C85, idnaMapped, -6211, ""},
+	{0x1C86, idnaMapped, -6204, ""},
+	{0x1C87, idnaMapped, -6180, ""},
+	{0x1C88, idnaMapped, 35267, ""},
+	{0x1C89, idnaDisallowed, 0, ""},
+	{0x1C90, idnaMapped, -3008, ""},
+	{0x1CBB, idnaDisallowed, 0, ""},
+	{0x1CBD, idnaMapped, -3008, ""},
+	{0x1CC0, idnaValidNV8, 0, ""},
+	{0x1CC8, idnaDisallowed, 0, ""},
+	{0x1CD0, idnaValid, 0, ""},
+	{0x1CD3, idnaValidNV8, 0, ""},
+	{0x1CD4, idnaValid, 0, ""},
+	{0x1CFB, idnaDisallowed, 0, ""},
+	{0x1D00, idnaValid, 0, ""},
+	{0x1D2C, idnaMapped, -7371, ""},
+	{0x1D2D, idnaMapped, -7239, ""},
+	{0x1D2E, idnaMapped, -7372, ""},
+	{0x1D2F, idnaValid, 0, ""},
+	{0x1D30, idnaMapped, -7372, ""},
+	{0x1D32, idnaMapped, -6997, ""},
+	{0x1D33, idnaMapped, -7372, ""},
+	{0x1D3B, idnaValid, 0, ""},
+	{0x1D3C, idnaMapped, -7373, ""},
+	{0x1D3D, idnaMapped, -6938, ""},
+	{0x1D3E, idnaMapped, -7374, ""},
+	{0x1D3F, idnaMapped, -7373, ""},
+	{0x1D40, idnaMapped, -7372, ""},
+	{0x1D42, idnaMapped, -7371, ""},
+	{0x1D43, idnaMapped, -7394, ""},
+	{0x1D44, idnaMapped, -6900, ""},
+	{0x1D46, idnaMapped, -68, ""},
+	{0x1D47, idnaMapped, -7397, ""},
+	{0x1D48, idnaMapped, -7396, ""},
+	{0x1D4A, idnaMapped, -6897, ""},
+	{0x1D4B, idnaMapped, -6896, ""},
+	{0x1D4D, idnaMapped, -7398, ""},
+	{0x1D4E, idnaValid, 0, ""},
+	{0x1D4F, idnaMapped, -7396, ""},
+	{0x1D50, idnaMapped, -7395, ""},
+	{0x1D51, idnaMapped, -7174, ""},
+	{0x1D52, idnaMapped, -7395, ""},
+	{0x1D53, idnaMapped, -6911, ""},
+	{0x1D54, idnaMapped, -62, ""},
+	{0x1D56, idnaMapped, -7398, ""},
+	{0x1D57, idnaMapped, -7395, ""},
+	{0x1D59, idnaMapped, -60, ""},
+	{0x1D5A, idnaMapped, -6891, ""},
+	{0x1D5B, idnaMapped, -7397, ""},
+	{0x1D5C, idnaMapped, -55, ""},
+	{0x1D5D, idnaMapped, -6571, ""},
+	{0x1D60, idnaMapped, -6554, ""},
+	{0x1D62, idnaMapped, -7417, ""},
+	{0x1D63, idnaMapped, -7409, ""},
+	{0x1D64, idnaMapped, -7407, ""},
+	{0x1D66, idnaMapped, -6580, ""},
+	{0x1D68, idnaMapped, -6567, ""},
+	{0x1D69, idnaMapped, -6563, ""},
+	{0x1D6B, idnaValid, 0, ""},
+	{0x1D78, idnaMapped, -6459, ""},
+	{0x1D79, idnaValid, 0, ""},
+	{0x1D9B, idnaMapped, -6985, ""},
+	{0x1D9C, idnaMapped, -7481, ""},
+	{0x1D9D, idnaMapped, -6984, ""},
+	{0x1D9E, idnaMapped, -7342, ""},
+	{0x1D9F, idnaMapped, -6979, ""},
+	{0x1DA0, idnaMapped, -7482, ""},
+	{0x1DA1, idnaMapped, -6978, ""},
+	{0x1DA2, idnaMapped, -6977, ""},
+	{0x1DA3, idnaMapped, -6974, ""},
+	{0x1DA4, idnaMapped, -6972, ""},
+	{0x1DA7, idnaMapped, -44, ""},
+	{0x1DA8, idnaMapped, -6923, ""},
+	{0x1DA9, idnaMapped, -6972, ""},
+	{0x1DAA, idnaMapped, -37, ""},
+	{0x1DAB, idnaMapped, -6924, ""},
+	{0x1DAC, idnaMapped, -6971, ""},
+	{0x1DAD, idnaMapped, -6973, ""},
+	{0x1DAE, idnaMapped, -6972, ""},
+	{0x1DB2, idnaMapped, -6970, ""},
+	{0x1DB3, idnaMapped, -6961, ""},
+	{0x1DB5, idnaMapped, -7178, ""},
+	{0x1DB6, idnaMapped, -6957, ""},
+	{0x1DB8, idnaMapped, -156, ""},
+	{0x1DB9, idnaMapped, -6958, ""},
+	{0x1DBB, idnaMapped, -7489, ""},
+	{0x1DBC, idnaMapped, -6956, ""},
+	{0x1DBF, idnaMapped, -6663, ""},
+	{0x1DC0, idnaValid, 0, ""},
+	{0x1E00, idnaMapped, 1, ""},
+	{0x1E01, idnaValid, 0, ""},
+	{0x1E02, idnaMapped, 1, ""},
+	{0x1E03, idnaValid, 0, ""},
+	{0x1E04, idnaMapped, 1, ""},
+	{0x1E05, idnaValid, 0, ""},
+	{0x1E06, idnaMapped, 1, ""},
+	{0x1E07, idnaValid, 0, ""},
+	{0x1E08, idnaMapped, 1, ""},
+	{0x1E09, idnaValid, 0, ""},
+	{0x1E0A, idnaMapped, 1, ""},
+	{0x1E0B, idnaValid, 0, ""},
+	{0x1E0C, idnaMapped, 1, ""},
+	{0x1E0D, idnaValid, 0, ""},
+	{0x1E0E, idnaMapped, 1, ""},
+	{0x1E0F, idnaValid, 0, ""},
+	{0x1E10, idnaMapped, 1, ""},
+	{0x1E11, idnaValid, 0, ""},
+	{0x1E12, idnaMapped, 1, ""},
+	{0x1E13, idnaValid, 0, ""},
+	{0x1E14, idnaMapped, 1, ""},
+	{0x1E15, idnaValid, 0, ""},
+	{0x1E16, idnaMapped, 1, ""},
+	{0x1E17, idnaValid, 0, ""},
+	{0x1E18, idnaMapped, 1, ""},
+	{0x1E19, idnaValid, 0, ""},
+	{0x1E1A, idnaMapped, 1, ""},
+	{0x1E1B, idnaValid, 0, ""},
+	{0x1E1C, idnaMapped, 1, ""},
+	{0x1E1D, idnaValid, 0, ""},
+	{0x1E1E, idnaMapped, 1, ""},
+	{0x1E1F, idnaValid, 0, ""},
+	{0x1E20, idnaMapped, 1, ""},
+	{0x1E21, idnaValid, 0, ""},
+	{0x1E22, idnaMapped, 1, ""},
+	{0x1E23, idnaValid, 0, ""},
+	{0x1E24, idnaMapped, 1, ""},
+	{0x1E25, idnaValid, 0, ""},
+	{0x1E26, idnaMapped, 1, ""},
+	{0x1E27, idnaValid, 0, ""},
+	{0x1E28, idnaMapped, 1, ""},
+	{0x1E29, idnaValid, 0, ""},
+	{0x1E2A, idnaMapped, 1, ""},
+	{0x1E2B, idnaValid, 0, ""},
+	{0x1E2C, idnaMapped, 1, ""},
+	{0x1E2D, idnaValid, 0, ""},
+	{0x1E2E, idnaMapped, 1, ""},
+	{0x1E2F, idnaValid, 0, ""},
+	{0x1E30, idnaMapped, 1, ""},
+	{0x1E31, idnaValid, 0, ""},
+	{0x1E32, idnaMapped, 1, ""},
+	{0x1E33, idnaValid, 0, ""},
+	{0x1E34, idnaMapped, 1, ""},
+	{0x1E35, idnaValid, 0, ""},
+	{0x1E36, idnaMapped, 1, ""},
+	{0x1E37, idnaValid, 0, ""},
+	{0x1E38, idnaMapped, 1, ""},
+	{0x1E39, idnaValid, 0, ""},
+	{0x1E3A, idnaMapped, 1, ""},
+	{0x1E3B, idnaValid, 0, ""},
+	{0x1E3C, idnaMapped, 1, ""},
+	{0x1E3D, idnaValid, 0, ""},
+	{0x1E3E, idnaMapped, 1, ""},
+	{0x1E3F, idnaValid, 0, ""},
+	{0x1E40, idnaMapped, 1, ""},
+	{0x1E41, idnaValid, 0, ""},
+	{0x1E42, idnaMapped, 1, ""},
+	{0x1E43, idnaValid, 0, ""},
+	{0x1E44, idnaMapped, 1, ""},
+	{0x1E45, idnaValid, 0, ""},
+	{0x1E46, idnaMapped, 1, ""},
+	{0x1E47, idnaValid, 0, ""},
+	{0x1E48, idnaMapped, 1, ""},
+	{0x1E49, idnaValid, 0, ""},
+	{0x1E4A, idnaMapped, 1, ""},
+	{0x1E4B, idnaValid, 0, ""},
+	{0x1E4C, idnaMapped, 1, ""},
+	{0x1E4D, idnaValid, 0, ""},
+	{0x1E4E, idnaMapped, 1, ""},
+	{0x1E4F, idnaValid, 0, ""},
+	{0x1E50, idnaMapped, 1, ""},
+	{0x1E51, idnaValid, 0, ""},
+	{0x1E52, idnaMapped, 1, ""},
+	{0x1E53, idnaValid, 0, ""},
+	{0x1E54, idnaMapped, 1, ""},
+	{0x1E55, idnaValid, 0, ""},
+	{0x1E56, idnaMapped, 1, ""},
+	{0x1E57, idnaValid, 0, ""},
+	{0x1E58, idnaMapped, 1, ""},
+	{0x1E59, idnaValid, 0, ""},
+	{0x1E5A, idnaMapped, 1, ""},
+	{0x1E5B, idnaValid, 0, ""},
+	{0x1E5C, idnaMapped, 1, ""},
+	{0x1E5D, idnaValid, 0, ""},
+	{0x1E5E, idnaMapped, 1, ""},
+	{0x1E5F, idnaValid, 0, ""},
+	{0x1E60, idnaMapped, 1, ""},
+	{0x1E61, idnaValid, 0, ""},
+	{0x1E62, idnaMapped, 1, ""},
+	{0x1E63, idnaValid, 0, ""},
+	{0x1E64, idnaMapped, 1, ""},
+	{0x1E65, idnaValid, 0, ""},
+	{0x1E66, idnaMapped, 1, ""},
+	{0x1E67, idnaValid, 0, ""},
+	{0x1E68, idnaMapped, 1, ""},
+	{0x1E69, idnaValid, 0, ""},
+	{0x1E6A, idnaMapped, 1, ""},
+	{0x1E6B, idnaValid, 0, ""},
+	{0x1E6C, idnaMapped, 1, ""},
+	{0x1E6D, idnaValid, 0, ""},
+	{0x1E6E, idnaMapped, 1, ""},
+	{0x1E6F, idnaValid, 0, ""},
+	{0x1E70, idnaMapped, 1, ""},
+	{0x1E71, idnaValid, 0, ""},
+	{0x1E72, idnaMapped, 1, ""},
+	{0x1E73, idnaValid, 0, ""},
+	{0x1E74, idnaMapped, 1, ""},
+	{0x1E75, idnaValid, 0, ""},
+	{0x1E76, idnaMapped, 1, ""},
+	{0x1E77, idnaValid, 0, ""},
+	{0x1E78, idnaMapped, 1, ""},
+	{0x1E79, idnaValid, 0, ""},
+	{0x1E7A, idnaMapped, 1, ""},
+	{0x1E7B, idnaValid, 0, ""},
+	{0x1E7C, idnaMapped, 1, ""},
+	{0x1E7D, idnaValid, 0, ""},
+	{0x1E7E, idnaMapped, 1, ""},
+	{0x1E7F, idnaValid, 0, ""},
+	{0x1E80, idnaMapped, 1, ""},
+	{0x1E81, idnaValid, 0, ""},
+	{0x1E82, idnaMapped, 1, ""},
+	{0x1E83, idnaValid, 0, ""},
+	{0x1E84, idnaMapped, 1, ""},
+	{0x1E85, idnaValid, 0, ""},
+	{0x1E86, idnaMapped, 1, ""},
+	{0x1E87, idnaValid, 0, ""},
+	{0x1E88, idnaMapped, 1, ""},
+	{0x1E89, idnaValid, 0, ""},
+	{0x1E8A, idnaMapped, 1, ""},
+	{0x1E8B, idnaValid, 0, ""},
+	{0x1E8C, idnaMapped, 1, ""},
+	{0x1E8D, idnaValid, 0, ""},
+	{0x1E8E, idnaMapped, 1, ""},
+	{0x1E8F, idnaValid, 0, ""},
+	{0x1E90, idnaMapped, 1, ""},
+	{0x1E91, idnaValid, 0, ""},
+	{0x1E92, idnaMapped, 1, ""},
+	{0x1E93, idnaValid, 0, ""},
+	{0x1E94, idnaMapped, 1, ""},
+	{0x1E95, idnaValid, 0, ""},
+	{0x1E9A, idnaMapped, 0, "a\u02be"},
+	{0x1E9B, idnaMapped, -58, ""},
+	{0x1E9C, idnaValid, 0, ""},
+	{0x1E9E, idnaMapped, -7615, ""},
+	{0x1E9F, idnaValid, 0, ""},
+	{0x1EA0, idnaMapped, 1, ""},
+	{0x1EA1, idnaValid, 0, ""},
+	{0x1EA2, idnaMapped, 1, ""},
+	{0x1EA3, idnaValid, 0, ""},
+	{0x1EA4, idnaMapped, 1, ""},
+	{0x1EA5, idnaValid, 0, ""},
+	{0x1EA6, idnaMapped, 1, ""},
+	{0x1EA7, idnaValid, 0, ""},
+	{0x1EA8, idnaMapped, 1, ""},
+	{0x1EA9, idnaValid, 0, ""},
+	{0x1EAA, idnaMapped, 1, ""},
+	{0x1EAB, idnaValid, 0, ""},
+	{0x1EAC, idnaMapped, 1, ""},
+	{0x1EAD, idnaValid, 0, ""},
+	{0x1EAE, idnaMapped, 1, ""},
+	{0x1EAF, idnaValid, 0, ""},
+	{0x1EB0, idnaMapped, 1, ""},
+	{0x1EB1, idnaValid, 0, ""},
+	{0x1EB2, idnaMapped, 1, ""},
+	{0x1EB3, idnaValid, 0, ""},
+	{0x1EB4, idnaMapped, 1, ""},
+	{0x1EB5, idnaValid, 0, ""},
+	{0x1EB6, idnaMapped, 1, ""},
+	{0x1EB7, idnaValid, 0, ""},
+	{0x1EB8, idnaMapped, 1, ""},
+	{0x1EB9, idnaValid, 0, ""},
+	{0x1EBA, idnaMapped, 1, ""},
+	{0x1EBB, idnaValid, 0, ""},
+	{0x1EBC, idnaMapped, 1, ""},
+	{0x1EBD, idnaValid, 0, ""},
+	{0x1EBE, idnaMapped, 1, ""},
+	{0x1EBF, idnaValid, 0, ""},
+	{0x1EC0, idnaMapped, 1, ""},
+	{0x1EC1, idnaValid, 0, ""},
+	{0x1EC2, idnaMapped, 1, ""},
+	{0x1EC3, idnaValid, 0, ""},
+	{0x1EC4, idnaMapped, 1, ""},
+	{0x1EC5, idnaValid, 0, ""},
+	{0x1EC6, idnaMapped, 1, ""},
+	{0x1EC7, idnaValid, 0, ""},
+	{0x1EC8, idnaMapped, 1, ""},
+	{0x1EC9, idnaValid, 0, ""},
+	{0x1ECA, idnaMapped, 1, ""},
+	{0x1ECB, idnaValid, 0, ""},
+	{0x1ECC, idnaMapped, 1, ""},
+	{0x1ECD, idnaValid, 0, ""},
+	{0x1ECE, idnaMapped, 1, ""},
+	{0x1ECF, idnaValid, 0, ""},
+	{0x1ED0, idnaMapped, 1, ""},
+	{0x1ED1, idnaValid, 0, ""},
+	{0x1ED2, idnaMapped, 1, ""},
+	{0x1ED3, idnaValid, 0, ""},
+	{0x1ED4, idnaMapped, 1, ""},
+	{0x1ED5, idnaValid, 0, ""},
+	{0x1ED6, idnaMapped, 1, ""},
+	{0x1ED7, idnaValid, 0, ""},
+	{0x1ED8, idnaMapped, 1, ""},
+	{0x1ED9, idnaValid, 0, ""},
+	{0x1EDA, idnaMapped, 1, ""},
+	{0x1EDB, idnaValid, 0, ""},
+	{0x1EDC, idnaMapped, 1, ""},
+	{0x1EDD, idnaValid, 0, ""},
+	{0x1EDE, idnaMapped, 1, ""},
+	{0x1EDF, idnaValid, 0, ""},
+	{0x1EE0, idnaMapped, 1, ""},
+	{0x1EE1, idnaValid, 0, ""},
+	{0x1EE2, idnaMapped, 1, ""},
+	{0x1EE3, idnaValid, 0, ""},
+	{0x1EE4, idnaMapped, 1, ""},
+	{0x1EE5, idnaValid, 0, ""},
+	{0x1EE6, idnaMapped, 1, ""},
+	{0x1EE7, idnaValid, 0, ""},
+	{0x1EE8, idnaMapped, 1, ""},
+	{0x1EE9, idnaValid, 0, ""},
+	{0x1EEA, idnaMapped, 1, ""},
+	{0x1EEB, idnaValid, 0, ""},
+	{0x1EEC, idnaMapped, 1, ""},
+	{0x1EED, idnaValid, 0, ""},
+	{0x1EEE, idnaMapped, 1, ""},
+	{0x1EEF, idnaValid, 0, ""},
+	{0x1EF0, idnaMapped, 1, ""},
+	{0x1EF1, idnaValid, 0, ""},
+	{0x1EF2, idnaMapped, 1, ""},
+	{0x1EF3, idnaValid, 0, ""},
+	{0x1EF4, idnaMapped, 1, ""},
+	{0x1EF5, idnaValid, 0, ""},
+	{0x1EF6, idnaMapped, 1, ""},
+	{0x1EF7, idnaValid, 0, ""},
+	{0x1EF8, idnaMapped, 1, ""},
+	{0x1EF9, idnaValid, 0, ""},
+	{0x1EFA, idnaMapped, 1, ""},
+	{0x1EFB, idnaValid, 0, ""},
+	{0x1EFC, idnaMapped, 1, ""},
+	{0x1EFD, idnaValid, 0, ""},
+	{0x1EFE, idnaMapped, 1, ""},
+	{0x1EFF, idnaValid, 0, ""},
+	{0x1F08, idnaMapped, -8, ""},
+	{0x1F10, idnaValid, 0, ""},
+	{0x1F16, idnaDisallowed, 0, ""},
+	{0x1F18, idnaMapped, -8, ""},
+	{0x1F1E, idnaDisallowed, 0, ""},
+	{0x1F20, idnaValid, 0, ""},
+	{0x1F28, idnaMapped, -8, ""},
+	{0x1F30, idnaValid, 0, ""},
+	{0x1F38, idnaMapped, -8, ""},
+	{0x1F40, idnaValid, 0, ""},
+	{0x1F46, idnaDisallowed, 0, ""},
+	{0x1F48, idnaMapped, -8, ""},
+	{0x1F4E, idnaDisallowed, 0, ""},
+	{0x1F50, idnaValid, 0, ""},
+	{0x1F58, idnaDisallowed, 0, ""},
+	{0x1F59, idnaMapped, -8, ""},
+	{0x1F5A, idnaDisallowed, 0, ""},
+	{0x1F5B, idnaMapped, -8, ""},
+	{0x1F5C, idnaDisallowed, 0, ""},
+	{0x1F5D, idnaMapped, -8, ""},
+	{0x1F5E, idnaDisallowed, 0, ""},
+	{0x1F5F, idnaMapped, -8, ""},
+	{0x1F60, idnaValid, 0, ""},
+	{0x1F68, idnaMapped, -8, ""},
+	{0x1F70, idnaValid, 0, ""},
+	{0x1F71, idnaMapped, -7109, ""},
+	{0x1F72, idnaValid, 0, ""},
+	{0x1F73, idnaMapped, -7110, ""},
+	{0x1F74, idnaValid, 0, ""},
+	{0x1F75, idnaMapped, -7111, ""},
+	{0x1F76, idnaValid, 0, ""},
+	{0x1F77, idnaMapped, -7112, ""},
+	{0x1F78, idnaValid, 0, ""},
+	{0x1F79, idnaMapped, -7085, ""},
+	{0x1F7A, idnaValid, 0, ""},
+	{0x1F7B, idnaMapped, -7086, ""},
+	{0x1F7C, idnaValid, 0, ""},
+	{0x1F7D, idnaMapped, -7087, ""},
+	{0x1F7E, idnaDisallowed, 0, ""},
+	{0x1F80, idnaMapped, 0, "\u1f00\u03b9"},
+	{0x1F81, idnaMapped, 0, "\u1f01\u03b9"},
+	{0x1F82, idnaMapped, 0, "\u1f02\u03b9"},
+	{0x1F83, idnaMapped, 0, "\u1f03\u03b9"},
+	{0x1F84, idnaMapped, 0, "\u1f04\u03b9"},
+	{0x1F85, idnaMapped, 0, "\u1f05\u03b9"},
+	{0x1F86, idnaMapped, 0, "\u1f06\u03b9"},
+	{0x1F87, idnaMapped, 0, "\u1f07\u03b9"},
+	{0x1F88, idnaMapped, 0, "\u1f00\u03b9"},
+	{0x1F89, idnaMapped, 0, "\u1f01\u03b9"},
+	{0x1F8A, idnaMapped, 0, "\u1f02\u03b9"},
+	{0x1F8B, idnaMapped, 0, "\u1f03\u03b9"},
+	{0x1F8C, idnaMapped, 0, "\u1f04\u03b9"},
+	{0x1F8D, idnaMapped, 0, "\u1f05\u03b9"},
+	{0x1F8E, idnaMapped, 0, "\u1f06\u03b9"},
+	{0x1F8F, idnaMapped, 0, "\u1f07\u03b9"},
+	{0x1F90, idnaMapped, 0, "\u1f20\u03b9"},
+	{0x1F91, idnaMapped, 0, "\u1f21\u03b9"},
+	{0x1F92, idnaMapped, 0, "\u1f22\u03b9"},
+	{0x1F93, idnaMapped, 0, "\u1f23\u03b9"},
+	{0x1F94, idnaMapped, 0, "\u1f24\u03b9"},
+	{0x1F95, idnaMapped, 0, "\u1f25\u03b9"},
+	{0x1F96, idnaMapped, 0, "\u1f26\u03b9"},
+	{0x1F97, idnaMapped, 0, "\u1f27\u03b9"},
+	{0x1F98, idnaMapped, 0, "\u1f20\u03b9"},
+	{0x1F99, idnaMapped, 0, "\u1f21\u03b9"},
+	{0x1F9A, idnaMapped, 0, "\u1f22\u03b9"},
+	{0x1F9B, idnaMapped, 0, "\u1f23\u03b9"},
+	{0x1F9C, idnaMapped, 0, "\u1f24\u03b9"},
+	{0x1F9D, idnaMapped, 0, "\u1f25\u03b9"},
+	{0x1F9E, idnaMapped, 0, "\u1f26\u03b9"},
+	{0x1F9F, idnaMapped, 0, "\u1f27\u03b9"},
+	{0x1FA0, idnaMapped, 0, "\u1f60\u03b9"},
+	{0x1FA1, idnaMapped, 0, "\u1f61\u03b9"},
+	{0x1FA2, idnaMapped, 0, "\u1f62\u03b9"},
+	{0x1FA3, idnaMapped, 0, "\u1f63\u03b9"},
+	{0x1FA4, idnaMapped, 0, "\u1f64\u03b9"},
+	{0x1FA5, idnaMapped, 0, "\u1f65\u03b9"},
+	{0x1FA6, idnaMapped, 0, "\u1f66\u03b9"},
+	{0x1FA7, idnaMapped, 0, "\u1f67\u03b9"},
+	{0x1FA8, idnaMapped, 0, "\u1f60\u03b9"},
+	{0x1FA9, idnaMapped, 0, "\u1f61\u03b9"},
+	{0x1FAA, idnaMapped, 0, "\u1f62\u03b9"},
+	{0x1FAB, idnaMapped, 0, "\u1f63\u03b9"},
+	{0x1FAC, idnaMapped, 0, "\u1f64\u03b9"},
+	{0x1FAD, idnaMapped, 0, "\u1f65\u03b9"},
+	{0x1FAE, idnaMapped, 0, "\u1f66\u03b9"},
+	{0x1FAF, idnaMapped, 0, "\u1f67\u03b9"},
+	{0x1FB0, idnaValid, 0, ""},
+	{0x1FB2, idnaMapped, 0, "\u1f70\u03b9"},
+	{0x1FB3, idnaMapped, 0, "\u03b1\u03b9"},
+	{0x1FB4, idnaMapped, 0, "\u03ac\u03b9"},
+	{0x1FB5, idnaDisallowed, 0, ""},
+	{0x1FB6, idnaValid, 0, ""},
+	{0x1FB7, idnaMapped, 0, "\u1fb6\u03b9"},
+	{0x1FB8, idnaMapped, -8, ""},
+	{0x1FBA, idnaMapped, -74, ""},
+	{0x1FBB, idnaMapped, -7183, ""},
+	{0x1FBC, idnaMapped, 0, "\u03b1\u03b9"},
+	{0x1FBD, idnaDisallowed, 0, ""},
+	{0x1FBE, idnaMapped, -7173, ""},
+	{0x1FBF, idnaDisallowed, 0, ""},
+	{0x1FC2, idnaMapped, 0, "\u1f74\u03b9"},
+	{0x1FC3, idnaMapped, 0, "\u03b7\u03b9"},
+	{0x1FC4, idnaMapped, 0, "\u03ae\u03b9"},
+	{0x1FC5, idnaDisallowed, 0, ""},
+	{0x1FC6, idnaValid, 0, ""},
+	{0x1FC7, idnaMapped, 0, "\u1fc6\u03b9"},
+	{0x1FC8, idnaMapped, -86, ""},
+	{0x1FC9, idnaMapped, -7196, ""},
+	{0x1FCA, idnaMapped, -86, ""},
+	{0x1FCB, idnaMapped, -7197, ""},
+	{0x1FCC, idnaMapped, 0, "\u03b7\u03b9"},
+	{0x1FCD, idnaDisallowed, 0, ""},
+	{0x1FD0, idnaValid, 0, ""},
+	{0x1FD3, idnaMapped, -7235, ""},
+	{0x1FD4, idnaDisallowed, 0, ""},
+	{0x1FD6, idnaValid, 0, ""},
+	{0x1FD8, idnaMapped, -8, ""},
+	{0x1FDA, idnaMapped, -100, ""},
+	{0x1FDB, idnaMapped, -7212, ""},
+	{0x1FDC, idnaDisallowed, 0, ""},
+	{0x1FE0, idnaValid, 0, ""},
+	{0x1FE3, idnaMapped, -7219, ""},
+	{0x1FE4, idnaValid, 0, ""},
+	{0x1FE8, idnaMapped, -8, ""},
+	{0x1FEA, idnaMapped, -112, ""},
+	{0x1FEB, idnaMapped, -7198, ""},
+	{0x1FEC, idnaMapped, -7, ""},
+	{0x1FED, idnaDisallowed, 0, ""},
+	{0x1FF2, idnaMapped, 0, "\u1f7c\u03b9"},
+	{0x1FF3, idnaMapped, 0, "\u03c9\u03b9"},
+	{0x1FF4, idnaMapped, 0, "\u03ce\u03b9"},
+	{0x1FF5, idnaDisallowed, 0, ""},
+	{0x1FF6, idnaValid, 0, ""},
+	{0x1FF7, idnaMapped, 0, "\u1ff6\u03b9"},
+	{0x1FF8, idnaMapped, -128, ""},
+	{0x1FF9, idnaMapped, -7213, ""},
+	{0x1FFA, idnaMapped, -126, ""},
+	{0x1FFB, idnaMapped, -7213, ""},
+	{0x1FFC, idnaMapped, 0, "\u03c9\u03b9"},
+	{0x1FFD, idnaDisallowed, 0, ""},
+	{0x200B, idnaIgnored, 0, ""},
+	{0x200C, idnaValid, 0, ""},
+	{0x200E, idnaDisallowed, 0, ""},
+	{0x2010, idnaValidNV8, 0, ""},
+	{0x2011, idnaMapped, -1, ""},
+	{0x2012, idnaValidNV8, 0, ""},
+	{0x2017, idnaDisallowed, 0, ""},
+	{0x2018, idnaValidNV8, 0, ""},
+	{0x2024, idnaDisallowed, 0, ""},
+	{0x2027, idnaValidNV8, 0, ""},
+	{0x2028, idnaDisallowed, 0, ""},
+	{0x2030, idnaValidNV8, 0, ""},
+	{0x2033, idnaMapped, 0, "\u2032\u2032"},
+	{0x2034, idnaMapped, 0, "\u2032\u2032\u2032"},
+	{0x2035, idnaValidNV8, 0, ""},
+	{0x2036, idnaMapped, 0, "\u2035\u2035"},
+	{0x2037, idnaMapped, 0, "\u2035\u2035\u2035"},
+	{0x2038, idnaValidNV8, 0, ""},
+	{0x203C, idnaDisallowed, 0, ""},
+	{0x203D, idnaValidNV8, 0, ""},
+	{0x203E, idnaDisallowed, 0, ""},
+	{0x203F, idnaValidNV8, 0, ""},
+	{0x2047, idnaDisallowed, 0, ""},
+	{0x204A, idnaValidNV8, 0, ""},
+	{0x2057, idnaMapped, 0, "\u2032\u2032\u2032\u2032"},
+	{0x2058, idnaValidNV8, 0, ""},
+	{0x205F, idnaDisallowed, 0, ""},
+	{0x2060, idnaIgnored, 0, ""},
+	{0x2061, idnaDisallowed, 0, ""},
+	{0x2064, idnaIgnored, 0, ""},
+	{0x2065, idnaDisallowed, 0, ""},
+	{0x2070, idnaMapped, -8256, ""},
+	{0x2071, idnaMapped, -8200, ""},
+	{0x2072, idnaDisallowed, 0, ""},
+	{0x2074, idnaMapped, -8256, ""},
+	{0x207A, idnaDisallowed, 0, ""},
+	{0x207B, idnaMapped, 407, ""},
+	{0x207C, idnaDisallowed, 0, ""},
+	{0x207F, idnaMapped, -8209, ""},
+	{0x2080, idnaMapped, -8272, ""},
+	{0x208A, idnaDisallowed, 0, ""},
+	{0x208B, idnaMapped, 391, ""},
+	{0x208C, idnaDisallowed, 0, ""},
+	{0x2090, idnaMapped, -8239, ""},
+	{0x2091, idnaMapped, -8236, ""},
+	{0x2092, idnaMapped, -8227, ""},
+	{0x2093, idnaMapped, -8219, ""},
+	{0x2094, idnaMapped, -7739, ""},
+	{0x2095, idnaMapped, -8237, ""},
+	{0x2096, idnaMapped, -8235, ""},
+	{0x209A, idnaMapped, -8234, ""},
+	{0x209B, idnaMapped, -8232, ""},
+	{0x209D, idnaDisallowed, 0, ""},
+	{0x20A0, idnaValidNV8, 0, ""},
+	{0x20A8, idnaMapped, 0, "rs"},
+	{0x20A9, idnaValidNV8, 0, ""},
+	{0x20C1, idnaDisallowed, 0, ""},
+	{0x20D0, idnaValidNV8, 0, ""},
+	{0x20F1, idnaDisallowed, 0, ""},
+	{0x2102, idnaMapped, -8351, ""},
+	{0x2103, idnaMapped, 0, "\u00b0c"},
+	{0x2104, idnaValidNV8, 0, ""},
+	{0x2105, idnaDisallowed, 0, ""},
+	{0x2107, idnaMapped, -7852, ""},
+	{0x2108, idnaValidNV8, 0, ""},
+	{0x2109, idnaMapped, 0, "\u00b0f"},
+	{0x210A, idnaMapped, -8355, ""},
+	{0x210C, idnaMapped, -8356, ""},
+	{0x210D, idnaMapped, -8357, ""},
+	{0x210E, idnaMapped, -8358, ""},
+	{0x210F, idnaMapped, -8168, ""},
+	{0x2110, idnaMapped, -8359, ""},
+	{0x2111, idnaMapped, -8360, ""},
+	{0x2112, idnaMapped, -8358, ""},
+	{0x2113, idnaMapped, -8359, ""},
+	{0x2114, idnaValidNV8, 0, ""},
+	{0x2115, idnaMapped, -8359, ""},
+	{0x2116, idnaMapped, 0, "no"},
+	{0x2117, idnaValidNV8, 0, ""},
+	{0x2119, idnaMapped, -8361, ""},
+	{0x211C, idnaMapped, -8362, ""},
+	{0x211D, idnaMapped, -8363, ""},
+	{0x211E, idnaValidNV8, 0, ""},
+	{0x2120, idnaMapped, 0, "sm"},
+	{0x2121, idnaMapped, 0, "tel"},
+	{0x2122, idnaMapped, 0, "tm"},
+	{0x2123, idnaValidNV8, 0, ""},
+	{0x2124, idnaMapped, -8362, ""},
+	{0x2125, idnaValidNV8, 0, ""},
+	{0x2126, idnaMapped, -7517, ""},
+	{0x2127, idnaValidNV8, 0, ""},
+	{0x2128, idnaMapped, -8366, ""},
+	{0x2129, idnaValidNV8, 0, ""},
+	{0x212A, idnaMapped, -8383, ""},
+	{0x212B, idnaMapped, -8262, ""},
+	{0x212C, idnaMapped, -8394, ""},
+	{0x212E, idnaValidNV8, 0, ""},
+	{0x212F, idnaMapped, -8394, ""},
+	{0x2130, idnaMapped, -8395, ""},
+	{0x2132, idnaDisallowed, 0, ""},
+	{0x2133, idnaMapped, -8390, ""},
+	{0x2134, idnaMapped, -8389, ""},
+	{0x2135, idnaMapped, -7013, ""},
+	{0x2139, idnaMapped, -8400, ""},
+	{0x213A, idnaValidNV8, 0, ""},
+	{0x213B, idnaMapped, 0, "fax"},
+	{0x213C, idnaMapped, -7548, ""},
+	{0x213D, idnaMapped, -7562, ""},
+	{0x213E, idnaMapped, -7563, ""},
+	{0x213F, idnaMapped, -7551, ""},
+	{0x2140, idnaMapped, 209, ""},
+	{0x2141, idnaValidNV8, 0, ""},
+	{0x2145, idnaMapped, -8417, ""},
+	{0x2146, idnaMapped, -8418, ""},
+	{0x2148, idnaMapped, -8415, ""},
+	{0x214A, idnaValidNV8, 0, ""},
+	{0x214E, idnaValid, 0, ""},
+	{0x214F, idnaValidNV8, 0, ""},
+	{0x2150, idnaMapped, 0, "1\u20447"},
+	{0x2151, idnaMapped, 0, "1\u20449"},
+	{0x2152, idnaMapped, 0, "1\u204410"},
+	{0x2153, idnaMapped, 0, "1\u20443"},
+	{0x2154, idnaMapped, 0, "2\u20443"},
+	{0x2155, idnaMapped, 0, "1\u20445"},
+	{0x2156, idnaMapped, 0, "2\u20445"},
+	{0x2157, idnaMapped, 0, "3\u20445"},
+	{0x2158, idnaMapped, 0, "4\u20445"},
+	{0x2159, idnaMapped, 0, "1\u20446"},
+	{0x215A, idnaMapped, 0, "5\u20446"},
+	{0x215B, idnaMapped, 0, "1\u20448"},
+	{0x215C, idnaMapped, 0, "3\u20448"},
+	{0x215D, idnaMapped, 0, "5\u20448"},
+	{0x215E, idnaMapped, 0, "7\u20448"},
+	{0x215F, idnaMapped, 0, "1\u2044"},
+	{0x2160, idnaMapped, -8439, ""},
+	{0x2161, idnaMapped, 0, "ii"},
+	{0x2162, idnaMapped, 0, "iii"},
+	{0x2163, idnaMapped, 0, "iv"},
+	{0x2164, idnaMapped, -8430, ""},
+	{0x2165, idnaMapped, 0, "vi"},
+	{0x2166, idnaMapped, 0, "vii"},
+	{0x2167, idnaMapped, 0, "viii"},
+	{0x2168, idnaMapped, 0, "ix"},
+	{0x2169, idnaMapped, -8433, ""},
+	{0x216A, idnaMapped, 0, "xi"},
+	{0x216B, idnaMapped, 0, "xii"},
+	{0x216C, idnaMapped, -8448, ""},
+	{0x216D, idnaMapped, -8458, ""},
+	{0x216F, idnaMapped, -8450, ""},
+	{0x2170, idnaMapped, -8455, ""},
+	{0x2171, idnaMapped, 0, "ii"},
+	{0x2172, idnaMapped, 0, "iii"},
+	{0x2173, idnaMapped, 0, "iv"},
+	{0x2174, idnaMapped, -8446, ""},
+	{0x2175, idnaMapped, 0, "vi"},
+	{0x2176, idnaMapped, 0, "vii"},
+	{0x2177, idnaMapped, 0, "viii"},
+	{0x2178, idnaMapped, 0, "ix"},
+	{0x2179, idnaMapped, -8449, ""},
+	{0x217A, idnaMapped, 0, "xi"},
+	{0x217B, idnaMapped, 0, "xii"},
+	{0x217C, idnaMapped, -8464, ""},
+	{0x217D, idnaMapped, -8474, ""},
+	{0x217F, idnaMapped, -8466, ""},
+	{0x2180, idnaValidNV8, 0, ""},
+	{0x2183, idnaDisallowed, 0, ""},
+	{0x2184, idnaValid, 0, ""},
+	{0x2185, idnaValidNV8, 0, ""},
+	{0x2189, idnaMapped, 0, "0\u20443"},
+	{0x218A, idnaValidNV8, 0, ""},
+	{0x218C, idnaDisallowed, 0, ""},
+	{0x2190, idnaValidNV8, 0, ""},
+	{0x222C, idnaMapped, 0, "\u222b\u222b"},
+	{0x222D, idnaMapped, 0, "\u222b\u222b\u222b"},
+	{0x222E, idnaValidNV8, 0, ""},
+	{0x222F, idnaMapped, 0, "\u222e\u222e"},
+	{0x2230, idnaMapped, 0, "\u222e\u222e\u222e"},
+	{0x2231, idnaValidNV8, 0, ""},
+	{0x2329, idnaMapped, 3295, ""},
+	{0x232B, idnaValidNV8, 0, ""},
+	{0x2427, idnaDisallowed, 0, ""},
+	{0x2440, idnaValidNV8, 0, ""},
+	{0x244B, idnaDisallowed, 0, ""},
+	{0x2460, idnaMapped, -9263, ""},
+	{0x2469, idnaMapped, 0, "10"},
+	{0x246A, idnaMapped, 0, "11"},
+	{0x246B, idnaMapped, 0, "12"},
+	{0x246C, idnaMapped, 0, "13"},
+	{0x246D, idnaMapped, 0, "14"},
+	{0x246E, idnaMapped, 0, "15"},
+	{0x246F, idnaMapped, 0, "16"},
+	{0x2470, idnaMapped, 0, "17"},
+	{0x2471, idnaMapped, 0, "18"},
+	{0x2472, idnaMapped, 0, "19"},
+	{0x2473, idnaMapped, 0, "20"},
+	{0x2474, idnaDisallowed, 0, ""},
+	{0x24B6, idnaMapped, -9301, ""},
+	{0x24D0, idnaMapped, -9327, ""},
+	{0x24EA, idnaMapped, -9402, ""},
+	{0x24EB, idnaValidNV8, 0, ""},
+	{0x2A0C, idnaMapped, 0, "\u222b\u222b\u222b\u222b"},
+	{0x2A0D, idnaValidNV8, 0, ""},
+	{0x2A74, idnaDisallowed, 0, ""},
+	{0x2A77, idnaValidNV8, 0, ""},
+	{0x2ADC, idnaMapped, 0, "\u2add\u0338"},
+	{0x2ADD, idnaValidNV8, 0, ""},
+	{0x2B74, idnaDisallowed, 0, ""},
+	{0x2B76, idnaValidNV8, 0, ""},
+	{0x2B96, idnaDisallowed, 0, ""},
+	{0x2B97, idnaValidNV8, 0, ""},
+	{0x2C00, idnaMapped, 48, ""},
+	{0x2C30, idnaValid, 0, ""},
+	{0x2C60, idnaMapped, 1, ""},
+	{0x2C61, idnaValid, 0, ""},
+	{0x2C62, idnaMapped, -10743, ""},
+	{0x2C63, idnaMapped, -3814, ""},
+	{0x2C64, idnaMapped, -10727, ""},
+	{0x2C65, idnaValid, 0, ""},
+	{0x2C67, idnaMapped, 1, ""},
+	{0x2C68, idnaValid, 0, ""},
+	{0x2C69, idnaMapped, 1, ""},
+	{0x2C6A, idnaValid, 0, ""},
+	{0x2C6B, idnaMapped, 1, ""},
+	{0x2C6C, idnaValid, 0, ""},
+	{0x2C6D, idnaMapped, -10780, ""},
+	{0x2C6E, idnaMapped, -10749, ""},
+	{0x2C6F, idnaMapped, -10783, ""},
+	{0x2C70, idnaMapped, -10782, ""},
+	{0x2C71, idnaValid, 0, ""},
+	{0x2C72, idnaMapped, 1, ""},
+	{0x2C73, idnaValid, 0, ""},
+	{0x2C75, idnaMapped, 1, ""},
+	{0x2C76, idnaValid, 0, ""},
+	{0x2C7C, idnaMapped, -11282, ""},
+	{0x2C7D, idnaMapped, -11271, ""},
+	{0x2C7E, idnaMapped, -10815, ""},
+	{0x2C80, idnaMapped, 1, ""},
+	{0x2C81, idnaValid, 0, ""},
+	{0x2C82, idnaMapped, 1, ""},
+	{0x2C83, idnaValid, 0, ""},
+	{0x2C84, idnaMapped, 1, ""},
+	{0x2C85, idnaValid, 0, ""},
+	{0x2C86, idnaMapped, 1, ""},
+	{0x2C87, idnaValid, 0, ""},
+	{0x2C88, idnaMapped, 1, ""},
+	{0x2C89, idnaValid, 0, ""},
+	{0x2C8A, idnaMapped, 1, ""},
+	{0x2C8B, idnaValid, 0, ""},
+	{0x2C8C, idnaMapped, 1, ""},
+	{0x2C8D, idnaValid, 0, ""},
+	{0x2C8E, idnaMapped, 1, ""},
+	{0x2C8F, idnaValid, 0, ""},
+	{0x2C90, idnaMapped, 1, ""},
+	{0x2C91, idnaValid, 0, ""},
+	{0x2C92, idnaMapped, 1, ""},
+	{0x2C93, idnaValid, 0, ""},
+	{0x2C94, idnaMapped, 1, ""},
+	{0x2C95, idnaValid, 0, ""},
+	{0x2C96, idnaMapped, 1, ""},
+	{0x2C97, idnaValid, 0, ""},
+	{0x2C98, idnaMapped, 1, ""},
+	{0x2C99, idnaValid, 0, ""},
+	{0x2C9A, idnaMapped, 1, ""},
+	{0x2C9B, idnaValid, 0, ""},
+	{0x2C9C, idnaMapped, 1, ""},
+	{0x2C9D, idnaValid, 0, ""},
+	{0x2C9E, idnaMapped, 1, ""},
+	{0x2C9F, idnaValid, 0, ""},
+	{0x2CA0, idnaMapped, 1, ""},
+	{0x2CA1, idnaValid, 0, ""},
+	{0x2CA2, idnaMapped, 1, ""},
+	{0x2CA3, idnaValid, 0, ""},
+	{0x2CA4, idnaMapped, 1, ""},
+	{0x2CA5, idnaValid, 0, ""},
+	{0x2CA6, idnaMapped, 1, ""},
+	{0x2CA7, idnaValid, 0, ""},
+	{0x2CA8, idnaMapped, 1, ""},
+	{0x2CA9, idnaValid, 0, ""},
+	{0x2CAA, idnaMapped, 1, ""},
+	{0x2CAB, idnaValid, 0, ""},
+	{0x2CAC, idnaMapped, 1, ""},
+	{0x2CAD, idnaValid, 0, ""},
+	{0x2CAE, idnaMapped, 1, ""},
+	{0x2CAF, idnaValid, 0, ""},
+	{0x2CB0, idnaMapped, 1, ""},
+	{0x2CB1, idnaValid, 0, ""},
+	{0x2CB2, idnaMapped, 1, ""},
+	{0x2CB3, idnaValid, 0, ""},
+	{0x2CB4, idnaMapped, 1, ""},
+	{0x2CB5, idnaValid, 0, ""},
+	{0x2CB6, idnaMapped, 1, ""},
+	{0x2CB7, idnaValid, 0, ""},
+	{0x2CB8, idnaMapped, 1, ""},
+	{0x2CB9, idnaValid, 0, ""},
+	{0x2CBA, idnaMapped, 1, ""},
+	{0x2CBB, idnaValid, 0, ""},
+	{0x2CBC, idnaMapped, 1, ""},
+	{0x2CBD, idnaValid, 0, ""},
+	{0x2CBE, idnaMapped, 1, ""},
+	{0x2CBF, idnaValid, 0, ""},
+	{0x2CC0, idnaMapped, 1, ""},
+	{0x2CC1, idnaValid, 0, ""},
+	{0x2CC2, idnaMapped, 1, ""},
+	{0x2CC3, idnaValid, 0, ""},
+	{0x2CC4, idnaMapped, 1, ""},
+	{0x2CC5, idnaValid, 0, ""},
+	{0x2CC6, idnaMapped, 1, ""},
+	{0x2CC7, idnaValid, 0, ""},
+	{0x2CC8, idnaMapped, 1, ""},
+	{0x2CC9, idnaValid, 0, ""},
+	{0x2CCA, idnaMapped, 1, ""},
+	{0x2CCB, idnaValid, 0, ""},
+	{0x2CCC, idnaMapped, 1, ""},
+	{0x2CCD, idnaValid, 0, ""},
+	{0x2CCE, idnaMapped, 1, ""},
+	{0x2CCF, idnaValid, 0, ""},
+	{0x2CD0, idnaMapped, 1, ""},
+	{0x2CD1, idnaValid, 0, ""},
+	{0x2CD2, idnaMapped, 1, ""},
+	{0x2CD3, idnaValid, 0, ""},
+	{0x2CD4, idnaMapped, 1, ""},
+	{0x2CD5, idnaValid, 0, ""},
+	{0x2CD6, idnaMapped, 1, ""},
+	{0x2CD7, idnaValid, 0, ""},
+	{0x2CD8, idnaMapped, 1, ""},
+	{0x2CD9, idnaValid, 0, ""},
+	{0x2CDA, idnaMapped, 1, ""},
+	{0x2CDB, idnaValid, 0, ""},
+	{0x2CDC, idnaMapped, 1, ""},
+	{0x2CDD, idnaValid, 0, ""},
+	{0x2CDE, idnaMapped, 1, ""},
+	{0x2CDF, idnaValid, 0, ""},
+	{0x2CE0, idnaMapped, 1, ""},
+	{0x2CE1, idnaValid, 0, ""},
+	{0x2CE2, idnaMapped, 1, ""},
+	{0x2CE3, idnaValid, 0, ""},
+	{0x2CE5, idnaValidNV8, 0, ""},
+	{0x2CEB, idnaMapped, 1, ""},
+	{0x2CEC, idnaValid, 0, ""},
+	{0x2CED, idnaMapped, 1, ""},
+	{0x2CEE, idnaValid, 0, ""},
+	{0x2CF2, idnaMapped, 1, ""},
+	{0x2CF3, idnaValid, 0, ""},
+	{0x2CF4, idnaDisallowed, 0, ""},
+	{0x2CF9, idnaValidNV8, 0, ""},
+	{0x2D00, idnaValid, 0, ""},
+	{0x2D26, idnaDisallowed, 0, ""},
+	{0x2D27, idnaValid, 0, ""},
+	{0x2D28, idnaDisallowed, 0, ""},
+	{0x2D2D, idnaValid, 0, ""},
+	{0x2D2E, idnaDisallowed, 0, ""},
+	{0x2D30, idnaValid, 0, ""},
+	{0x2D68, idnaDisallowed, 0, ""},
+	{0x2D6F, idnaMapped, -14, ""},
+	{0x2D70, idnaValidNV8, 0, ""},
+	{0x2D71, idnaDisallowed, 0, ""},
+	{0x2D7F, idnaValid, 0, ""},
+	{0x2D97, idnaDisallowed, 0, ""},
+	{0x2DA0, idnaValid, 0, ""},
+	{0x2DA7, idnaDisallowed, 0, ""},
+	{0x2DA8, idnaValid, 0, ""},
+	{0x2DAF, idnaDisallowed, 0, ""},
+	{0x2DB0, idnaValid, 0, ""},
+	{0x2DB7, idnaDisallowed, 0, ""},
+	{0x2DB8, idnaValid, 0, ""},
+	{0x2DBF, idnaDisallowed, 0, ""},
+	{0x2DC0, idnaValid, 0, ""},
+	{0x2DC7, idnaDisallowed, 0, ""},
+	{0x2DC8, idnaValid, 0, ""},
+	{0x2DCF, idnaDisallowed, 0, ""},
+	{0x2DD0, idnaValid, 0, ""},
+	{0x2DD7, idnaDisallowed, 0, ""},
+	{0x2DD8, idnaValid, 0, ""},
+	{0x2DDF, idnaDisallowed, 0, ""},
+	{0x2DE0, idnaValid, 0, ""},
+	{0x2E00, idnaValidNV8, 0, ""},
+	{0x2E2F, idnaValid, 0, ""},
+	{0x2E30, idnaValidNV8, 0, ""},
+	{0x2E5E, idnaDisallowed, 0, ""},
+	{0x2E80, idnaValidNV8, 0, ""},
+	{0x2E9A, idnaDisallowed, 0, ""},
+	{0x2E9B, idnaValidNV8, 0, ""},
+	{0x2E9F, idnaMapped, 15662, ""},
+	{0x2EA0, idnaValidNV8, 0, ""},
+	{0x2EF3, idnaMapped, 28844, ""},
+	{0x2EF4, idnaDisallowed, 0, ""},
+	{0x2F00, idnaMapped, 7936, ""},
+	{0x2F01, idnaMapped, 7975, ""},
+	{0x2F02, idnaMapped, 7988, ""},
+	{0x2F03, idnaMapped, 7996, ""},
+	{0x2F04, idnaMapped, 8021, ""},
+	{0x2F05, idnaMapped, 8064, ""},
+	{0x2F06, idnaMapped, 8070, ""},
+	{0x2F07, idnaMapped, 8089, ""},
+	{0x2F08, idnaMapped, 8114, ""},
+	{0x2F09, idnaMapped, 8758, ""},
+	{0x2F0A, idnaMapped, 8795, ""},
+	{0x2F0B, idnaMapped, 8800, ""},
+	{0x2F0C, idnaMapped, 8822, ""},
+	{0x2F0D, idnaMapped, 8841, ""},
+	{0x2F0E, idnaMapped, 8861, ""},
+	{0x2F0F, idnaMapped, 8913, ""},
+	{0x2F10, idnaMapped, 8933, ""},
+	{0x2F11, idnaMapped, 8943, ""},
+	{0x2F12, idnaMapped, 9097, ""},
+	{0x2F13, idnaMapped, 9190, ""},
+	{0x2F14, idnaMapped, 9217, ""},
+	{0x2F15, idnaMapped, 9221, ""},
+	{0x2F16, idnaMapped, 9250, ""},
+	{0x2F17, idnaMapped, 9258, ""},
+	{0x2F18, idnaMapped, 9284, ""},
+	{0x2F19, idnaMapped, 9296, ""},
+	{0x2F1A, idnaMapped, 9320, ""},
+	{0x2F1B, idnaMapped, 9371, ""},
+	{0x2F1C, idnaMapped, 9388, ""},
+	{0x2F1D, idnaMapped, 9414, ""},
+	{0x2F1E, idnaMapped, 10169, ""},
+	{0x2F1F, idnaMapped, 10240, ""},
+	{0x2F20, idnaMapped, 10699, ""},
+	{0x2F21, idnaMapped, 10721, ""},
+	{0x2F22, idnaMapped, 10728, ""},
+	{0x2F23, idnaMapped, 10738, ""},
+	{0x2F24, idnaMapped, 10755, ""},
+	{0x2F25, idnaMapped, 10830, ""},
+	{0x2F26, idnaMapped, 11306, ""},
+	{0x2F27, idnaMapped, 11353, ""},
+	{0x2F28, idnaMapped, 11472, ""},
+	{0x2F29, idnaMapped, 11494, ""},
+	{0x2F2A, idnaMapped, 11512, ""},
+	{0x2F2B, idnaMapped, 11533, ""},
+	{0x2F2C, idnaMapped, 11586, ""},
+	{0x2F2D, idnaMapped, 11588, ""},
+	{0x2F2E, idnaMapped, 11949, ""},
+	{0x2F2F, idnaMapped, 11958, ""},
+	{0x2F30, idnaMapped, 11969, ""},
+	{0x2F31, idnaMapped, 11981, ""},
+	{0x2F32, idnaMapped, 12096, ""},
+	{0x2F33, idnaMapped, 12103, ""},
+	{0x2F34, idnaMapped, 12107, ""},
+	{0x2F35, idnaMapped, 12223, ""},
+	{0x2F36, idnaMapped, 12232, ""},
+	{0x2F37, idnaMapped, 12244, ""},
+	{0x2F38, idnaMapped, 12251, ""},
+	{0x2F39, idnaMapped, 12311, ""},
+	{0x2F3A, idnaMapped, 12327, ""},
+	{0x2F3B, idnaMapped, 12344, ""},
+	{0x2F3C, idnaMapped, 12423, ""},
+	{0x2F3D, idnaMapped, 13003, ""},
+	{0x2F3E, idnaMapped, 13048, ""},
+	{0x2F3F, idnaMapped, 13068, ""},
+	{0x2F40, idnaMapped, 13807, ""},
+	{0x2F41, idnaMapped, 13811, ""},
+	{0x2F42, idnaMapped, 13893, ""},
+	{0x2F43, idnaMapped, 13908, ""},
+	{0x2F44, idnaMapped, 13920, ""},
+	{0x2F45, idnaMapped, 13940, ""},
+	{0x2F46, idnaMapped, 13978, ""},
+	{0x2F47, idnaMapped, 13982, ""},
+	{0x2F48, idnaMapped, 14248, ""},
+	{0x2F49, idnaMapped, 14271, ""},
+	{0x2F4A, idnaMapped, 14302, ""},
+	{0x2F4B, idnaMapped, 15317, ""},
+	{0x2F4C, idnaMapped, 15382, ""},
+	{0x2F4D, idnaMapped, 15404, ""},
+	{0x2F4E, idnaMapped, 15461, ""},
+	{0x2F4F, idnaMapped, 15484, ""},
+	{0x2F50, idnaMapped, 15492, ""},
+	{0x2F51, idnaMapped, 15498, ""},
+	{0x2F52, idnaMapped, 15549, ""},
+	{0x2F53, idnaMapped, 15553, ""},
+	{0x2F54, idnaMapped, 15584, ""},
+	{0x2F55, idnaMapped, 16662, ""},
+	{0x2F56, idnaMapped, 17108, ""},
+	{0x2F57, idnaMapped, 17119, ""},
+	{0x2F58, idnaMapped, 17123, ""},
+	{0x2F59, idnaMapped, 17126, ""},
+	{0x2F5A, idnaMapped, 17133, ""},
+	{0x2F5B, idnaMapped, 17150, ""},
+	{0x2F5C, idnaMapped, 17151, ""},
+	{0x2F5D, idnaMapped, 17231, ""},
+	{0x2F5E, idnaMapped, 17446, ""},
+	{0x2F5F, idnaMapped, 17450, ""},
+	{0x2F60, idnaMapped, 17788, ""},
+	{0x2F61, idnaMapped, 17797, ""},
+	{0x2F62, idnaMapped, 17846, ""},
+	{0x2F63, idnaMapped, 17852, ""},
+	{0x2F64, idnaMapped, 17860, ""},
+	{0x2F65, idnaMapped, 17867, ""},
+	{0x2F66, idnaMapped, 17957, ""},
+	{0x2F67, idnaMapped, 17963, ""},
+	{0x2F68, idnaMapped, 18190, ""},
+	{0x2F69, idnaMapped, 18196, ""},
+	{0x2F6A, idnaMapped, 18244, ""},
+	{0x2F6B, idnaMapped, 18260, ""},
+	{0x2F6C, idnaMapped, 18306, ""},
+	{0x2F6D, idnaMapped, 18542, ""},
+	{0x2F6E, idnaMapped, 18548, ""},
+	{0x2F6F, idnaMapped, 18564, ""},
+	{0x2F70, idnaMapped, 18890, ""},
+	{0x2F71, idnaMapped, 19015, ""},
+	{0x2F72, idnaMapped, 19020, ""},
+	{0x2F73, idnaMapped, 19201, ""},
+	{0x2F74, idnaMapped, 19287, ""},
+	{0x2F75, idnaMapped, 19332, ""},
+	{0x2F76, idnaMapped, 19709, ""},
+	{0x2F77, idnaMapped, 19841, ""},
+	{0x2F78, idnaMapped, 20414, ""},
+	{0x2F79, idnaMapped, 20440, ""},
+	{0x2F7A, idnaMapped, 20496, ""},
+	{0x2F7B, idnaMapped, 20546, ""},
+	{0x2F7C, idnaMapped, 20613, ""},
+	{0x2F7D, idnaMapped, 20623, ""},
+	{0x2F7E, idnaMapped, 20628, ""},
+	{0x2F7F, idnaMapped, 20660, ""},
+	{0x2F80, idnaMapped, 20735, ""},
+	{0x2F81, idnaMapped, 20744, ""},
+	{0x2F82, idnaMapped, 21089, ""},
+	{0x2F83, idnaMapped, 21095, ""},
+	{0x2F84, idnaMapped, 21103, ""},
+	{0x2F85, idnaMapped, 21111, ""},
+	{0x2F86, idnaMapped, 21126, ""},
+	{0x2F87, idnaMapped, 21140, ""},
+	{0x2F88, idnaMapped, 21143, ""},
+	{0x2F89, idnaMapped, 21221, ""},
+	{0x2F8A, idnaMapped, 21224, ""},
+	{0x2F8B, idnaMapped, 21229, ""},
+	{0x2F8C, idnaMapped, 22209, ""},
+	{0x2F8D, idnaMapped, 22238, ""},
+	{0x2F8E, idnaMapped, 22706, ""},
+	{0x2F8F, idnaMapped, 22717, ""},
+	{0x2F90, idnaMapped, 22739, ""},
+	{0x2F91, idnaMapped, 23021, ""},
+	{0x2F92, idnaMapped, 23033, ""},
+	{0x2F93, idnaMapped, 23103, ""},
+	{0x2F94, idnaMapped, 23148, ""},
+	{0x2F95, idnaMapped, 23714, ""},
+	{0x2F96, idnaMapped, 23728, ""},
+	{0x2F97, idnaMapped, 23742, ""},
+	{0x2F98, idnaMapped, 23776, ""},
+	{0x2F99, idnaMapped, 23812, ""},
+	{0x2F9A, idnaMapped, 24010, ""},
+	{0x2F9B, idnaMapped, 24021, ""},
+	{0x2F9C, idnaMapped, 24087, ""},
+	{0x2F9D, idnaMapped, 24334, ""},
+	{0x2F9E, idnaMapped, 24364, ""},
+	{0x2F9F, idnaMapped, 24572, ""},
+	{0x2FA0, idnaMapped, 24592, ""},
+	{0x2FA1, idnaMapped, 24596, ""},
+	{0x2FA2, idnaMapped, 24815, ""},
+	{0x2FA3, idnaMapped, 24998, ""},
+	{0x2FA4, idnaMapped, 25122, ""},
+	{0x2FA5, idnaMapped, 25127, ""},
+	{0x2FA6, idnaMapped, 25131, ""},
+	{0x2FA7, idnaMapped, 26064, ""},
+	{0x2FA8, idnaMapped, 26072, ""},
+	{0x2FA9, idnaMapped, 26227, ""},
+	{0x2FAA, idnaMapped, 26380, ""},
+	{0x2FAB, idnaMapped, 26382, ""},
+	{0x2FAC, idnaMapped, 26428, ""},
+	{0x2FAD, idnaMapped, 26532, ""},
+	{0x2FAE, idnaMapped, 26544, ""},
+	{0x2FAF, idnaMapped, 26547, ""},
+	{0x2FB0, idnaMapped, 26553, ""},
+	{0x2FB1, idnaMapped, 26650, ""},
+	{0x2FB2, idnaMapped, 26683, ""},
+	{0x2FB3, idnaMapped, 26688, ""},
+	{0x2FB4, idnaMapped, 26701, ""},
+	{0x2FB5, idnaMapped, 26867, ""},
+	{0x2FB6, idnaMapped, 26917, ""},
+	{0x2FB7, idnaMapped, 26920, ""},
+	{0x2FB8, idnaMapped, 27102, ""},
+	{0x2FB9, idnaMapped, 27104, ""},
+	{0x2FBA, idnaMapped, 27122, ""},
+	{0x2FBB, idnaMapped, 27373, ""},
+	{0x2FBC, idnaMapped, 27420, ""},
+	{0x2FBD, idnaMapped, 27426, ""},
+	{0x2FBE, idnaMapped, 27495, ""},
+	{0x2FBF, idnaMapped, 27504, ""},
+	{0x2FC0, idnaMapped, 27506, ""},
+	{0x2FC1, idnaMapped, 27515, ""},
+	{0x2FC2, idnaMapped, 27544, ""},
+	{0x2FC3, idnaMapped, 27938, ""},
+	{0x2FC4, idnaMapped, 28337, ""},
+	{0x2FC5, idnaMapped, 28346, ""},
+	{0x2FC6, idnaMapped, 28383, ""},
+	{0x2FC7, idnaMapped, 28404, ""},
+	{0x2FC8, idnaMapped, 28411, ""},
+	{0x2FC9, idnaMapped, 28420, ""},
+	{0x2FCA, idnaMapped, 28423, ""},
+	{0x2FCB, idnaMapped, 28462, ""},
+	{0x2FCC, idnaMapped, 28465, ""},
+	{0x2FCD, idnaMapped, 28481, ""},
+	{0x2FCE, idnaMapped, 28485, ""},
+	{0x2FCF, idnaMapped, 28497, ""},
+	{0x2FD0, idnaMapped, 28523, ""},
+	{0x2FD1, idnaMapped, 28537, ""},
+	{0x2FD2, idnaMapped, 28544, ""},
+	{0x2FD3, idnaMapped, 28602, ""},
+	{0x2FD4, idnaMapped, 28616, ""},
+	{0x2FD5, idnaMapped, 28619, ""},
+	{0x2FD6, idnaDisallowed, 0, ""},
+	{0x3001, idnaValidNV8, 0, ""},
+	{0x3002, idnaMapped, -12244, ""},
+	{0x3003, idnaValidNV8, 0, ""},
+	{0x3005, idnaValid, 0, ""},
+	{0x3008, idnaValidNV8, 0, ""},
+	{0x302A, idnaValid, 0, ""},
+	{0x302E, idnaValidNV8, 0, ""},
+	{0x3036, idnaMapped, -36, ""},
+	{0x3037, idnaValidNV8, 0, ""},
+	{0x3038, idnaMapped, 8969, ""},
+	{0x3039, idnaMapped, 8971, ""},
+	{0x303B, idnaValidNV8, 0, ""},
+	{0x303C, idnaValid, 0, ""},
+	{0x303D, idnaValidNV8, 0, ""},
+	{0x3040, idnaDisallowed, 0, ""},
+	{0x3041, idnaValid, 0, ""},
+	{0x3097, idnaDisallowed, 0, ""},
+	{0x3099, idnaValid, 0, ""},
+	{0x309B, idnaDisallowed, 0, ""},
+	{0x309D, idnaValid, 0, ""},
+	{0x309F, idnaMapped, 0, "\u3088\u308a"},
+	{0x30A0, idnaValidNV8, 0, ""},
+	{0x30A1, idnaValid, 0, ""},
+	{0x30FF, idnaMapped, 0, "\u30b3\u30c8"},
+	{0x3100, idnaDisallowed, 0, ""},
+	{0x3105, idnaValid, 0, ""},
+	{0x3130, idnaDisallowed, 0, ""},
+	{0x3131, idnaMapped, -8241, ""},
+	{0x3133, idnaMapped, -8073, ""},
+	{0x3134, idnaMapped, -8242, ""},
+	{0x3135, idnaMapped, -8073, ""},
+	{0x3137, idnaMapped, -8244, ""},
+	{0x313A, idnaMapped, -8074, ""},
+	{0x3140, idnaMapped, -8230, ""},
+	{0x3141, idnaMapped, -8251, ""},
+	{0x3144, idnaMapped, -8227, ""},
+	{0x3145, idnaMapped, -8252, ""},
+	{0x314F, idnaMapped, -8174, ""},
+	{0x3164, idnaDisallowed, 0, ""},
+	{0x3165, idnaMapped, -8273, ""},
+	{0x3167, idnaMapped, -8096, ""},
+	{0x3169, idnaMapped, -8093, ""},
+	{0x316A, idnaMapped, -8092, ""},
+	{0x316B, idnaMapped, -8088, ""},
+	{0x316C, idnaMapped, -8085, ""},
+	{0x316D, idnaMapped, -8084, ""},
+	{0x316E, idnaMapped, -8274, ""},
+	{0x316F, idnaMapped, -8082, ""},
+	{0x3170, idnaMapped, -8081, ""},
+	{0x3171, idnaMapped, -8276, ""},
+	{0x3173, idnaMapped, -8275, ""},
+	{0x3174, idnaMapped, -8274, ""},
+	{0x3176, idnaMapped, -8271, ""},
+	{0x3177, idnaMapped, -8270, ""},
+	{0x3178, idnaMapped, -8269, ""},
+	{0x317D, idnaMapped, -8267, ""},
+	{0x317E, idnaMapped, -8264, ""},
+	{0x317F, idnaMapped, -8255, ""},
+	{0x3180, idnaMapped, -8249, ""},
+	{0x3181, idnaMapped, -8245, ""},
+	{0x3182, idnaMapped, -8081, ""},
+	{0x3184, idnaMapped, -8237, ""},
+	{0x3187, idnaMapped, -8195, ""},
+	{0x3189, idnaMapped, -8193, ""},
+	{0x318A, idnaMapped, -8185, ""},
+	{0x318C, idnaMapped, -8184, ""},
+	{0x318D, idnaMapped, -8175, ""},
+	{0x318E, idnaMapped, -8173, ""},
+	{0x318F, idnaDisallowed, 0, ""},
+	{0x3190, idnaValidNV8, 0, ""},
+	{0x3192, idnaMapped, 7278, ""},
+	{0x3193, idnaMapped, 7417, ""},
+	{0x3194, idnaMapped, 7285, ""},
+	{0x3195, idnaMapped, 9542, ""},
+	{0x3196, idnaMapped, 7284, ""},
+	{0x3197, idnaMapped, 7318, ""},
+	{0x3198, idnaMapped, 7283, ""},
+	{0x3199, idnaMapped, 17305, ""},
+	{0x319A, idnaMapped, 7359, ""},
+	{0x319B, idnaMapped, 7294, ""},
+	{0x319C, idnaMapped, 7269, ""},
+	{0x319D, idnaMapped, 10124, ""},
+	{0x319E, idnaMapped, 9618, ""},
+	{0x319F, idnaMapped, 7451, ""},
+	{0x31A0, idnaValid, 0, ""},
+	{0x31C0, idnaValidNV8, 0, ""},
+	{0x31E4, idnaDisallowed, 0, ""},
+	{0x31F0, idnaValid, 0, ""},
+	{0x3200, idnaDisallowed, 0, ""},
+	{0x3244, idnaMapped, 8971, ""},
+	{0x3245, idnaMapped, 11319, ""},
+	{0x3246, idnaMapped, 13121, ""},
+	{0x3247, idnaMapped, 18760, ""},
+	{0x3248, idnaValidNV8, 0, ""},
+	{0x3250, idnaMapped, 0, "pte"},
+	{0x3251, idnaMapped, 0, "21"},
+	{0x3252, idnaMapped, 0, "22"},
+	{0x3253, idnaMapped, 0, "23"},
+	{0x3254, idnaMapped, 0, "24"},
+	{0x3255, idnaMapped, 0, "25"},
+	{0x3256, idnaMapped, 0, "26"},
+	{0x3257, idnaMapped, 0, "27"},
+	{0x3258, idnaMapped, 0, "28"},
+	{0x3259, idnaMapped, 0, "29"},
+	{0x325A, idnaMapped, 0, "30"},
+	{0x325B, idnaMapped, 0, "31"},
+	{0x325C, idnaMapped, 0, "32"},
+	{0x325D, idnaMapped, 0, "33"},
+	{0x325E, idnaMapped, 0, "34"},
+	{0x325F, idnaMapped, 0, "35"},
+	{0x3260, idnaMapped, -8544, ""},
+	{0x3261, idnaMapped, -8543, ""},
+	{0x3263, idnaMapped, -8542, ""},
+	{0x3266, idnaMapped, -8541, ""},
+	{0x3267, idnaMapped, -8540, ""},
+	{0x3269, idnaMapped, -8539, ""},
+	{0x326E, idnaMapped, 31122, ""},
+	{0x326F, idnaMapped, 32297, ""},
+	{0x3270, idnaMapped, 32884, ""},
+	{0x3271, idnaMapped, 34059, ""},
+	{0x3272, idnaMapped, 34646, ""},
+	{0x3273, idnaMapped, 35233, ""},
+	{0x3274, idnaMapped, 36408, ""},
+	{0x3275, idnaMapped, 37583, ""},
+	{0x3276, idnaMapped, 38170, ""},
+	{0x3277, idnaMapped, 39345, ""},
+	{0x3278, idnaMapped, 39932, ""},
+	{0x3279, idnaMapped, 40519, ""},
+	{0x327A, idnaMapped, 41106, ""},
+	{0x327B, idnaMapped, 41693, ""},
+	{0x327C, idnaMapped, 0, "\ucc38\uace0"},
+	{0x327D, idnaMapped, 0, "\uc8fc\uc758"},
+	{0x327E, idnaMapped, 37938, ""},
+	{0x327F, idnaValidNV8, 0, ""},
+	{0x3280, idnaMapped, 7040, ""},
+	{0x3281, idnaMapped, 7179, ""},
+	{0x3282, idnaMapped, 7047, ""},
+	{0x3283, idnaMapped, 9304, ""},
+	{0x3284, idnaMapped, 7184, ""},
+	{0x3285, idnaMapped, 7912, ""},
+	{0x3286, idnaMapped, 7037, ""},
+	{0x3287, idnaMapped, 7908, ""},
+	{0x3288, idnaMapped, 7125, ""},
+	{0x3289, idnaMapped, 8376, ""},
+	{0x328A, idnaMapped, 13438, ""},
+	{0x328B, idnaMapped, 15840, ""},
+	{0x328C, idnaMapped, 14760, ""},
+	{0x328D, idnaMapped, 13467, ""},
+	{0x328E, idnaMapped, 24387, ""},
+	{0x328F, idnaMapped, 9360, ""},
+	{0x3290, idnaMapped, 13141, ""},
+	{0x3291, idnaMapped, 13721, ""},
+	{0x3292, idnaMapped, 13431, ""},
+	{0x3293, idnaMapped, 18091, ""},
+	{0x3294, idnaMapped, 8569, ""},
+	{0x3295, idnaMapped, 16356, ""},
+	{0x3296, idnaMapped, 23051, ""},
+	{0x3297, idnaMapped, 18118, ""},
+	{0x3298, idnaMapped, 8220, ""},
+	{0x3299, idnaMapped, 18239, ""},
+	{0x329A, idnaMapped, 17053, ""},
+	{0x329B, idnaMapped, 9944, ""},
+	{0x329C, idnaMapped, 24013, ""},
+	{0x329D, idnaMapped, 7821, ""},
+	{0x329E, idnaMapped, 8402, ""},
+	{0x329F, idnaMapped, 14921, ""},
+	{0x32A0, idnaMapped, 25957, ""},
+	{0x32A1, idnaMapped, 7280, ""},
+	{0x32A2, idnaMapped, 7927, ""},
+	{0x32A3, idnaMapped, 14528, ""},
+	{0x32A4, idnaMapped, 7014, ""},
+	{0x32A5, idnaMapped, 7048, ""},
+	{0x32A6, idnaMapped, 7013, ""},
+	{0x32A7, idnaMapped, 11071, ""},
+	{0x32A8, idnaMapped, 8523, ""},
+	{0x32A9, idnaMapped, 8338, ""},
+	{0x32AA, idnaMapped, 10477, ""},
+	{0x32AB, idnaMapped, 10427, ""},
+	{0x32AC, idnaMapped, 17463, ""},
+	{0x32AD, idnaMapped, 7252, ""},
+	{0x32AE, idnaMapped, 23065, ""},
+	{0x32AF, idnaMapped, 8357, ""},
+	{0x32B0, idnaMapped, 9836, ""},
+	{0x32B1, idnaMapped, 0, "36"},
+	{0x32B2, idnaMapped, 0, "37"},
+	{0x32B3, idnaMapped, 0, "38"},
+	{0x32B4, idnaMapped, 0, "39"},
+	{0x32B5, idnaMapped, 0, "40"},
+	{0x32B6, idnaMapped, 0, "41"},
+	{0x32B7, idnaMapped, 0, "42"},
+	{0x32B8, idnaMapped, 0, "43"},
+	{0x32B9, idnaMapped, 0, "44"},
+	{0x32BA, idnaMapped, 0, "45"},
+	{0x32BB, idnaMapped, 0, "46"},
+	{0x32BC, idnaMapped, 0, "47"},
+	{0x32BD, idnaMapped, 0, "48"},
+	{0x32BE, idnaMapped, 0, "49"},
+	{0x32BF, idnaMapped, 0, "50"},
+	{0x32C0, idnaMapped, 0, "1\u6708"},
+	{0x32C1, idnaMapped, 0, "2\u6708"},
+	{0x32C2, idnaMapped, 0, "3\u6708"},
+	{0x32C3, idnaMapped, 0, "4\u6708"},
+	{0x32C4, idnaMapped, 0, "5\u6708"},
+	{0x32C5, idnaMapped, 0, "6\u6708"},
+	{0x32C6, idnaMapped, 0, "7\u6708"},
+	{0x32C7, idnaMapped, 0, "8\u6708"},
+	{0x32C8, idnaMapped, 0, "9\u6708"},
+	{0x32C9, idnaMapped, 0, "10\u6708"},
+	{0x32CA, idnaMapped, 0, "11\u6708"},
+	{0x32CB, idnaMapped, 0, "12\u6708"},
+	{0x32CC, idnaMapped, 0, "hg"},
+	{0x32CD, idnaMapped, 0, "erg"},
+	{0x32CE, idnaMapped, 0, "ev"},
+	{0x32CF, idnaMapped, 0, "ltd"},
+	{0x32D0, idnaMapped, -558, ""},
+	{0x32D1, idnaMapped, -557, ""},
+	{0x32D2, idnaMapped, -556, ""},
+	{0x32D3, idnaMapped, -555, ""},
+	{0x32D4, idnaMapped, -554, ""},
+	{0x32D6, idnaMapped, -553, ""},
+	{0x32D7, idnaMapped, -552, ""},
+	{0x32D8, idnaMapped, -551, ""},
+	{0x32D9, idnaMapped, -550, ""},
+	{0x32DA, idnaMapped, -549, ""},
+	{0x32DB, idnaMapped, -548, ""},
+	{0x32DC, idnaMapped, -547, ""},
+	{0x32DD, idnaMapped, -546, ""},
+	{0x32DE, idnaMapped, -545, ""},
+	{0x32DF, idnaMapped, -544, ""},
+	{0x32E0, idnaMapped, -543, ""},
+	{0x32E1, idnaMapped, -541, ""},
+	{0x32E2, idnaMapped, -540, ""},
+	{0x32E3, idnaMapped, -539, ""},
+	{0x32E4, idnaMapped, -538, ""},
+	{0x32EA, idnaMapped, -536, ""},
+	{0x32EB, idnaMapped, -534, ""},
+	{0x32EC, idnaMapped, -532, ""},
+	{0x32ED, idnaMapped, -530, ""},
+	{0x32EE, idnaMapped, -528, ""},
+	{0x32F3, idnaMapped, -527, ""},
+	{0x32F4, idnaMapped, -526, ""},
+	{0x32F5, idnaMapped, -525, ""},
+	{0x32FB, idnaMapped, -524, ""},
+	{0x32FF, idnaMapped, 0, "\u4ee4\u548c"},
+	{0x3300, idnaMapped, 0, "\u30a2\u30d1\u30fc\u30c8"},
+	{0x3301, idnaMapped, 0, "\u30a2\u30eb\u30d5\u30a1"},
+	{0x3302, idnaMapped, 0, "\u30a2\u30f3\u30da\u30a2"},
+	{0x3303, idnaMapped, 0, "\u30a2\u30fc\u30eb"},
+	{0x3304, idnaMapped, 0, "\u30a4\u30cb\u30f3\u30b0"},
+	{0x3305, idnaMapped, 0, "\u30a4\u30f3\u30c1"},
+	{0x3306, idnaMapped, 0, "\u30a6\u30a9\u30f3"},
+	{0x3307, idnaMapped, 0, "\u30a8\u30b9\u30af\u30fc\u30c9"},
+	{0x3308, idnaMapped, 0, "\u30a8\u30fc\u30ab\u30fc"},
+	{0x3309, idnaMapped, 0, "\u30aa\u30f3\u30b9"},
+	{0x330A, idnaMapped, 0, "\u30aa\u30fc\u30e0"},
+	{0x330B, idnaMapped, 0, "\u30ab\u30a4\u30ea"},
+	{0x330C, idnaMapped, 0, "\u30ab\u30e9\u30c3\u30c8"},
+	{0x330D, idnaMapped, 0, "\u30ab\u30ed\u30ea\u30fc"},
+	{0x330E, idnaMapped, 0, "\u30ac\u30ed\u30f3"},
+	{0x330F, idnaMapped, 0, "\u30ac\u30f3\u30de"},
+	{0x3310, idnaMapped, 0, "\u30ae\u30ac"},
+	{0x3311, idnaMapped, 0, "\u30ae\u30cb\u30fc"},
+	{0x3312, idnaMapped, 0, "\u30ad\u30e5\u30ea\u30fc"},
+	{0x3313, idnaMapped, 0, "\u30ae\u30eb\u30c0\u30fc"},
+	{0x3314, idnaMapped, 0, "\u30ad\u30ed"},
+	{0x3315, idnaMapped, 0, "\u30ad\u30ed\u30b0\u30e9\u30e0"},
+	{0x3316, idnaMapped, 0, "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb"},
+	{0x3317, idnaMapped, 0, "\u30ad\u30ed\u30ef\u30c3\u30c8"},
+	{0x3318, idnaMapped, 0, "\u30b0\u30e9\u30e0"},
+	{0x3319, idnaMapped, 0, "\u30b0\u30e9\u30e0\u30c8\u30f3"},
+	{0x331A, idnaMapped, 0, "\u30af\u30eb\u30bc\u30a4\u30ed"},
+	{0x331B, idnaMapped, 0, "\u30af\u30ed\u30fc\u30cd"},
+	{0x331C, idnaMapped, 0, "\u30b1\u30fc\u30b9"},
+	{0x331D, idnaMapped, 0, "\u30b3\u30eb\u30ca"},
+	{0x331E, idnaMapped, 0, "\u30b3\u30fc\u30dd"},
+	{0x331F, idnaMapped, 0, "\u30b5\u30a4\u30af\u30eb"},
+	{0x3320, idnaMapped, 0, "\u30b5\u30f3\u30c1\u30fc\u30e0"},
+	{0x3321, idnaMapped, 0, "\u30b7\u30ea\u30f3\u30b0"},
+	{0x3322, idnaMapped, 0, "\u30bb\u30f3\u30c1"},
+	{0x3323, idnaMapped, 0, "\u30bb\u30f3\u30c8"},
+	{0x3324, idnaMapped, 0, "\u30c0\u30fc\u30b9"},
+	{0x3325, idnaMapped, 0, "\u30c7\u30b7"},
+	{0x3326, idnaMapped, 0, "\u30c9\u30eb"},
+	{0x3327, idnaMapped, 0, "\u30c8\u30f3"},
+	{0x3328, idnaMapped, 0, "\u30ca\u30ce"},
+	{0x3329, idnaMapped, 0, "\u30ce\u30c3\u30c8"},
+	{0x332A, idnaMapped, 0, "\u30cf\u30a4\u30c4"},
+	{0x332B, idnaMapped, 0, "\u30d1\u30fc\u30bb\u30f3\u30c8"},
+	{0x332C, idnaMapped, 0, "\u30d1\u30fc\u30c4"},
+	{0x332D, idnaMapped, 0, "\u30d0\u30fc\u30ec\u30eb"},
+	{0x332E, idnaMapped, 0, "\u30d4\u30a2\u30b9\u30c8\u30eb"},
+	{0x332F, idnaMapped, 0, "\u30d4\u30af\u30eb"},
+	{0x3330, idnaMapped, 0, "\u30d4\u30b3"},
+	{0x3331, idnaMapped, 0, "\u30d3\u30eb"},
+	{0x3332, idnaMapped, 0, "\u30d5\u30a1\u30e9\u30c3\u30c9"},
+	{0x3333, idnaMapped, 0, "\u30d5\u30a3\u30fc\u30c8"},
+	{0x3334, idnaMapped, 0, "\u30d6\u30c3\u30b7\u30a7\u30eb"},
+	{0x3335, idnaMapped, 0, "\u30d5\u30e9\u30f3"},
+	{0x3336, idnaMapped, 0, "\u30d8\u30af\u30bf\u30fc\u30eb"},
+	{0x3337, idnaMapped, 0, "\u30da\u30bd"},
+	{0x3338, idnaMapped, 0, "\u30da\u30cb\u30d2"},
+	{0x3339, idnaMapped, 0, "\u30d8\u30eb\u30c4"},
+	{0x333A, idnaMapped, 0, "\u30da\u30f3\u30b9"},
+	{0x333B, idnaMapped, 0, "\u30da\u30fc\u30b8"},
+	{0x333C, idnaMapped, 0, "\u30d9\u30fc\u30bf"},
+	{0x333D, idnaMapped, 0, "\u30dd\u30a4\u30f3\u30c8"},
+	{0x333E, idnaMapped, 0, "\u30dc\u30eb\u30c8"},
+	{0x333F, idnaMapped, 0, "\u30db\u30f3"},
+	{0x3340, idnaMapped, 0, "\u30dd\u30f3\u30c9"},
+	{0x3341, idnaMapped, 0, "\u30db\u30fc\u30eb"},
+	{0x3342, idnaMapped, 0, "\u30db\u30fc\u30f3"},
+	{0x3343, idnaMapped, 0, "\u30de\u30a4\u30af\u30ed"},
+	{0x3344, idnaMapped, 0, "\u30de\u30a4\u30eb"},
+	{0x3345, idnaMapped, 0, "\u30de\u30c3\u30cf"},
+	{0x3346, idnaMapped, 0, "\u30de\u30eb\u30af"},
+	{0x3347, idnaMapped, 0, "\u30de\u30f3\u30b7\u30e7\u30f3"},
+	{0x3348, idnaMapped, 0, "\u30df\u30af\u30ed\u30f3"},
+	{0x3349, idnaMapped, 0, "\u30df\u30ea"},
+	{0x334A, idnaMapped, 0, "\u30df\u30ea\u30d0\u30fc\u30eb"},
+	{0x334B, idnaMapped, 0, "\u30e1\u30ac"},
+	{0x334C, idnaMapped, 0, "\u30e1\u30ac\u30c8\u30f3"},
+	{0x334D, idnaMapped, 0, "\u30e1\u30fc\u30c8\u30eb"},
+	{0x334E, idnaMapped, 0, "\u30e4\u30fc\u30c9"},
+	{0x334F, idnaMapped, 0, "\u30e4\u30fc\u30eb"},
+	{0x3350, idnaMapped, 0, "\u30e6\u30a2\u30f3"},
+	{0x3351, idnaMapped, 0, "\u30ea\u30c3\u30c8\u30eb"},
+	{0x3352, idnaMapped, 0, "\u30ea\u30e9"},
+	{0x3353, idnaMapped, 0, "\u30eb\u30d4\u30fc"},
+	{0x3354, idnaMapped, 0, "\u30eb\u30fc\u30d6\u30eb"},
+	{0x3355, idnaMapped, 0, "\u30ec\u30e0"},
+	{0x3356, idnaMapped, 0, "\u30ec\u30f3\u30c8\u30b2\u30f3"},
+	{0x3357, idnaMapped, 0, "\u30ef\u30c3\u30c8"},
+	{0x3358, idnaMapped, 0, "0\u70b9"},
+	{0x3359, idnaMapped, 0, "1\u70b9"},
+	{0x335A, idnaMapped, 0, "2\u70b9"},
+	{0x335B, idnaMapped, 0, "3\u70b9"},
+	{0x335C, idnaMapped, 0, "4\u70b9"},
+	{0x335D, idnaMapped, 0, "5\u70b9"},
+	{0x335E, idnaMapped, 0, "6\u70b9"},
+	{0x335F, idnaMapped, 0, "7\u70b9"},
+	{0x3360, idnaMapped, 0, "8\u70b9"},
+	{0x3361, idnaMapped, 0, "9\u70b9"},
+	{0x3362, idnaMapped, 0, "10\u70b9"},
+	{0x3363, idnaMapped, 0, "11\u70b9"},
+	{0x3364, idnaMapped, 0, "12\u70b9"},
+	{0x3365, idnaMapped, 0, "13\u70b9"},
+	{0x3366, idnaMapped, 0, "14\u70b9"},
+	{0x3367, idnaMapped, 0, "15\u70b9"},
+	{0x3368, idnaMapped, 0, "16\u70b9"},
+	{0x3369, idnaMapped, 0, "17\u70b9"},
+	{0x336A, idnaMapped, 0, "18\u70b9"},
+	{0x336B, idnaMapped, 0, "19\u70b9"},
+	{0x336C, idnaMapped, 0, "20\u70b9"},
+	{0x336D, idnaMapped, 0, "21\u70b9"},
+	{0x336E, idnaMapped, 0, "22\u70b9"},
+	{0x336F, idnaMapped, 0, "23\u70b9"},
+	{0x3370, idnaMapped, 0, "24\u70b9"},
+	{0x3371, idnaMapped, 0, "hpa"},
+	{0x3372, idnaMapped, 0, "da"},
+	{0x3373, idnaMapped, 0, "au"},
+	{0x3374, idnaMapped, 0, "bar"},
+	{0x3375, idnaMapped, 0, "ov"},
+	{0x3376, idnaMapped, 0, "pc"},
+	{0x3377, idnaMapped, 0, "dm"},
+	{0x3378, idnaMapped, 0, "dm2"},
+	{0x3379, idnaMapped, 0, "dm3"},
+	{0x337A, idnaMapped, 0, "iu"},
+	{0x337B, idnaMapped, 0, "\u5e73\u6210"},
+	{0x337C, idnaMapped, 0, "\u662d\u548c"},
+	{0x337D, idnaMapped, 0, "\u5927\u6b63"},
+	{0x337E, idnaMapped, 0, "\u660e\u6cbb"},
+	{0x337F, idnaMapped, 0, "\u682a\u5f0f\u4f1a\u793e"},
+	{0x3380, idnaMapped, 0, "pa"},
+	{0x3381, idnaMapped, 0, "na"},
+	{0x3382, idnaMapped, 0, "\u03bca"},
+	{0x3383, idnaMapped, 0, "ma"},
+	{0x3384, idnaMapped, 0, "ka"},
+	{0x3385, idnaMapped, 0, "kb"},
+	{0x3386, idnaMapped, 0, "mb"},
+	{0x3387, idnaMapped, 0, "gb"},
+	{0x3388, idnaMapped, 0, "cal"},
+	{0x3389, idnaMapped, 0, "kcal"},
+	{0x338A, idnaMapped, 0, "pf"},
+	{0x338B, idnaMapped, 0, "nf"},
+	{0x338C, idnaMapped, 0, "\u03bcf"},
+	{0x338D, idnaMapped, 0, "\u03bcg"},
+	{0x338E, idnaMapped, 0, "mg"},
+	{0x338F, idnaMapped, 0, "kg"},
+	{0x3390, idnaMapped, 0, "hz"},
+	{0x3391, idnaMapped, 0, "khz"},
+	{0x3392, idnaMapped, 0, "mhz"},
+	{0x3393, idnaMapped, 0, "ghz"},
+	{0x3394, idnaMapped, 0, "thz"},
+	{0x3395, idnaMapped, 0, "\u03bcl"},
+	{0x3396, idnaMapped, 0, "ml"},
+	{0x3397, idnaMapped, 0, "dl"},
+	{0x3398, idnaMapped, 0, "kl"},
+	{0x3399, idnaMapped, 0, "fm"},
+	{0x339A, idnaMapped, 0, "nm"},
+	{0x339B, idnaMapped, 0, "\u03bcm"},
+	{0x339C, idnaMapped, 0, "mm"},
+	{0x339D, idnaMapped, 0, "cm"},
+	{0x339E, idnaMapped, 0, "km"},
+	{0x339F, idnaMapped, 0, "mm2"},
+	{0x33A0, idnaMapped, 0, "cm2"},
+	{0x33A1, idnaMapped, 0, "m2"},
+	{0x33A2, idnaMapped, 0, "km2"},
+	{0x33A3, idnaMapped, 0, "mm3"},
+	{0x33A4, idnaMapped, 0, "cm3"},
+	{0x33A5, idnaMapped, 0, "m3"},
+	{0x33A6, idnaMapped, 0, "km3"},
+	{0x33A7, idnaMapped, 0, "m\u2215s"},
+	{0x33A8, idnaMapped, 0, "m\u2215s2"},
+	{0x33A9, idnaMapped, 0, "pa"},
+	{0x33AA, idnaMapped, 0, "kpa"},
+	{0x33AB, idnaMapped, 0, "mpa"},
+	{0x33AC, idnaMapped, 0, "gpa"},
+	{0x33AD, idnaMapped, 0, "rad"},
+	{0x33AE, idnaMapped, 0, "rad\u2215s"},
+	{0x33AF, idnaMapped, 0, "rad\u2215s2"},
+	{0x33B0, idnaMapped, 0, "ps"},
+	{0x33B1, idnaMapped, 0, "ns"},
+	{0x33B2, idnaMapped, 0, "\u03bcs"},
+	{0x33B3, idnaMapped, 0, "ms"},
+	{0x33B4, idnaMapped, 0, "pv"},
+	{0x33B5, idnaMapped, 0, "nv"},
+	{0x33B6, idnaMapped, 0, "\u03bcv"},
+	{0x33B7, idnaMapped, 0, "mv"},
+	{0x33B8, idnaMapped, 0, "kv"},
+	{0x33B9, idnaMapped, 0, "mv"},
+	{0x33BA, idnaMapped, 0, "pw"},
+	{0x33BB, idnaMapped, 0, "nw"},
+	{0x33BC, idnaMapped, 0, "\u03bcw"},
+	{0x33BD, idnaMapped, 0, "mw"},
+	{0x33BE, idnaMapped, 0, "kw"},
+	{0x33BF, idnaMapped, 0, "mw"},
+	{0x33C0, idnaMapped, 0, "k\u03c9"},
+	{0x33C1, idnaMapped, 0, "m\u03c9"},
+	{0x33C2, idnaDisallowed, 0, ""},
+	{0x33C3, idnaMapped, 0, "bq"},
+	{0x33C4, idnaMapped, 0, "cc"},
+	{0x33C5, idnaMapped, 0, "cd"},
+	{0x33C6, idnaMapped, 0, "c\u2215kg"},
+	{0x33C7, idnaDisallowed, 0, ""},
+	{0x33C8, idnaMapped, 0, "db"},
+	{0x33C9, idnaMapped, 0, "gy"},
+	{0x33CA, idnaMapped, 0, "ha"},
+	{0x33CB, idnaMapped, 0, "hp"},
+	{0x33CC, idnaMapped, 0, "in"},
+	{0x33CD, idnaMapped, 0, "kk"},
+	{0x33CE, idnaMapped, 0, "km"},
+	{0x33CF, idnaMapped, 0, "kt"},
+	{0x33D0, idnaMapped, 0, "lm"},
+	{0x33D1, idnaMapped, 0, "ln"},
+	{0x33D2, idnaMapped, 0, "log"},
+	{0x33D3, idnaMapped, 0, "lx"},
+	{0x33D4, idnaMapped, 0, "mb"},
+	{0x33D5, idnaMapped, 0, "mil"},
+	{0x33D6, idnaMapped, 0, "mol"},
+	{0x33D7, idnaMapped, 0, "ph"},
+	{0x33D8, idnaDisallowed, 0, ""},
+	{0x33D9, idnaMapped, 0, "ppm"},
+	{0x33DA, idnaMapped, 0, "pr"},
+	{0x33DB, idnaMapped, 0, "sr"},
+	{0x33DC, idnaMapped, 0, "sv"},
+	{0x33DD, idnaMapped, 0, "wb"},
+	{0x33DE, idnaMapped, 0, "v\u2215m"},
+	{0x33DF, idnaMapped, 0, "a\u2215m"},
+	{0x33E0, idnaMapped, 0, "1\u65e5"},
+	{0x33E1, idnaMapped, 0, "2\u65e5"},
+	{0x33E2, idnaMapped, 0, "3\u65e5"},
+	{0x33E3, idnaMapped, 0, "4\u65e5"},
+	{0x33E4, idnaMapped, 0, "5\u65e5"},
+	{0x33E5, idnaMapped, 0, "6\u65e5"},
+	{0x33E6, idnaMapped, 0, "7\u65e5"},
+	{0x33E7, idnaMapped, 0, "8\u65e5"},
+	{0x33E8, idnaMapped, 0, "9\u65e5"},
+	{0x33E9, idnaMapped, 0, "10\u65e5"},
+	{0x33EA, idnaMapped, 0, "11\u65e5"},
+	{0x33EB, idnaMapped, 0, "12\u65e5"},
+	{0x33EC, idnaMapped, 0, "13\u65e5"},
+	{0x33ED, idnaMapped, 0, "14\u65e5"},
+	{0x33EE, idnaMapped, 0, "15\u65e5"},
+	{0x33EF, idnaMapped, 0, "16\u65e5"},
+	{0x33F0, idnaMapped, 0, "17\u65e5"},
+	{0x33F1, idnaMapped, 0, "18\u65e5"},
+	{0x33F2, idnaMapped, 0, "19\u65e5"},
+	{0x33F3, idnaMapped, 0, "20\u65e5"},
+	{0x33F4, idnaMapped, 0, "21\u65e5"},
+	{0x33F5, idnaMapped, 0, "22\u65e5"},
+	{0x33F6, idnaMapped, 0, "23\u65e5"},
+	{0x33F7, idnaMapped, 0, "24\u65e5"},
+	{0x33F8, idnaMapped, 0, "25\u65e5"},
+	{0x33F9, idnaMapped, 0, "26\u65e5"},
+	{0x33FA, idnaMapped, 0, "27\u65e5"},
+	{0x33FB, idnaMapped, 0, "28\u65e5"},
+	{0x33FC, idnaMapped, 0, "29\u65e5"},
+	{0x33FD, idnaMapped, 0, "30\u65e5"},
+	{0x33FE, idnaMapped, 0, "31\u65e5"},
+	{0x33FF, idnaMapped, 0, "gal"},
+	{0x3400, idnaValid, 0, ""},
+	{0x4DC0, idnaValidNV8, 0, ""},
+	{0x4E00, idnaValid, 0, ""},
+	{0xA48D, idnaDisallowed, 0, ""},
+	{0xA490, idnaValidNV8, 0, ""},
+	{0xA4C7, idnaDisallowed, 0, ""},
+	{0xA4D0, idnaValid, 0, ""},
+	{0xA4FE, idnaValidNV8, 0, ""},
+	{0xA500, idnaValid, 0, ""},
+	{0xA60D, idnaValidNV8, 0, ""},
+	{0xA610, idnaValid, 0, ""},
+	{0xA62C, idnaDisallowed, 0, ""},
+	{0xA640, idnaMapped, 1, ""},
+	{0xA641, idnaValid, 0, ""},
+	{0xA642, idnaMapped, 1, ""},
+	{0xA643, idnaValid, 0, ""},
+	{0xA644, idnaMapped, 1, ""},
+	{0xA645, idnaValid, 0, ""},
+	{0xA646, idnaMapped, 1, ""},
+	{0xA647, idnaValid, 0, ""},
+	{0xA648, idnaMapped, 1, ""},
+	{0xA649, idnaValid, 0, ""},
+	{0xA64A, idnaMapped, 1, ""},
+	{0xA64B, idnaValid, 0, ""},
+	{0xA64C, idnaMapped, 1, ""},
+	{0xA64D, idnaValid, 0, ""},
+	{0xA64E, idnaMapped, 1, ""},
+	{0xA64F, idnaValid, 0, ""},
+	{0xA650, idnaMapped, 1, ""},
+	{0xA651, idnaValid, 0, ""},
+	{0xA652, idnaMapped, 1, ""},
+	{0xA653, idnaValid, 0, ""},
+	{0xA654, idnaMapped, 1, ""},
+	{0xA655, idnaValid, 0, ""},
+	{0xA656, idnaMapped, 1, ""},
+	{0xA657, idnaValid, 0, ""},
+	{0xA658, idnaMapped, 1, ""},
+	{0xA659, idnaValid, 0, ""},
+	{0xA65A, idnaMapped, 1, ""},
+	{0xA65B, idnaValid, 0, ""},
+	{0xA65C, idnaMapped, 1, ""},
+	{0xA65D, idnaValid, 0, ""},
+	{0xA65E, idnaMapped, 1, ""},
+	{0xA65F, idnaValid, 0, ""},
+	{0xA660, idnaMapped, 1, ""},
+	{0xA661, idnaValid, 0, ""},
+	{0xA662, idnaMapped, 1, ""},
+	{0xA663, idnaValid, 0, ""},
+	{0xA664, idnaMapped, 1, ""},
+	{0xA665, idnaValid, 0, ""},
+	{0xA666, idnaMapped, 1, ""},
+	{0xA667, idnaValid, 0, ""},
+	{0xA668, idnaMapped, 1, ""},
+	{0xA669, idnaValid, 0, ""},
+	{0xA66A, idnaMapped, 1, ""},
+	{0xA66B, idnaValid, 0, ""},
+	{0xA66C, idnaMapped, 1, ""},
+	{0xA66D, idnaValid, 0, ""},
+	{0xA670, idnaValidNV8, 0, ""},
+	{0xA674, idnaValid, 0, ""},
+	{0xA67E, idnaValidNV8, 0, ""},
+	{0xA67F, idnaValid, 0, ""},
+	{0xA680, idnaMapped, 1, ""},
+	{0xA681, idnaValid, 0, ""},
+	{0xA682, idnaMapped, 1, ""},
+	{0xA683, idnaValid, 0, ""},
+	{0xA684, idnaMapped, 1, ""},
+	{0xA685, idnaValid, 0, ""},
+	{0xA686, idnaMapped, 1, ""},
+	{0xA687, idnaValid, 0, ""},
+	{0xA688, idnaMapped, 1, ""},
+	{0xA689, idnaValid, 0, ""},
+	{0xA68A, idnaMapped, 1, ""},
+	{0xA68B, idnaValid, 0, ""},
+	{0xA68C, idnaMapped, 1, ""},
+	{0xA68D, idnaValid, 0, ""},
+	{0xA68E, idnaMapped, 1, ""},
+	{0xA68F, idnaValid, 0, ""},
+	{0xA690, idnaMapped, 1, ""},
+	{0xA691, idnaValid, 0, ""},
+	{0xA692, idnaMapped, 1, ""},
+	{0xA693, idnaValid, 0, ""},
+	{0xA694, idnaMapped, 1, ""},
+	{0xA695, idnaValid, 0, ""},
+	{0xA696, idnaMapped, 1, ""},
+	{0xA697, idnaValid, 0, ""},
+	{0xA698, idnaMapped, 1, ""},
+	{0xA699, idnaValid, 0, ""},
+	{0xA69A, idnaMapped, 1, ""},
+	{0xA69B, idnaValid, 0, ""},
+	{0xA69C, idnaMapped, -41554, ""},
+	{0xA69D, idnaMapped, -41553, ""},
+	{0xA69E, idnaValid, 0, ""},
+	{0xA6E6, idnaValidNV8, 0, ""},
+	{0xA6F0, idnaValid, 0, ""},
+	{0xA6F2, idnaValidNV8, 0, ""},
+	{0xA6F8, idnaDisallowed, 0, ""},
+	{0xA700, idnaValidNV8, 0, ""},
+	{0xA717, idnaValid, 0, ""},
+	{0xA720, idnaValidNV8, 0, ""},
+	{0xA722, idnaMapped, 1, ""},
+	{0xA723, idnaValid, 0, ""},
+	{0xA724, idnaMapped, 1, ""},
+	{0xA725, idnaValid, 0, ""},
+	{0xA726, idnaMapped, 1, ""},
+	{0xA727, idnaValid, 0, ""},
+	{0xA728, idnaMapped, 1, ""},
+	{0xA729, idnaValid, 0, ""},
+	{0xA72A, idnaMapped, 1, ""},
+	{0xA72B, idnaValid, 0, ""},
+	{0xA72C, idnaMapped, 1, ""},
+	{0xA72D, idnaValid, 0, ""},
+	{0xA72E, idnaMapped, 1, ""},
+	{0xA72F, idnaValid, 0, ""},
+	{0xA732, idnaMapped, 1, ""},
+	{0xA733, idnaValid, 0, ""},
+	{0xA734, idnaMapped, 1, ""},
+	{0xA735, idnaValid, 0, ""},
+	{0xA736, idnaMapped, 1, ""},
+	{0xA737, idnaValid, 0, ""},
+	{0xA738, idnaMapped, 1, ""},
+	{0xA739, idnaValid, 0, ""},
+	{0xA73A, idnaMapped, 1, ""},
+	{0xA73B, idnaValid, 0, ""},
+	{0xA73C, idnaMapped, 1, ""},
+	{0xA73D, idnaValid, 0, ""},
+	{0xA73E, idnaMapped, 1, ""},
+	{0xA73F, idnaValid, 0, ""},
+	{0xA740, idnaMapped, 1, ""},
+	{0xA741, idnaValid, 0, ""},
+	{0xA742, idnaMapped, 1, ""},
+	{0xA743, idnaValid, 0, ""},
+	{0xA744, idnaMapped, 1, ""},
+	{0xA745, idnaValid, 0, ""},
+	{0xA746, idnaMapped, 1, ""},
+	{0xA747, idnaValid, 0, ""},
+	{0xA748, idnaMapped, 1, ""},
+	{0xA749, idnaValid, 0, ""},
+	{0xA74A, idnaMapped, 1, ""},
+	{0xA74B, idnaValid, 0, ""},
+	{0xA74C, idnaMapped, 1, ""},
+	{0xA74D, idnaValid, 0, ""},
+	{0xA74E, idnaMapped, 1, ""},
+	{0xA74F, idnaValid, 0, ""},
+	{0xA750, idnaMapped, 1, ""},
+	{0xA751, idnaValid, 0, ""},
+	{0xA752, idnaMapped, 1, ""},
+	{0xA753, idnaValid, 0, ""},
+	{0xA754, idnaMapped, 1, ""},
+	{0xA755, idnaValid, 0, ""},
+	{0xA756, idnaMapped, 1, ""},
+	{0xA757, idnaValid, 0, ""},
+	{0xA758, idnaMapped, 1, ""},
+	{0xA759, idnaValid, 0, ""},
+	{0xA75A, idnaMapped, 1, ""},
+	{0xA75B, idnaValid, 0, ""},
+	{0xA75C, idnaMapped, 1, ""},
+	{0xA75D, idnaValid, 0, ""},
+	{0xA75E, idnaMapped, 1, ""},
+	{0xA75F, idnaValid, 0, ""},
+	{0xA760, idnaMapped, 1, ""},
+	{0xA761, idnaValid, 0, ""},
+	{0xA762, idnaMapped, 1, ""},
+	{0xA763, idnaValid, 0, ""},
+	{0xA764, idnaMapped, 1, ""},
+	{0xA765, idnaValid, 0, ""},
+	{0xA766, idnaMapped, 1, ""},
+	{0xA767, idnaValid, 0, ""},
+	{0xA768, idnaMapped, 1, ""},
+	{0xA769, idnaValid, 0, ""},
+	{0xA76A, idnaMapped, 1, ""},
+	{0xA76B, idnaValid, 0, ""},
+	{0xA76C, idnaMapped, 1, ""},
+	{0xA76D, idnaValid, 0, ""},
+	{0xA76E, idnaMapped, 1, ""},
+	{0xA76F, idnaValid, 0, ""},
+	{0xA770, idnaMapped, -1, ""},
+	{0xA771, idnaValid, 0, ""},
+	{0xA779, idnaMapped, 1, ""},
+	{0xA77A, idnaValid, 0, ""},
+	{0xA77B, idnaMapped, 1, ""},
+	{0xA77C, idnaValid, 0, ""},
+	{0xA77D, idnaMapped, -35332, ""},
+	{0xA77E, idnaMapped, 1, ""},
+	{0xA77F, idnaValid, 0, ""},
+	{0xA780, idnaMapped, 1, ""},
+	{0xA781, idnaValid, 0, ""},
+	{0xA782, idnaMapped, 1, ""},
+	{0xA783, idnaValid, 0, ""},
+	{0xA784, idnaMapped, 1, ""},
+	{0xA785, idnaValid, 0, ""},
+	{0xA786, idnaMapped, 1, ""},
+	{0xA787, idnaValid, 0, ""},
+	{0xA789, idnaValidNV8, 0, ""},
+	{0xA78B, idnaMapped, 1, ""},
+	{0xA78C, idnaValid, 0, ""},
+	{0xA78D, idnaMapped, -42280, ""},
+	{0xA78E, idnaValid, 0, ""},
+	{0xA790, idnaMapped, 1, ""},
+	{0xA791, idnaValid, 0, ""},
+	{0xA792, idnaMapped, 1, ""},
+	{0xA793, idnaValid, 0, ""},
+	{0xA796, idnaMapped, 1, ""},
+	{0xA797, idnaValid, 0, ""},
+	{0xA798, idnaMapped, 1, ""},
+	{0xA799, idnaValid, 0, ""},
+	{0xA79A, idnaMapped, 1, ""},
+	{0xA79B, idnaValid, 0, ""},
+	{0xA79C, idnaMapped, 1, ""},
+	{0xA79D, idnaValid, 0, ""},
+	{0xA79E, idnaMapped, 1, ""},
+	{0xA79F, idnaValid, 0, ""},
+	{0xA7A0, idnaMapped, 1, ""},
+	{0xA7A1, idnaValid, 0, ""},
+	{0xA7A2, idnaMapped, 1, ""},
+	{0xA7A3, idnaValid, 0, ""},
+	{0xA7A4, idnaMapped, 1, ""},
+	{0xA7A5, idnaValid, 0, ""},
+	{0xA7A6, idnaMapped, 1, ""},
+	{0xA7A7, idnaValid, 0, ""},
+	{0xA7A8, idnaMapped, 1, ""},
+	{0xA7A9, idnaValid, 0, ""},
+	{0xA7AA, idnaMapped, -42308, ""},
+	{0xA7AB, idnaMapped, -42319, ""},
+	{0xA7AC, idnaMapped, -42315, ""},
+	{0xA7AD, idnaMapped, -42305, ""},
+	{0xA7AE, idnaMapped, -42308, ""},
+	{0xA7AF, idnaValid, 0, ""},
+	{0xA7B0, idnaMapped, -42258, ""},
+	{0xA7B1, idnaMapped, -42282, ""},
+	{0xA7B2, idnaMapped, -42261, ""},
+	{0xA7B3, idnaMapped, 928, ""},
+	{0xA7B4, idnaMapped, 1, ""},
+	{0xA7B5, idnaValid, 0, ""},
+	{0xA7B6, idnaMapped, 1, ""},
+	{0xA7B7, idnaValid, 0, ""},
+	{0xA7B8, idnaMapped, 1, ""},
+	{0xA7B9, idnaValid, 0, ""},
+	{0xA7BA, idnaMapped, 1, ""},
+	{0xA7BB, idnaValid, 0, ""},
+	{0xA7BC, idnaMapped, 1, ""},
+	{0xA7BD, idnaValid, 0, ""},
+	{0xA7BE, idnaMapped, 1, ""},
+	{0xA7BF, idnaValid, 0, ""},
+	{0xA7C0, idnaMapped, 1, ""},
+	{0xA7C1, idnaValid, 0, ""},
+	{0xA7C2, idnaMapped, 1, ""},
+	{0xA7C3, idnaValid, 0, ""},
+	{0xA7C4, idnaMapped, -48, ""},
+	{0xA7C5, idnaMapped, -42307, ""},
+	{0xA7C6, idnaMapped, -35384, ""},
+	{0xA7C7, idnaMapped, 1, ""},
+	{0xA7C8, idnaValid, 0, ""},
+	{0xA7C9, idnaMapped, 1, ""},
+	{0xA7CA, idnaValid, 0, ""},
+	{0xA7CB, idnaDisallowed, 0, ""},
+	{0xA7D0, idnaMapped, 1, ""},
+	{0xA7D1, idnaValid, 0, ""},
+	{0xA7D2, idnaDisallowed, 0, ""},
+	{0xA7D3, idnaValid, 0, ""},
+	{0xA7D4, idnaDisallowed, 0, ""},
+	{0xA7D5, idnaValid, 0, ""},
+	{0xA7D6, idnaMapped, 1, ""},
+	{0xA7D7, idnaValid, 0, ""},
+	{0xA7D8, idnaMapped, 1, ""},
+	{0xA7D9, idnaValid, 0, ""},
+	{0xA7DA, idnaDisallowed, 0, ""},
+	{0xA7F2, idnaMapped, -42895, ""},
+	{0xA7F3, idnaMapped, -42893, ""},
+	{0xA7F4, idnaMapped, -42883, ""},
+	{0xA7F5, idnaMapped, 1, ""},
+	{0xA7F6, idnaValid, 0, ""},
+	{0xA7F8, idnaMapped, -42705, ""},
+	{0xA7F9, idnaMapped, -42662, ""},
+	{0xA7FA, idnaValid, 0, ""},
+	{0xA828, idnaValidNV8, 0, ""},
+	{0xA82C, idnaValid, 0, ""},
+	{0xA82D, idnaDisallowed, 0, ""},
+	{0xA830, idnaValidNV8, 0, ""},
+	{0xA83A, idnaDisallowed, 0, ""},
+	{0xA840, idnaValid, 0, ""},
+	{0xA874, idnaValidNV8, 0, ""},
+	{0xA878, idnaDisallowed, 0, ""},
+	{0xA880, idnaValid, 0, ""},
+	{0xA8C6, idnaDisallowed, 0, ""},
+	{0xA8CE, idnaValidNV8, 0, ""},
+	{0xA8D0, idnaValid, 0, ""},
+	{0xA8DA, idnaDisallowed, 0, ""},
+	{0xA8E0, idnaValid, 0, ""},
+	{0xA8F8, idnaValidNV8, 0, ""},
+	{0xA8FB, idnaValid, 0, ""},
+	{0xA8FC, idnaValidNV8, 0, ""},
+	{0xA8FD, idnaValid, 0, ""},
+	{0xA92E, idnaValidNV8, 0, ""},
+	{0xA930, idnaValid, 0, ""},
+	{0xA954, idnaDisallowed, 0, ""},
+	{0xA95F, idnaValidNV8, 0, ""},
+	{0xA97D, idnaDisallowed, 0, ""},
+	{0xA980, idnaValid, 0, ""},
+	{0xA9C1, idnaValidNV8, 0, ""},
+	{0xA9CE, idnaDisallowed, 0, ""},
+	{0xA9CF, idnaValid, 0, ""},
+	{0xA9DA, idnaDisallowed, 0, ""},
+	{0xA9DE, idnaValidNV8, 0, ""},
+	{0xA9E0, idnaValid, 0, ""},
+	{0xA9FF, idnaDisallowed, 0, ""},
+	{0xAA00, idnaValid, 0, ""},
+	{0xAA37, idnaDisallowed, 0, ""},
+	{0xAA40, idnaValid, 0, ""},
+	{0xAA4E, idnaDisallowed, 0, ""},
+	{0xAA50, idnaValid, 0, ""},
+	{0xAA5A, idnaDisallowed, 0, ""},
+	{0xAA5C, idnaValidNV8, 0, ""},
+	{0xAA60, idnaValid, 0, ""},
+	{0xAA77, idnaValidNV8, 0, ""},
+	{0xAA7A, idnaValid, 0, ""},
+	{0xAAC3, idnaDisallowed, 0, ""},
+	{0xAADB, idnaValid, 0, ""},
+	{0xAADE, idnaValidNV8, 0, ""},
+	{0xAAE0, idnaValid, 0, ""},
+	{0xAAF0, idnaValidNV8, 0, ""},
+	{0xAAF2, idnaValid, 0, ""},
+	{0xAAF7, idnaDisallowed, 0, ""},
+	{0xAB01, idnaValid, 0, ""},
+	{0xAB07, idnaDisallowed, 0, ""},
+	{0xAB09, idnaValid, 0, ""},
+	{0xAB0F, idnaDisallowed, 0, ""},
+	{0xAB11, idnaValid, 0, ""},
+	{0xAB17, idnaDisallowed, 0, ""},
+	{0xAB20, idnaValid, 0, ""},
+	{0xAB27, idnaDisallowed, 0, ""},
+	{0xAB28, idnaValid, 0, ""},
+	{0xAB2F, idnaDisallowed, 0, ""},
+	{0xAB30, idnaValid, 0, ""},
+	{0xAB5B, idnaValidNV8, 0, ""},
+	{0xAB5C, idnaMapped, -1077, ""},
+	{0xAB5D, idnaMapped, -38, ""},
+	{0xAB5E, idnaMapped, -43251, ""},
+	{0xAB5F, idnaMapped, -13, ""},
+	{0xAB60, idnaValid, 0, ""},
+	{0xAB69, idnaMapped, -43228, ""},
+	{0xAB6A, idnaValidNV8, 0, ""},
+	{0xAB6C, idnaDisallowed, 0, ""},
+	{0xAB70, idnaMapped, -38864, ""},
+	{0xABC0, idnaValid, 0, ""},
+	{0xABEB, idnaValidNV8, 0, ""},
+	{0xABEC, idnaValid, 0, ""},
+	{0xABEE, idnaDisallowed, 0, ""},
+	{0xABF0, idnaValid, 0, ""},
+	{0xABFA, idnaDisallowed, 0, ""},
+	{0xAC00, idnaValid, 0, ""},
+	{0xD7A4, idnaDisallowed, 0, ""},
+	{0xD7B0, idnaValidNV8, 0, ""},
+	{0xD7C7, idnaDisallowed, 0, ""},
+	{0xD7CB, idnaValidNV8, 0, ""},
+	{0xD7FC, idnaDisallowed, 0, ""},
+	{0xF900, idnaMapped, -27832, ""},
+	{0xF901, idnaMapped, -37389, ""},
+	{0xF902, idnaMapped, -27192, ""},
+	{0xF903, idnaMapped, -27707, ""},
+	{0xF904, idnaMapped, -35379, ""},
+	{0xF905, idnaMapped, -43731, ""},
+	{0xF906, idnaMapped, -42273, ""},
+	{0xF907, idnaMapped, -22891, ""},
+	{0xF908, idnaMapped, -22892, ""},
+	{0xF909, idnaMapped, -40888, ""},
+	{0xF90A, idnaMapped, -26425, ""},
+	{0xF90B, idnaMapped, -41860, ""},
+	{0xF90C, idnaMapped, -40900, ""},
+	{0xF90D, idnaMapped, -38679, ""},
+	{0xF90E, idnaMapped, -33445, ""},
+	{0xF90F, idnaMapped, -31114, ""},
+	{0xF910, idnaMapped, -29393, ""},
+	{0xF911, idnaMapped, -29015, ""},
+	{0xF912, idnaMapped, -28698, ""},
+	{0xF913, idnaMapped, -26756, ""},
+	{0xF914, idnaMapped, -36626, ""},
+	{0xF915, idnaMapped, -35834, ""},
+	{0xF916, idnaMapped, -34877, ""},
+	{0xF917, idnaMapped, -34105, ""},
+	{0xF918, idnaMapped, -29915, ""},
+	{0xF919, idnaMapped, -26543, ""},
+	{0xF91A, idnaMapped, -24361, ""},
+	{0xF91B, idnaMapped, -43673, ""},
+	{0xF91C, idnaMapped, -42407, ""},
+	{0xF91D, idnaMapped, -36377, ""},
+	{0xF91E, idnaMapped, -34563, ""},
+	{0xF91F, idnaMapped, -29426, ""},
+	{0xF920, idnaMapped, -23298, ""},
+	{0xF921, idnaMapped, -39889, ""},
+	{0xF922, idnaMapped, -35127, ""},
+	{0xF923, idnaMapped, -29526, ""},
+	{0xF924, idnaMapped, -28608, ""},
+	{0xF925, idnaMapped, -38492, ""},
+	{0xF926, idnaMapped, -30542, ""},
+	{0xF927, idnaMapped, -28936, ""},
+	{0xF928, idnaMapped, -39518, ""},
+	{0xF929, idnaMapped, -37394, ""},
+	{0xF92A, idnaMapped, -35776, ""},
+	{0xF92B, idnaMapped, -34351, ""},
+	{0xF92C, idnaMapped, -26718, ""},
+	{0xF92D, idnaMapped, -43431, ""},
+	{0xF92E, idnaMapped, -42871, ""},
+	{0xF92F, idnaMapped, -42577, ""},
+	{0xF930, idnaMapped, -37996, ""},
+	{0xF931, idnaMapped, -36446, ""},
+	{0xF932, idnaMapped, -34594, ""},
+	{0xF933, idnaMapped, -33356, ""},
+	{0xF934, idnaMapped, -31027, ""},
+	{0xF935, idnaMapped, -29487, ""},
+	{0xF936, idnaMapped, -29402, ""},
+	{0xF937, idnaMapped, -27464, ""},
+	{0xF938, idnaMapped, -25094, ""},
+	{0xF939, idnaMapped, -24010, ""},
+	{0xF93A, idnaMapped, -23360, ""},
+	{0xF93B, idnaMapped, -32943, ""},
+	{0xF93C, idnaMapped, -32701, ""},
+	{0xF93D, idnaMapped, -31645, ""},
+	{0xF93E, idnaMapped, -30069, ""},
+	{0xF93F, idnaMapped, -26171, ""},
+	{0xF940, idnaMapped, -23233, ""},
+	{0xF941, idnaMapped, -28267, ""},
+	{0xF942, idnaMapped, -41059, ""},
+	{0xF943, idnaMapped, -39487, ""},
+	{0xF944, idnaMapped, -31972, ""},
+	{0xF945, idnaMapped, -30919, ""},
+	{0xF946, idnaMapped, -34532, ""},
+	{0xF947, idnaMapped, -32893, ""},
+	{0xF948, idnaMapped, -27782, ""},
+	{0xF949, idnaMapped, -25170, ""},
+	{0xF94A, idnaMapped, -41074, ""},
+	{0xF94B, idnaMapped, -40169, ""},
+	{0xF94C, idnaMapped, -36665, ""},
+	{0xF94D, idnaMapped, -35699, ""},
+	{0xF94E, idnaMapped, -35391, ""},
+	{0xF94F, idnaMapped, -31776, ""},
+	{0xF950, idnaMapped, -31513, ""},
+	{0xF951, idnaMapped, -25350, ""},
+	{0xF952, idnaMapped, -42624, ""},
+	{0xF953, idnaMapped, -30920, ""},
+	{0xF954, idnaMapped, -42872, ""},
+	{0xF955, idnaMapped, -42889, ""},
+	{0xF956, idnaMapped, -32570, ""},
+	{0xF957, idnaMapped, -31641, ""},
+	{0xF958, idnaMapped, -30055, ""},
+	{0xF959, idnaMapped, -25316, ""},
+	{0xF95A, idnaMapped, -28122, ""},
+	{0xF95B, idnaMapped, -38540, ""},
+	{0xF95C, idnaMapped, -36698, ""},
+	{0xF95D, idnaMapped, -28255, ""},
+	{0xF95E, idnaMapped, -43813, ""},
+	{0xF95F, idnaMapped, -40312, ""},
+	{0xF960, idnaMapped, -39246, ""},
+	{0xF961, idnaMapped, -34266, ""},
+	{0xF962, idnaMapped, -33778, ""},
+	{0xF963, idnaMapped, -42572, ""},
+	{0xF964, idnaMapped, -32873, ""},
+	{0xF965, idnaMapped, -43430, ""},
+	{0xF966, idnaMapped, -39357, ""},
+	{0xF967, idnaMapped, -43866, ""},
+	{0xF968, idnaMapped, -35996, ""},
+	{0xF969, idnaMapped, -37873, ""},
+	{0xF96A, idnaMapped, -31816, ""},
+	{0xF96B, idnaMapped, -42408, ""},
+	{0xF96C, idnaMapped, -41230, ""},
+	{0xF96D, idnaMapped, -33388, ""},
+	{0xF96E, idnaMapped, -29989, ""},
+	{0xF96F, idnaMapped, -28357, ""},
+	{0xF970, idnaMapped, -36278, ""},
+	{0xF971, idnaMapped, -27073, ""},
+	{0xF972, idnaMapped, -36074, ""},
+	{0xF973, idnaMapped, -38517, ""},
+	{0xF974, idnaMapped, -30351, ""},
+	{0xF975, idnaMapped, -38357, ""},
+	{0xF976, idnaMapped, -33809, ""},
+	{0xF977, idnaMapped, -43721, ""},
+	{0xF978, idnaMapped, -43023, ""},
+	{0xF979, idnaMapped, -42928, ""},
+	{0xF97A, idnaMapped, -37113, ""},
+	{0xF97B, idnaMapped, -31892, ""},
+	{0xF97C, idnaMapped, -30477, ""},
+	{0xF97D, idnaMapped, -28331, ""},
+	{0xF97E, idnaMapped, -26543, ""},
+	{0xF97F, idnaMapped, -42634, ""},
+	{0xF980, idnaMapped, -42302, ""},
+	{0xF981, idnaMapped, -40974, ""},
+	{0xF982, idnaMapped, -39574, ""},
+	{0xF983, idnaMapped, -37822, ""},
+	{0xF984, idnaMapped, -35206, ""},
+	{0xF985, idnaMapped, -32859, ""},
+	{0xF986, idnaMapped, -25561, ""},
+	{0xF987, idnaMapped, -24349, ""},
+	{0xF988, idnaMapped, -23281, ""},
+	{0xF989, idnaMapped, -23227, ""},
+	{0xF98A, idnaMapped, -42735, ""},
+	{0xF98B, idnaMapped, -37573, ""},
+	{0xF98C, idnaMapped, -36373, ""},
+	{0xF98D, idnaMapped, -27179, ""},
+	{0xF98E, idnaMapped, -39706, ""},
+	{0xF98F, idnaMapped, -38911, ""},
+	{0xF990, idnaMapped, -38800, ""},
+	{0xF991, idnaMapped, -38135, ""},
+	{0xF992, idnaMapped, -35439, ""},
+	{0xF993, idnaMapped, -34890, ""},
+	{0xF994, idnaMapped, -34059, ""},
+	{0xF995, idnaMapped, -32715, ""},
+	{0xF996, idnaMapped, -31650, ""},
+	{0xF997, idnaMapped, -31016, ""},
+	{0xF998, idnaMapped, -27250, ""},
+	{0xF999, idnaMapped, -29867, ""},
+	{0xF99A, idnaMapped, -26999, ""},
+	{0xF99B, idnaMapped, -26193, ""},
+	{0xF99C, idnaMapped, -42885, ""},
+	{0xF99D, idnaMapped, -42746, ""},
+	{0xF99E, idnaMapped, -42209, ""},
+	{0xF99F, idnaMapped, -35031, ""},
+	{0xF9A0, idnaMapped, -28894, ""},
+	{0xF9A1, idnaMapped, -28407, ""},
+	{0xF9A2, idnaMapped, -39641, ""},
+	{0xF9A3, idnaMapped, -39342, ""},
+	{0xF9A4, idnaMapped, -38441, ""},
+	{0xF9A5, idnaMapped, -36343, ""},
+	{0xF9A6, idnaMapped, -32104, ""},
+	{0xF9A7, idnaMapped, -34354, ""},
+	{0xF9A8, idnaMapped, -43716, ""},
+	{0xF9A9, idnaMapped, -41648, ""},
+	{0xF9AA, idnaMapped, -40387, ""},
+	{0xF9AB, idnaMapped, -39921, ""},
+	{0xF9AC, idnaMapped, -39312, ""},
+	{0xF9AD, idnaMapped, -34299, ""},
+	{0xF9AE, idnaMapped, -34117, ""},
+	{0xF9AF, idnaMapped, -31253, ""},
+	{0xF9B0, idnaMapped, -31082, ""},
+	{0xF9B1, idnaMapped, -26493, ""},
+	{0xF9B2, idnaMapped, -25276, ""},
+	{0xF9B3, idnaMapped, -25195, ""},
+	{0xF9B4, idnaMapped, -24988, ""},
+	{0xF9B5, idnaMapped, -43562, ""},
+	{0xF9B6, idnaMapped, -32776, ""},
+	{0xF9B7, idnaMapped, -26627, ""},
+	{0xF9B8, idnaMapped, -25344, ""},
+	{0xF9B9, idnaMapped, -39128, ""},
+	{0xF9BA, idnaMapped, -43828, ""},
+	{0xF9BB, idnaMapped, -43233, ""},
+	{0xF9BC, idnaMapped, -40398, ""},
+	{0xF9BD, idnaMapped, -40318, ""},
+	{0xF9BE, idnaMapped, -37925, ""},
+	{0xF9BF, idnaMapped, -36797, ""},
+	{0xF9C0, idnaMapped, -34802, ""},
+	{0xF9C1, idnaMapped, -33663, ""},
+	{0xF9C2, idnaMapped, -29894, ""},
+	{0xF9C3, idnaMapped, -26951, ""},
+	{0xF9C4, idnaMapped, -23095, ""},
+	{0xF9C5, idnaMapped, -37693, ""},
+	{0xF9C6, idnaMapped, -25496, ""},
+	{0xF9C7, idnaMapped, -42814, ""},
+	{0xF9C8, idnaMapped, -37453, ""},
+	{0xF9C9, idnaMapped, -37334, ""},
+	{0xF9CA, idnaMapped, -35977, ""},
+	{0xF9CB, idnaMapped, -35631, ""},
+	{0xF9CC, idnaMapped, -34243, ""},
+	{0xF9CD, idnaMapped, -33908, ""},
+	{0xF9CE, idnaMapped, -33123, ""},
+	{0xF9CF, idnaMapped, -31935, ""},
+	{0xF9D0, idnaMapped, -24946, ""},
+	{0xF9D1, idnaMapped, -43108, ""},
+	{0xF9D2, idnaMapped, -38820, ""},
+	{0xF9D3, idnaMapped, -25435, ""},
+	{0xF9D4, idnaMapped, -43433, ""},
+	{0xF9D5, idnaMapped, -40124, ""},
+	{0xF9D6, idnaMapped, -35820, ""},
+	{0xF9D7, idnaMapped, -27309, ""},
+	{0xF9D8, idnaMapped, -39501, ""},
+	{0xF9D9, idnaMapped, -39061, ""},
+	{0xF9DA, idnaMapped, -37315, ""},
+	{0xF9DB, idnaMapped, -34388, ""},
+	{0xF9DC, idnaMapped, -25430, ""},
+	{0xF9DD, idnaMapped, -42932, ""},
+	{0xF9DE, idnaMapped, -42447, ""},
+	{0xF9DF, idnaMapped, -40314, ""},
+	{0xF9E0, idnaMapped, -37837, ""},
+	{0xF9E1, idnaMapped, -37523, ""},
+	{0xF9E2, idnaMapped, -37178, ""},
+	{0xF9E3, idnaMapped, -36094, ""},
+	{0xF9E4, idnaMapped, -34270, ""},
+	{0xF9E5, idnaMapped, -33795, ""},
+	{0xF9E6, idnaMapped, -31341, ""},
+	{0xF9E7, idnaMapped, -28952, ""},
+	{0xF9E8, idnaMapped, -28935, ""},
+	{0xF9E9, idnaMapped, -26653, ""},
+	{0xF9EA, idnaMapped, -25352, ""},
+	{0xF9EB, idnaMapped, -42668, ""},
+	{0xF9EC, idnaMapped, -35634, ""},
+	{0xF9ED, idnaMapped, -42448, ""},
+	{0xF9EE, idnaMapped, -34846, ""},
+	{0xF9EF, idnaMapped, -34135, ""},
+	{0xF9F0, idnaMapped, -29686, ""},
+	{0xF9F1, idnaMapped, -25422, ""},
+	{0xF9F2, idnaMapped, -23963, ""},
+	{0xF9F3, idnaMapped, -23380, ""},
+	{0xF9F4, idnaMapped, -37469, ""},
+	{0xF9F5, idnaMapped, -35882, ""},
+	{0xF9F6, idnaMapped, -30734, ""},
+	{0xF9F7, idnaMapped, -32556, ""},
+	{0xF9F8, idnaMapped, -32472, ""},
+	{0xF9F9, idnaMapped, -32103, ""},
+	{0xF9FA, idnaMapped, -34618, ""},
+	{0xF9FB, idnaMapped, -35170, ""},
+	{0xF9FC, idnaMapped, -28324, ""},
+	{0xF9FD, idnaMapped, -43837, ""},
+	{0xF9FE, idnaMapped, -30408, ""},
+	{0xF9FF, idnaMapped, -42949, ""},
+	{0xFA00, idnaMapped, -43001, ""},
+	{0xFA01, idnaMapped, -39771, ""},
+	{0xFA02, idnaMapped, -38703, ""},
+	{0xFA03, idnaMapped, -32045, ""},
+	{0xFA04, idnaMapped, -40575, ""},
+	{0xFA05, idnaMapped, -36071, ""},
+	{0xFA06, idnaMapped, -37714, ""},
+	{0xFA07, idnaMapped, -27340, ""},
+	{0xFA08, idnaMapped, -29116, ""},
+	{0xFA09, idnaMapped, -25532, ""},
+	{0xFA0A, idnaMapped, -28799, ""},
+	{0xFA0B, idnaMapped, -39736, ""},
+	{0xFA0C, idnaMapped, -43212, ""},
+	{0xFA0D, idnaMapped, -42061, ""},
+	{0xFA0E, idnaValid, 0, ""},
+	{0xFA10, idnaMapped, -41398, ""},
+	{0xFA11, idnaValid, 0, ""},
+	{0xFA12, idnaMapped, -37790, ""},
+	{0xFA13, idnaValid, 0, ""},
+	{0xFA15, idnaMapped, -43063, ""},
+	{0xFA16, idnaMapped, -34540, ""},
+	{0xFA17, idnaMapped, -33613, ""},
+	{0xFA18, idnaMapped, -32988, ""},
+	{0xFA19, idnaMapped, -32955, ""},
+	{0xFA1A, idnaMapped, -32949, ""},
+	{0xFA1B, idnaMapped, -32908, ""},
+	{0xFA1C, idnaMapped, -25286, ""},
+	{0xFA1D, idnaMapped, -32095, ""},
+	{0xFA1E, idnaMapped, -31329, ""},
+	{0xFA1F, idnaValid, 0, ""},
+	{0xFA20, idnaMapped, -29710, ""},
+	{0xFA21, idnaValid, 0, ""},
+	{0xFA22, idnaMapped, -28458, ""},
+	{0xFA23, idnaValid, 0, ""},
+	{0xFA25, idnaMapped, -27117, ""},
+	{0xFA26, idnaMapped, -26921, ""},
+	{0xFA27, idnaValid, 0, ""},
+	{0xFA2A, idnaMapped, -24891, ""},
+	{0xFA2B, idnaMapped, -24879, ""},
+	{0xFA2C, idnaMapped, -24836, ""},
+	{0xFA2D, idnaMapped, -23673, ""},
+	{0xFA2E, idnaMapped, -26960, ""},
+	{0xFA2F, idnaMapped, -25464, ""},
+	{0xFA30, idnaMapped, -43650, ""},
+	{0xFA31, idnaMapped, -43338, ""},
+	{0xFA32, idnaMapped, -43237, ""},
+	{0xFA33, idnaMapped, -42858, ""},
+	{0xFA34, idnaMapped, -42832, ""},
+	{0xFA35, idnaMapped, -42724, ""},
+	{0xFA36, idnaMapped, -42137, ""},
+	{0xFA37, idnaMapped, -42033, ""},
+	{0xFA38, idnaMapped, -41936, ""},
+	{0xFA39, idnaMapped, -41465, ""},
+	{0xFA3A, idnaMapped, -41362, ""},
+	{0xFA3B, idnaMapped, -40407, ""},
+	{0xFA3C, idnaMapped, -40398, ""},
+	{0xFA3D, idnaMapped, -39337, ""},
+	{0xFA3E, idnaMapped, -39126, ""},
+	{0xFA3F, idnaMapped, -39089, ""},
+	{0xFA40, idnaMapped, -38990, ""},
+	{0xFA41, idnaMapped, -38130, ""},
+	{0xFA42, idnaMapped, -37984, ""},
+	{0xFA43, idnaMapped, -37810, ""},
+	{0xFA44, idnaMapped, -37311, ""},
+	{0xFA45, idnaMapped, -36046, ""},
+	{0xFA46, idnaMapped, -35884, ""},
+	{0xFA47, idnaMapped, -35621, ""},
+	{0xFA48, idnaMapped, -35034, ""},
+	{0xFA49, idnaMapped, -34846, ""},
+	{0xFA4A, idnaMapped, -34344, ""},
+	{0xFA4B, idnaMapped, -33210, ""},
+	{0xFA4C, idnaMapped, -33038, ""},
+	{0xFA4D, idnaMapped, -33028, ""},
+	{0xFA4E, idnaMapped, -33030, ""},
+	{0xFA4F, idnaMapped, -33023, ""},
+	{0xFA50, idnaMapped, -33018, ""},
+	{0xFA51, idnaMapped, -33012, ""},
+	{0xFA52, idnaMapped, -32965, ""},
+	{0xFA54, idnaMapped, -32788, ""},
+	{0xFA55, idnaMapped, -32724, ""},
+	{0xFA56, idnaMapped, -32406, ""},
+	{0xFA57, idnaMapped, -31843, ""},
+	{0xFA58, idnaMapped, -31823, ""},
+	{0xFA59, idnaMapped, -31768, ""},
+	{0xFA5A, idnaMapped, -31464, ""},
+	{0xFA5B, idnaMapped, -31318, ""},
+	{0xFA5C, idnaMapped, -30831, ""},
+	{0xFA5D, idnaMapped, -30692, ""},
+	{0xFA5E, idnaMapped, -30693, ""},
+	{0xFA5F, idnaMapped, -30216, ""},
+	{0xFA60, idnaMapped, -29008, ""},
+	{0xFA61, idnaMapped, -28875, ""},
+	{0xFA62, idnaMapped, -28513, ""},
+	{0xFA63, idnaMapped, -28458, ""},
+	{0xFA64, idnaMapped, -28049, ""},
+	{0xFA65, idnaMapped, -27997, ""},
+	{0xFA66, idnaMapped, -27312, ""},
+	{0xFA67, idnaMapped, -27183, ""},
+	{0xFA68, idnaMapped, -25477, ""},
+	{0xFA69, idnaMapped, -25194, ""},
+	{0xFA6A, idnaMapped, -25135, ""},
+	{0xFA6B, idnaMapped, -39414, ""},
+	{0xFA6C, idnaMapped, 84098, ""},
+	{0xFA6D, idnaMapped, -30805, ""},
+	{0xFA6E, idnaDisallowed, 0, ""},
+	{0xFA70, idnaMapped, -44106, ""},
+	{0xFA71, idnaMapped, -43196, ""},
+	{0xFA72, idnaMapped, -43274, ""},
+	{0xFA73, idnaMapped, -43763, ""},
+	{0xFA74, idnaMapped, -43311, ""},
+	{0xFA75, idnaMapped, -43253, ""},
+	{0xFA76, idnaMapped, -42927, ""},
+	{0xFA77, idnaMapped, -42877, ""},
+	{0xFA78, idnaMapped, -42203, ""},
+	{0xFA79, idnaMapped, -42276, ""},
+	{0xFA7A, idnaMapped, -42209, ""},
+	{0xFA7B, idnaMapped, -42137, ""},
+	{0xFA7C, idnaMapped, -41506, ""},
+	{0xFA7D, idnaMapped, -41418, ""},
+	{0xFA7E, idnaMapped, -41274, ""},
+	{0xFA7F, idnaMapped, -41259, ""},
+	{0xFA80, idnaMapped, -40990, ""},
+	{0xFA81, idnaMapped, -40793, ""},
+	{0xFA82, idnaMapped, -39856, ""},
+	{0xFA83, idnaMapped, -39850, ""},
+	{0xFA84, idnaMapped, -39707, ""},
+	{0xFA85, idnaMapped, -39640, ""},
+	{0xFA86, idnaMapped, -39342, ""},
+	{0xFA87, idnaMapped, -39225, ""},
+	{0xFA88, idnaMapped, -39296, ""},
+	{0xFA89, idnaMapped, -39163, ""},
+	{0xFA8A, idnaMapped, -39210, ""},
+	{0xFA8B, idnaMapped, -39065, ""},
+	{0xFA8C, idnaMapped, -39000, ""},
+	{0xFA8D, idnaMapped, -38601, ""},
+	{0xFA8E, idnaMapped, -38514, ""},
+	{0xFA8F, idnaMapped, -38461, ""},
+	{0xFA90, idnaMapped, -38202, ""},
+	{0xFA91, idnaMapped, -37917, ""},
+	{0xFA92, idnaMapped, -37755, ""},
+	{0xFA93, idnaMapped, -37752, ""},
+	{0xFA94, idnaMapped, -37694, ""},
+	{0xFA95, idnaMapped, -36636, ""},
+	{0xFA96, idnaMapped, -36572, ""},
+	{0xFA97, idnaMapped, -36182, ""},
+	{0xFA98, idnaMapped, -35773, ""},
+	{0xFA99, idnaMapped, -35790, ""},
+	{0xFA9A, idnaMapped, -35704, ""},
+	{0xFA9B, idnaMapped, -35453, ""},
+	{0xFA9C, idnaMapped, -35118, ""},
+	{0xFA9D, idnaMapped, -33526, ""},
+	{0xFA9E, idnaMapped, -34921, ""},
+	{0xFA9F, idnaMapped, -34800, ""},
+	{0xFAA0, idnaMapped, -34678, ""},
+	{0xFAA1, idnaMapped, -34352, ""},
+	{0xFAA2, idnaMapped, -34204, ""},
+	{0xFAA3, idnaMapped, -34152, ""},
+	{0xFAA4, idnaMapped, -33927, ""},
+	{0xFAA5, idnaMapped, -33926, ""},
+	{0xFAA6, idnaMapped, -33756, ""},
+	{0xFAA7, idnaMapped, -33740, ""},
+	{0xFAA8, idnaMapped, -33716, ""},
+	{0xFAA9, idnaMapped, -33631, ""},
+	{0xFAAA, idnaMapped, -33642, ""},
+	{0xFAAB, idnaMapped, -33247, ""},
+	{0xFAAC, idnaMapped, -32763, ""},
+	{0xFAAD, idnaMapped, -32493, ""},
+	{0xFAAE, idnaMapped, -32307, ""},
+	{0xFAAF, idnaMapped, -32084, ""},
+	{0xFAB0, idnaMapped, -31932, ""},
+	{0xFAB1, idnaMapped, -31603, ""},
+	{0xFAB2, idnaMapped, -31405, ""},
+	{0xFAB3, idnaMapped, -30561, ""},
+	{0xFAB4, idnaMapped, -30405, ""},
+	{0xFAB5, idnaMapped, -29500, ""},
+	{0xFAB6, idnaMapped, -29045, ""},
+	{0xFAB7, idnaMapped, -28977, ""},
+	{0xFAB8, idnaMapped, -28962, ""},
+	{0xFAB9, idnaMapped, -28666, ""},
+	{0xFABA, idnaMapped, -28610, ""},
+	{0xFABB, idnaMapped, -28656, ""},
+	{0xFABC, idnaMapped, -28603, ""},
+	{0xFABD, idnaMapped, -28607, ""},
+	{0xFABE, idnaMapped, -28625, ""},
+	{0xFABF, idnaMapped, -28550, ""},
+	{0xFAC0, idnaMapped, -28470, ""},
+	{0xFAC1, idnaMapped, -28089, ""},
+	{0xFAC2, idnaMapped, -27530, ""},
+	{0xFAC3, idnaMapped, -27217, ""},
+	{0xFAC4, idnaMapped, -26923, ""},
+	{0xFAC5, idnaMapped, -26703, ""},
+	{0xFAC6, idnaMapped, -25674, ""},
+	{0xFAC7, idnaMapped, -25572, ""},
+	{0xFAC8, idnaMapped, -25458, ""},
+	{0xFAC9, idnaMapped, -25326, ""},
+	{0xFACA, idnaMapped, -25291, ""},
+	{0xFACB, idnaMapped, -25280, ""},
+	{0xFACC, idnaMapped, -25233, ""},
+	{0xFACD, idnaMapped, -24507, ""},
+	{0xFACE, idnaMapped, -23346, ""},
+	{0xFACF, idnaMapped, 77179, ""},
+	{0xFAD0, idnaMapped, 77172, ""},
+	{0xFAD1, idnaMapped, 80132, ""},
+	{0xFAD2, idnaMapped, -48949, ""},
+	{0xFAD3, idnaMapped, -47803, ""},
+	{0xFAD4, idnaMapped, -47771, ""},
+	{0xFAD5, idnaMapped, 87924, ""},
+	{0xFAD6, idnaMapped, 90618, ""},
+	{0xFAD7, idnaMapped, 99324, ""},
+	{0xFAD8, idnaMapped, -23445, ""},
+	{0xFAD9, idnaMapped, -23371, ""},
+	{0xFADA, idnaDisallowed, 0, ""},
+	{0xFB00, idnaMapped, 0, "ff"},
+	{0xFB01, idnaMapped, 0, "fi"},
+	{0xFB02, idnaMapped, 0, "fl"},
+	{0xFB03, idnaMapped, 0, "ffi"},
+	{0xFB04, idnaMapped, 0, "ffl"},
+	{0xFB05, idnaMapped, 0, "st"},
+	{0xFB07, idnaDisallowed, 0, ""},
+	{0xFB13, idnaMapped, 0, "\u0574\u0576"},
+	{0xFB14, idnaMapped, 0, "\u0574\u0565"},
+	{0xFB15, idnaMapped, 0, "\u0574\u056b"},
+	{0xFB16, idnaMapped, 0, "\u057e\u0576"},
+	{0xFB17, idnaMapped, 0, "\u0574\u056d"},
+	{0xFB18, idnaDisallowed, 0, ""},
+	{0xFB1D, idnaMapped, 0, "\u05d9\u05b4"},
+	{0xFB1E, idnaValid, 0, ""},
+	{0xFB1F, idnaMapped, 0, "\u05f2\u05b7"},
+	{0xFB20, idnaMapped, -62782, ""},
+	{0xFB21, idnaMapped, -62801, ""},
+	{0xFB22, idnaMapped, -62799, ""},
+	{0xFB24, idnaMapped, -62793, ""},
+	{0xFB27, idnaMapped, -62783, ""},
+	{0xFB28, idnaMapped, -62782, ""},
+	{0xFB29, idnaDisallowed, 0, ""},
+	{0xFB2A, idnaMapped, 0, "\u05e9\u05c1"},
+	{0xFB2B, idnaMapped, 0, "\u05e9\u05c2"},
+	{0xFB2C, idnaMapped, 0, "\u05e9\u05bc\u05c1"},
+	{0xFB2D, idnaMapped, 0, "\u05e9\u05bc\u05c2"},
+	{0xFB2E, idnaMapped, 0, "\u05d0\u05b7"},
+	{0xFB2F, idnaMapped, 0, "\u05d0\u05b8"},
+	{0xFB30, idnaMapped, 0, "\u05d0\u05bc"},
+	{0xFB31, idnaMapped, 0, "\u05d1\u05bc"},
+	{0xFB32, idnaMapped, 0, "\u05d2\u05bc"},
+	{0xFB33, idnaMapped, 0, "\u05d3\u05bc"},
+	{0xFB34, idnaMapped, 0, "\u05d4\u05bc"},
+	{0xFB35, idnaMapped, 0, "\u05d5\u05bc"},
+	{0xFB36, idnaMapped, 0, "\u05d6\u05bc"},
+	{0xFB37, idnaDisallowed, 0, ""},
+	{0xFB38, idnaMapped, 0, "\u05d8\u05bc"},
+	{0xFB39, idnaMapped, 0, "\u05d9\u05bc"},
+	{0xFB3A, idnaMapped, 0, "\u05da\u05bc"},
+	{0xFB3B, idnaMapped, 0, "\u05db\u05bc"},
+	{0xFB3C, idnaMapped, 0, "\u05dc\u05bc"},
+	{0xFB3D, idnaDisallowed, 0, ""},
+	{0xFB3E, idnaMapped, 0, "\u05de\u05bc"},
+	{0xFB3F, idnaDisallowed, 0, ""},
+	{0xFB40, idnaMapped, 0, "\u05e0\u05bc"},
+	{0xFB41, idnaMapped, 0, "\u05e1\u05bc"},
+	{0xFB42, idnaDisallowed, 0, ""},
+	{0xFB43, idnaMapped, 0, "\u05e3\u05bc"},
+	{0xFB44, idnaMapped, 0, "\u05e4\u05bc"},
+	{0xFB45, idnaDisallowed, 0, ""},
+	{0xFB46, idnaMapped, 0, "\u05e6\u05bc"},
+	{0xFB47, idnaMapped, 0, "\u05e7\u05bc"},
+	{0xFB48, idnaMapped, 0, "\u05e8\u05bc"},
+	{0xFB49, idnaMapped, 0, "\u05e9\u05bc"},
+	{0xFB4A, idnaMapped, 0, "\u05ea\u05bc"},
+	{0xFB4B, idnaMapped, 0, "\u05d5\u05b9"},
+	{0xFB4C, idnaMapped, 0, "\u05d1\u05bf"},
+	{0xFB4D, idnaMapped, 0, "\u05db\u05bf"},
+	{0xFB4E, idnaMapped, 0, "\u05e4\u05bf"},
+	{0xFB4F, idnaMapped, 0, "\u05d0\u05dc"},
+	{0xFB50, idnaMapped, -62687, ""},
+	{0xFB51, idnaMapped, -62688, ""},
+	{0xFB52, idnaMapped, -62679, ""},
+	{0xFB53, idnaMapped, -62680, ""},
+	{0xFB54, idnaMapped, -62681, ""},
+	{0xFB55, idnaMapped, -62682, ""},
+	{0xFB56, idnaMapped, -62680, ""},
+	{0xFB57, idnaMapped, -62681, ""},
+	{0xFB58, idnaMapped, -62682, ""},
+	{0xFB59, idnaMapped, -62683, ""},
+	{0xFB5A, idnaMapped, -62682, ""},
+	{0xFB5B, idnaMapped, -62683, ""},
+	{0xFB5C, idnaMapped, -62684, ""},
+	{0xFB5D, idnaMapped, -62685, ""},
+	{0xFB5E, idnaMapped, -62692, ""},
+	{0xFB5F, idnaMapped, -62693, ""},
+	{0xFB60, idnaMapped, -62694, ""},
+	{0xFB61, idnaMapped, -62695, ""},
+	{0xFB62, idnaMapped, -62691, ""},
+	{0xFB63, idnaMapped, -62692, ""},
+	{0xFB64, idnaMapped, -62693, ""},
+	{0xFB65, idnaMapped, -62694, ""},
+	{0xFB66, idnaMapped, -62701, ""},
+	{0xFB67, idnaMapped, -62702, ""},
+	{0xFB68, idnaMapped, -62703, ""},
+	{0xFB69, idnaMapped, -62704, ""},
+	{0xFB6A, idnaMapped, -62662, ""},
+	{0xFB6B, idnaMapped, -62663, ""},
+	{0xFB6C, idnaMapped, -62664, ""},
+	{0xFB6D, idnaMapped, -62665, ""},
+	{0xFB6E, idnaMapped, -62664, ""},
+	{0xFB6F, idnaMapped, -62665, ""},
+	{0xFB70, idnaMapped, -62666, ""},
+	{0xFB71, idnaMapped, -62667, ""},
+	{0xFB72, idnaMapped, -62702, ""},
+	{0xFB73, idnaMapped, -62703, ""},
+	{0xFB74, idnaMapped, -62704, ""},
+	{0xFB75, idnaMapped, -62705, ""},
+	{0xFB76, idnaMapped, -62707, ""},
+	{0xFB77, idnaMapped, -62708, ""},
+	{0xFB78, idnaMapped, -62709, ""},
+	{0xFB79, idnaMapped, -62710, ""},
+	{0xFB7A, idnaMapped, -62708, ""},
+	{0xFB7B, idnaMapped, -62709, ""},
+	{0xFB7C, idnaMapped, -62710, ""},
+	{0xFB7D, idnaMapped, -62711, ""},
+	{0xFB7F, idnaMapped, -62712, ""},
+	{0xFB80, idnaMapped, -62713, ""},
+	{0xFB81, idnaMapped, -62714, ""},
+	{0xFB82, idnaMapped, -62709, ""},
+	{0xFB83, idnaMapped, -62710, ""},
+	{0xFB84, idnaMapped, -62712, ""},
+	{0xFB85, idnaMapped, -62713, ""},
+	{0xFB86, idnaMapped, -62712, ""},
+	{0xFB87, idnaMapped, -62713, ""},
+	{0xFB88, idnaMapped, -62720, ""},
+	{0xFB89, idnaMapped, -62721, ""},
+	{0xFB8A, idnaMapped, -62706, ""},
+	{0xFB8B, idnaMapped, -62707, ""},
+	{0xFB8C, idnaMapped, -62715, ""},
+	{0xFB8D, idnaMapped, -62716, ""},
+	{0xFB8E, idnaMapped, -62693, ""},
+	{0xFB8F, idnaMapped, -62694, ""},
+	{0xFB90, idnaMapped, -62695, ""},
+	{0xFB91, idnaMapped, -62696, ""},
+	{0xFB92, idnaMapped, -62691, ""},
+	{0xFB93, idnaMapped, -62692, ""},
+	{0xFB94, idnaMapped, -62693, ""},
+	{0xFB95, idnaMapped, -62694, ""},
+	{0xFB96, idnaMapped, -62691, ""},
+	{0xFB97, idnaMapped, -62692, ""},
+	{0xFB98, idnaMapped, -62693, ""},
+	{0xFB99, idnaMapped, -62694, ""},
+	{0xFB9A, idnaMapped, -62697, ""},
+	{0xFB9B, idnaMapped, -62698, ""},
+	{0xFB9C, idnaMapped, -62699, ""},
+	{0xFB9D, idnaMapped, -62700, ""},
+	{0xFB9E, idnaMapped, -62692, ""},
+	{0xFB9F, idnaMapped, -62693, ""},
+	{0xFBA1, idnaMapped, -62694, ""},
+	{0xFBA2, idnaMapped, -62695, ""},
+	{0xFBA3, idnaMapped, -62696, ""},
+	{0xFBA4, idnaMapped, -62692, ""},
+	{0xFBA5, idnaMapped, -62693, ""},
+	{0xFBA7, idnaMapped, -62694, ""},
+	{0xFBA8, idnaMapped, -62695, ""},
+	{0xFBA9, idnaMapped, -62696, ""},
+	{0xFBAA, idnaMapped, -62700, ""},
+	{0xFBAB, idnaMapped, -62701, ""},
+	{0xFBAC, idnaMapped, -62702, ""},
+	{0xFBAD, idnaMapped, -62703, ""},
+	{0xFBAE, idnaMapped, -62684, ""},
+	{0xFBAF, idnaMapped, -62685, ""},
+	{0xFBB1, idnaMapped, -62686, ""},
+	{0xFBB2, idnaValidNV8, 0, ""},
+	{0xFBC3, idnaDisallowed, 0, ""},
+	{0xFBD3, idnaMapped, -62758, ""},
+	{0xFBD4, idnaMapped, -62759, ""},
+	{0xFBD5, idnaMapped, -62760, ""},
+	{0xFBD6, idnaMapped, -62761, ""},
+	{0xFBD7, idnaMapped, -62736, ""},
+	{0xFBD8, idnaMapped, -62737, ""},
+	{0xFBD9, idnaMapped, -62739, ""},
+	{0xFBDA, idnaMapped, -62740, ""},
+	{0xFBDB, idnaMapped, -62739, ""},
+	{0xFBDC, idnaMapped, -62740, ""},
+	{0xFBDD, idnaMapped, 0, "\u06c7\u0674"},
+	{0xFBDE, idnaMapped, -62739, ""},
+	{0xFBDF, idnaMapped, -62740, ""},
+	{0xFBE0, idnaMapped, -62747, ""},
+	{0xFBE1, idnaMapped, -62748, ""},
+	{0xFBE2, idnaMapped, -62745, ""},
+	{0xFBE3, idnaMapped, -62746, ""},
+	{0xFBE4, idnaMapped, -62740, ""},
+	{0xFBE5, idnaMapped, -62741, ""},
+	{0xFBE6, idnaMapped, -62742, ""},
+	{0xFBE7, idnaMapped, -62743, ""},
+	{0xFBE8, idnaMapped, -62879, ""},
+	{0xFBE9, idnaMapped, -62880, ""},
+	{0xFBEA, idnaMapped, 0, "\u0626\u0627"},
+	{0xFBEC, idnaMapped, 0, "\u0626\u06d5"},
+	{0xFBEE, idnaMapped, 0, "\u0626\u0648"},
+	{0xFBF0, idnaMapped, 0, "\u0626\u06c7"},
+	{0xFBF2, idnaMapped, 0, "\u0626\u06c6"},
+	{0xFBF4, idnaMapped, 0, "\u0626\u06c8"},
+	{0xFBF6, idnaMapped, 0, "\u0626\u06d0"},
+	{0xFBF9, idnaMapped, 0, "\u0626\u0649"},
+	{0xFBFC, idnaMapped, -62768, ""},
+	{0xFBFD, idnaMapped, -62769, ""},
+	{0xFBFE, idnaMapped, -62770, ""},
+	{0xFBFF, idnaMapped, -62771, ""},
+	{0xFC00, idnaMapped, 0, "\u0626\u062c"},
+	{0xFC01, idnaMapped, 0, "\u0626\u062d"},
+	{0xFC02, idnaMapped, 0, "\u0626\u0645"},
+	{0xFC03, idnaMapped, 0, "\u0626\u0649"},
+	{0xFC04, idnaMapped, 0, "\u0626\u064a"},
+	{0xFC05, idnaMapped, 0, "\u0628\u062c"},
+	{0xFC06, idnaMapped, 0, "\u0628\u062d"},
+	{0xFC07, idnaMapped, 0, "\u0628\u062e"},
+	{0xFC08, idnaMapped, 0, "\u0628\u0645"},
+	{0xFC09, idnaMapped, 0, "\u0628\u0649"},
+	{0xFC0A, idnaMapped, 0, "\u0628\u064a"},
+	{0xFC0B, idnaMapped, 0, "\u062a\u062c"},
+	{0xFC0C, idnaMapped, 0, "\u062a\u062d"},
+	{0xFC0D, idnaMapped, 0, "\u062a\u062e"},
+	{0xFC0E, idnaMapped, 0, "\u062a\u0645"},
+	{0xFC0F, idnaMapped, 0, "\u062a\u0649"},
+	{0xFC10, idnaMapped, 0, "\u062a\u064a"},
+	{0xFC11, idnaMapped, 0, "\u062b\u062c"},
+	{0xFC12, idnaMapped, 0, "\u062b\u0645"},
+	{0xFC13, idnaMapped, 0, "\u062b\u0649"},
+	{0xFC14, idnaMapped, 0, "\u062b\u064a"},
+	{0xFC15, idnaMapped, 0, "\u062c\u062d"},
+	{0xFC16, idnaMapped, 0, "\u062c\u0645"},
+	{0xFC17, idnaMapped, 0, "\u062d\u062c"},
+	{0xFC18, idnaMapped, 0, "\u062d\u0645"},
+	{0xFC19, idnaMapped, 0, "\u062e\u062c"},
+	{0xFC1A, idnaMapped, 0, "\u062e\u062d"},
+	{0xFC1B, idnaMapped, 0, "\u062e\u0645"},
+	{0xFC1C, idnaMapped, 0, "\u0633\u062c"},
+	{0xFC1D, idnaMapped, 0, "\u0633\u062d"},
+	{0xFC1E, idnaMapped, 0, "\u0633\u062e"},
+	{0xFC1F, idnaMapped, 0, "\u0633\u0645"},
+	{0xFC20, idnaMapped, 0, "\u0635\u062d"},
+	{0xFC21, idnaMapped, 0, "\u0635\u0645"},
+	{0xFC22, idnaMapped, 0, "\u0636\u062c"},
+	{0xFC23, idnaMapped, 0, "\u0636\u062d"},
+	{0xFC24, idnaMapped, 0, "\u0636\u062e"},
+	{0xFC25, idnaMapped, 0, "\u0636\u0645"},
+	{0xFC26, idnaMapped, 0, "\u0637\u062d"},
+	{0xFC27, idnaMapped, 0, "\u0637\u0645"},
+	{0xFC28, idnaMapped, 0, "\u0638\u0645"},
+	{0xFC29, idnaMapped, 0, "\u0639\u062c"},
+	{0xFC2A, idnaMapped, 0, "\u0639\u0645"},
+	{0xFC2B, idnaMapped, 0, "\u063a\u062c"},
+	{0xFC2C, idnaMapped, 0, "\u063a\u0645"},
+	{0xFC2D, idnaMapped, 0, "\u0641\u062c"},
+	{0xFC2E, idnaMapped, 0, "\u0641\u062d"},
+	{0xFC2F, idnaMapped, 0, "\u0641\u062e"},
+	{0xFC30, idnaMapped, 0, "\u0641\u0645"},
+	{0xFC31, idnaMapped, 0, "\u0641\u0649"},
+	{0xFC32, idnaMapped, 0, "\u0641\u064a"},
+	{0xFC33, idnaMapped, 0, "\u0642\u062d"},
+	{0xFC34, idnaMapped, 0, "\u0642\u0645"},
+	{0xFC35, idnaMapped, 0, "\u0642\u0649"},
+	{0xFC36, idnaMapped, 0, "\u0642\u064a"},
+	{0xFC37, idnaMapped, 0, "\u0643\u0627"},
+	{0xFC38, idnaMapped, 0, "\u0643\u062c"},
+	{0xFC39, idnaMapped, 0, "\u0643\u062d"},
+	{0xFC3A, idnaMapped, 0, "\u0643\u062e"},
+	{0xFC3B, idnaMapped, 0, "\u0643\u0644"},
+	{0xFC3C, idnaMapped, 0, "\u0643\u0645"},
+	{0xFC3D, idnaMapped, 0, "\u0643\u0649"},
+	{0xFC3E, idnaMapped, 0, "\u0643\u064a"},
+	{0xFC3F, idnaMapped, 0, "\u0644\u062c"},
+	{0xFC40, idnaMapped, 0, "\u0644\u062d"},
+	{0xFC41, idnaMapped, 0, "\u0644\u062e"},
+	{0xFC42, idnaMapped, 0, "\u0644\u0645"},
+	{0xFC43, idnaMapped, 0, "\u0644\u0649"},
+	{0xFC44, idnaMapped, 0, "\u0644\u064a"},
+	{0xFC45, idnaMapped, 0, "\u0645\u062c"},
+	{0xFC46, idnaMapped, 0, "\u0645\u062d"},
+	{0xFC47, idnaMapped, 0, "\u0645\u062e"},
+	{0xFC48, idnaMapped, 0, "\u0645\u0645"},
+	{0xFC49, idnaMapped, 0, "\u0645\u0649"},
+	{0xFC4A, idnaMapped, 0, "\u0645\u064a"},
+	{0xFC4B, idnaMapped, 0, "\u0646\u062c"},
+	{0xFC4C, idnaMapped, 0, "\u0646\u062d"},
+	{0xFC4D, idnaMapped, 0, "\u0646\u062e"},
+	{0xFC4E, idnaMapped, 0, "\u0646\u0645"},
+	{0xFC4F, idnaMapped, 0, "\u0646\u0649"},
+	{0xFC50, idnaMapped, 0, "\u0646\u064a"},
+	{0xFC51, idnaMapped, 0, "\u0647\u062c"},
+	{0xFC52, idnaMapped, 0, "\u0647\u0645"},
+	{0xFC53, idnaMapped, 0, "\u0647\u0649"},
+	{0xFC54, idnaMapped, 0, "\u0647\u064a"},
+	{0xFC55, idnaMapped, 0, "\u064a\u062c"},
+	{0xFC56, idnaMapped, 0, "\u064a\u062d"},
+	{0xFC57, idnaMapped, 0, "\u064a\u062e"},
+	{0xFC58, idnaMapped, 0, "\u064a\u0645"},
+	{0xFC59, idnaMapped, 0, "\u064a\u0649"},
+	{0xFC5A, idnaMapped, 0, "\u064a\u064a"},
+	{0xFC5B, idnaMapped, 0, "\u0630\u0670"},
+	{0xFC5C, idnaMapped, 0, "\u0631\u0670"},
+	{0xFC5D, idnaMapped, 0, "\u0649\u0670"},
+	{0xFC5E, idnaDisallowed, 0, ""},
+	{0xFC64, idnaMapped, 0, "\u0626\u0631"},
+	{0xFC65, idnaMapped, 0, "\u0626\u0632"},
+	{0xFC66, idnaMapped, 0, "\u0626\u0645"},
+	{0xFC67, idnaMapped, 0, "\u0626\u0646"},
+	{0xFC68, idnaMapped, 0, "\u0626\u0649"},
+	{0xFC69, idnaMapped, 0, "\u0626\u064a"},
+	{0xFC6A, idnaMapped, 0, "\u0628\u0631"},
+	{0xFC6B, idnaMapped, 0, "\u0628\u0632"},
+	{0xFC6C, idnaMapped, 0, "\u0628\u0645"},
+	{0xFC6D, idnaMapped, 0, "\u0628\u0646"},
+	{0xFC6E, idnaMapped, 0, "\u0628\u0649"},
+	{0xFC6F, idnaMapped, 0, "\u0628\u064a"},
+	{0xFC70, idnaMapped, 0, "\u062a\u0631"},
+	{0xFC71, idnaMapped, 0, "\u062a\u0632"},
+	{0xFC72, idnaMapped, 0, "\u062a\u0645"},
+	{0xFC73, idnaMapped, 0, "\u062a\u0646"},
+	{0xFC74, idnaMapped, 0, "\u062a\u0649"},
+	{0xFC75, idnaMapped, 0, "\u062a\u064a"},
+	{0xFC76, idnaMapped, 0, "\u062b\u0631"},
+	{0xFC77, idnaMapped, 0, "\u062b\u0632"},
+	{0xFC78, idnaMapped, 0, "\u062b\u0645"},
+	{0xFC79, idnaMapped, 0, "\u062b\u0646"},
+	{0xFC7A, idnaMapped, 0, "\u062b\u0649"},
+	{0xFC7B, idnaMapped, 0, "\u062b\u064a"},
+	{0xFC7C, idnaMapped, 0, "\u0641\u0649"},
+	{0xFC7D, idnaMapped, 0, "\u0641\u064a"},
+	{0xFC7E, idnaMapped, 0, "\u0642\u0649"},
+	{0xFC7F, idnaMapped, 0, "\u0642\u064a"},
+	{0xFC80, idnaMapped, 0, "\u0643\u0627"},
+	{0xFC81, idnaMapped, 0, "\u0643\u0644"},
+	{0xFC82, idnaMapped, 0, "\u0643\u0645"},
+	{0xFC83, idnaMapped, 0, "\u0643\u0649"},
+	{0xFC84, idnaMapped, 0, "\u0643\u064a"},
+	{0xFC85, idnaMapped, 0, "\u0644\u0645"},
+	{0xFC86, idnaMapped, 0, "\u0644\u0649"},
+	{0xFC87, idnaMapped, 0, "\u0644\u064a"},
+	{0xFC88, idnaMapped, 0, "\u0645\u0627"},
+	{0xFC89, idnaMapped, 0, "\u0645\u0645"},
+	{0xFC8A, idnaMapped, 0, "\u0646\u0631"},
+	{0xFC8B, idnaMapped, 0, "\u0646\u0632"},
+	{0xFC8C, idnaMapped, 0, "\u0646\u0645"},
+	{0xFC8D, idnaMapped, 0, "\u0646\u0646"},
+	{0xFC8E, idnaMapped, 0, "\u0646\u0649"},
+	{0xFC8F, idnaMapped, 0, "\u0646\u064a"},
+	{0xFC90, idnaMapped, 0, "\u0649\u0670"},
+	{0xFC91, idnaMapped, 0, "\u064a\u0631"},
+	{0xFC92, idnaMapped, 0, "\u064a\u0632"},
+	{0xFC93, idnaMapped, 0, "\u064a\u0645"},
+	{0xFC94, idnaMapped, 0, "\u064a\u0646"},
+	{0xFC95, idnaMapped, 0, "\u064a\u0649"},
+	{0xFC96, idnaMapped, 0, "\u064a\u064a"},
+	{0xFC97, idnaMapped, 0, "\u0626\u062c"},
+	{0xFC98, idnaMapped, 0, "\u0626\u062d"},
+	{0xFC99, idnaMapped, 0, "\u0626\u062e"},
+	{0xFC9A, idnaMapped, 0, "\u0626\u0645"},
+	{0xFC9B, idnaMapped, 0, "\u0626\u0647"},
+	{0xFC9C, idnaMapped, 0, "\u0628\u062c"},
+	{0xFC9D, idnaMapped, 0, "\u0628\u062d"},
+	{0xFC9E, idnaMapped, 0, "\u0628\u062e"},
+	{0xFC9F, idnaMapped, 0, "\u0628\u0645"},
+	{0xFCA0, idnaMapped, 0, "\u0628\u0647"},
+	{0xFCA1, idnaMapped, 0, "\u062a\u062c"},
+	{0xFCA2, idnaMapped, 0, "\u062a\u062d"},
+	{0xFCA3, idnaMapped, 0, "\u062a\u062e"},
+	{0xFCA4, idnaMapped, 0, "\u062a\u0645"},
+	{0xFCA5, idnaMapped, 0, "\u062a\u0647"},
+	{0xFCA6, idnaMapped, 0, "\u062b\u0645"},
+	{0xFCA7, idnaMapped, 0, "\u062c\u062d"},
+	{0xFCA8, idnaMapped, 0, "\u062c\u0645"},
+	{0xFCA9, idnaMapped, 0, "\u062d\u062c"},
+	{0xFCAA, idnaMapped, 0, "\u062d\u0645"},
+	{0xFCAB, idnaMapped, 0, "\u062e\u062c"},
+	{0xFCAC, idnaMapped, 0, "\u062e\u0645"},
+	{0xFCAD, idnaMapped, 0, "\u0633\u062c"},
+	{0xFCAE, idnaMapped, 0, "\u0633\u062d"},
+	{0xFCAF, idnaMapped, 0, "\u0633\u062e"},
+	{0xFCB0, idnaMapped, 0, "\u0633\u0645"},
+	{0xFCB1, idnaMapped, 0, "\u0635\u062d"},
+	{0xFCB2, idnaMapped, 0, "\u0635\u062e"},
+	{0xFCB3, idnaMapped, 0, "\u0635\u0645"},
+	{0xFCB4, idnaMapped, 0, "\u0636\u062c"},
+	{0xFCB5, idnaMapped, 0, "\u0636\u062d"},
+	{0xFCB6, idnaMapped, 0, "\u0636\u062e"},
+	{0xFCB7, idnaMapped, 0, "\u0636\u0645"},
+	{0xFCB8, idnaMapped, 0, "\u0637\u062d"},
+	{0xFCB9, idnaMapped, 0, "\u0638\u0645"},
+	{0xFCBA, idnaMapped, 0, "\u0639\u062c"},
+	{0xFCBB, idnaMapped, 0, "\u0639\u0645"},
+	{0xFCBC, idnaMapped, 0, "\u063a\u062c"},
+	{0xFCBD, idnaMapped, 0, "\u063a\u0645"},
+	{0xFCBE, idnaMapped, 0, "\u0641\u062c"},
+	{0xFCBF, idnaMapped, 0, "\u0641\u062d"},
+	{0xFCC0, idnaMapped, 0, "\u0641\u062e"},
+	{0xFCC1, idnaMapped, 0, "\u0641\u0645"},
+	{0xFCC2, idnaMapped, 0, "\u0642\u062d"},
+	{0xFCC3, idnaMapped, 0, "\u0642\u0645"},
+	{0xFCC4, idnaMapped, 0, "\u0643\u062c"},
+	{0xFCC5, idnaMapped, 0, "\u0643\u062d"},
+	{0xFCC6, idnaMapped, 0, "\u0643\u062e"},
+	{0xFCC7, idnaMapped, 0, "\u0643\u0644"},
+	{0xFCC8, idnaMapped, 0, "\u0643\u0645"},
+	{0xFCC9, idnaMapped, 0, "\u0644\u062c"},
+	{0xFCCA, idnaMapped, 0, "\u0644\u062d"},
+	{0xFCCB, idnaMapped, 0, "\u0644\u062e"},
+	{0xFCCC, idnaMapped, 0, "\u0644\u0645"},
+	{0xFCCD, idnaMapped, 0, "\u0644\u0647"},
+	{0xFCCE, idnaMapped, 0, "\u0645\u062c"},
+	{0xFCCF, idnaMapped, 0, "\u0645\u062d"},
+	{0xFCD0, idnaMapped, 0, "\u0645\u062e"},
+	{0xFCD1, idnaMapped, 0, "\u0645\u0645"},
+	{0xFCD2, idnaMapped, 0, "\u0646\u062c"},
+	{0xFCD3, idnaMapped, 0, "\u0646\u062d"},
+	{0xFCD4, idnaMapped, 0, "\u0646\u062e"},
+	{0xFCD5, idnaMapped, 0, "\u0646\u0645"},
+	{0xFCD6, idnaMapped, 0, "\u0646\u0647"},
+	{0xFCD7, idnaMapped, 0, "\u0647\u062c"},
+	{0xFCD8, idnaMapped, 0, "\u0647\u0645"},
+	{0xFCD9, idnaMapped, 0, "\u0647\u0670"},
+	{0xFCDA, idnaMapped, 0, "\u064a\u062c"},
+	{0xFCDB, idnaMapped, 0, "\u064a\u062d"},
+	{0xFCDC, idnaMapped, 0, "\u064a\u062e"},
+	{0xFCDD, idnaMapped, 0, "\u064a\u0645"},
+	{0xFCDE, idnaMapped, 0, "\u064a\u0647"},
+	{0xFCDF, idnaMapped, 0, "\u0626\u0645"},
+	{0xFCE0, idnaMapped, 0, "\u0626\u0647"},
+	{0xFCE1, idnaMapped, 0, "\u0628\u0645"},
+	{0xFCE2, idnaMapped, 0, "\u0628\u0647"},
+	{0xFCE3, idnaMapped, 0, "\u062a\u0645"},
+	{0xFCE4, idnaMapped, 0, "\u062a\u0647"},
+	{0xFCE5, idnaMapped, 0, "\u062b\u0645"},
+	{0xFCE6, idnaMapped, 0, "\u062b\u0647"},
+	{0xFCE7, idnaMapped, 0, "\u0633\u0645"},
+	{0xFCE8, idnaMapped, 0, "\u0633\u0647"},
+	{0xFCE9, idnaMapped, 0, "\u0634\u0645"},
+	{0xFCEA, idnaMapped, 0, "\u0634\u0647"},
+	{0xFCEB, idnaMapped, 0, "\u0643\u0644"},
+	{0xFCEC, idnaMapped, 0, "\u0643\u0645"},
+	{0xFCED, idnaMapped, 0, "\u0644\u0645"},
+	{0xFCEE, idnaMapped, 0, "\u0646\u0645"},
+	{0xFCEF, idnaMapped, 0, "\u0646\u0647"},
+	{0xFCF0, idnaMapped, 0, "\u064a\u0645"},
+	{0xFCF1, idnaMapped, 0, "\u064a\u0647"},
+	{0xFCF2, idnaMapped, 0, "\u0640\u064e\u0651"},
+	{0xFCF3, idnaMapped, 0, "\u0640\u064f\u0651"},
+	{0xFCF4, idnaMapped, 0, "\u0640\u0650\u0651"},
+	{0xFCF5, idnaMapped, 0, "\u0637\u0649"},
+	{0xFCF6, idnaMapped, 0, "\u0637\u064a"},
+	{0xFCF7, idnaMapped, 0, "\u0639\u0649"},
+	{0xFCF8, idnaMapped, 0, "\u0639\u064a"},
+	{0xFCF9, idnaMapped, 0, "\u063a\u0649"},
+	{0xFCFA, idnaMapped, 0, "\u063a\u064a"},
+	{0xFCFB, idnaMapped, 0, "\u0633\u0649"},
+	{0xFCFC, idnaMapped, 0, "\u0633\u064a"},
+	{0xFCFD, idnaMapped, 0, "\u0634\u0649"},
+	{0xFCFE, idnaMapped, 0, "\u0634\u064a"},
+	{0xFCFF, idnaMapped, 0, "\u062d\u0649"},
+	{0xFD00, idnaMapped, 0, "\u062d\u064a"},
+	{0xFD01, idnaMapped, 0, "\u062c\u0649"},
+	{0xFD02, idnaMapped, 0, "\u062c\u064a"},
+	{0xFD03, idnaMapped, 0, "\u062e\u0649"},
+	{0xFD04, idnaMapped, 0, "\u062e\u064a"},
+	{0xFD05, idnaMapped, 0, "\u0635\u0649"},
+	{0xFD06, idnaMapped, 0, "\u0635\u064a"},
+	{0xFD07, idnaMapped, 0, "\u0636\u0649"},
+	{0xFD08, idnaMapped, 0, "\u0636\u064a"},
+	{0xFD09, idnaMapped, 0, "\u0634\u062c"},
+	{0xFD0A, idnaMapped, 0, "\u0634\u062d"},
+	{0xFD0B, idnaMapped, 0, "\u0634\u062e"},
+	{0xFD0C, idnaMapped, 0, "\u0634\u0645"},
+	{0xFD0D, idnaMapped, 0, "\u0634\u0631"},
+	{0xFD0E, idnaMapped, 0, "\u0633\u0631"},
+	{0xFD0F, idnaMapped, 0, "\u0635\u0631"},
+	{0xFD10, idnaMapped, 0, "\u0636\u0631"},
+	{0xFD11, idnaMapped, 0, "\u0637\u0649"},
+	{0xFD12, idnaMapped, 0, "\u0637\u064a"},
+	{0xFD13, idnaMapped, 0, "\u0639\u0649"},
+	{0xFD14, idnaMapped, 0, "\u0639\u064a"},
+	{0xFD15, idnaMapped, 0, "\u063a\u0649"},
+	{0xFD16, idnaMapped, 0, "\u063a\u064a"},
+	{0xFD17, idnaMapped, 0, "\u0633\u0649"},
+	{0xFD18, idnaMapped, 0, "\u0633\u064a"},
+	{0xFD19, idnaMapped, 0, "\u0634\u0649"},
+	{0xFD1A, idnaMapped, 0, "\u0634\u064a"},
+	{0xFD1B, idnaMapped, 0, "\u062d\u0649"},
+	{0xFD1C, idnaMapped, 0, "\u062d\u064a"},
+	{0xFD1D, idnaMapped, 0, "\u062c\u0649"},
+	{0xFD1E, idnaMapped, 0, "\u062c\u064a"},
+	{0xFD1F, idnaMapped, 0, "\u062e\u0649"},
+	{0xFD20, idnaMapped, 0, "\u062e\u064a"},
+	{0xFD21, idnaMapped, 0, "\u0635\u0649"},
+	{0xFD22, idnaMapped, 0, "\u0635\u064a"},
+	{0xFD23, idnaMapped, 0, "\u0636\u0649"},
+	{0xFD24, idnaMapped, 0, "\u0636\u064a"},
+	{0xFD25, idnaMapped, 0, "\u0634\u062c"},
+	{0xFD26, idnaMapped, 0, "\u0634\u062d"},
+	{0xFD27, idnaMapped, 0, "\u0634\u062e"},
+	{0xFD28, idnaMapped, 0, "\u0634\u0645"},
+	{0xFD29, idnaMapped, 0, "\u0634\u0631"},
+	{0xFD2A, idnaMapped, 0, "\u0633\u0631"},
+	{0xFD2B, idnaMapped, 0, "\u0635\u0631"},
+	{0xFD2C, idnaMapped, 0, "\u0636\u0631"},
+	{0xFD2D, idnaMapped, 0, "\u0634\u062c"},
+	{0xFD2E, idnaMapped, 0, "\u0634\u062d"},
+	{0xFD2F, idnaMapped, 0, "\u0634\u062e"},
+	{0xFD30, idnaMapped, 0, "\u0634\u0645"},
+	{0xFD31, idnaMapped, 0, "\u0633\u0647"},
+	{0xFD32, idnaMapped, 0, "\u0634\u0647"},
+	{0xFD33, idnaMapped, 0, "\u0637\u0645"},
+	{0xFD34, idnaMapped, 0, "\u0633\u062c"},
+	{0xFD35, idnaMapped, 0, "\u0633\u062d"},
+	{0xFD36, idnaMapped, 0, "\u0633\u062e"},
+	{0xFD37, idnaMapped, 0, "\u0634\u062c"},
+	{0xFD38, idnaMapped, 0, "\u0634\u062d"},
+	{0xFD39, idnaMapped, 0, "\u0634\u062e"},
+	{0xFD3A, idnaMapped, 0, "\u0637\u0645"},
+	{0xFD3B, idnaMapped, 0, "\u0638\u0645"},
+	{0xFD3C, idnaMapped, 0, "\u0627\u064b"},
+	{0xFD3E, idnaValidNV8, 0, ""},
+	{0xFD50, idnaMapped, 0, "\u062a\u062c\u0645"},
+	{0xFD51, idnaMapped, 0, "\u062a\u062d\u062c"},
+	{0xFD53, idnaMapped, 0, "\u062a\u062d\u0645"},
+	{0xFD54, idnaMapped, 0, "\u062a\u062e\u0645"},
+	{0xFD55, idnaMapped, 0, "\u062a\u0645\u062c"},
+	{0xFD56, idnaMapped, 0, "\u062a\u0645\u062d"},
+	{0xFD57, idnaMapped, 0, "\u062a\u0645\u062e"},
+	{0xFD58, idnaMapped, 0, "\u062c\u0645\u062d"},
+	{0xFD5A, idnaMapped, 0, "\u062d\u0645\u064a"},
+	{0xFD5B, idnaMapped, 0, "\u062d\u0645\u0649"},
+	{0xFD5C, idnaMapped, 0, "\u0633\u062d\u062c"},
+	{0xFD5D, idnaMapped, 0, "\u0633\u062c\u062d"},
+	{0xFD5E, idnaMapped, 0, "\u0633\u062c\u0649"},
+	{0xFD5F, idnaMapped, 0, "\u0633\u0645\u062d"},
+	{0xFD61, idnaMapped, 0, "\u0633\u0645\u062c"},
+	{0xFD62, idnaMapped, 0, "\u0633\u0645\u0645"},
+	{0xFD64, idnaMapped, 0, "\u0635\u062d\u062d"},
+	{0xFD66, idnaMapped, 0, "\u0635\u0645\u0645"},
+	{0xFD67, idnaMapped, 0, "\u0634\u062d\u0645"},
+	{0xFD69, idnaMapped, 0, "\u0634\u062c\u064a"},
+	{0xFD6A, idnaMapped, 0, "\u0634\u0645\u062e"},
+	{0xFD6C, idnaMapped, 0, "\u0634\u0645\u0645"},
+	{0xFD6E, idnaMapped, 0, "\u0636\u062d\u0649"},
+	{0xFD6F, idnaMapped, 0, "\u0636\u062e\u0645"},
+	{0xFD71, idnaMapped, 0, "\u0637\u0645\u062d"},
+	{0xFD73, idnaMapped, 0, "\u0637\u0645\u0645"},
+	{0xFD74, idnaMapped, 0, "\u0637\u0645\u064a"},
+	{0xFD75, idnaMapped, 0, "\u0639\u062c\u0645"},
+	{0xFD76, idnaMapped, 0, "\u0639\u0645\u0645"},
+	{0xFD78, idnaMapped, 0, "\u0639\u0645\u0649"},
+	{0xFD79, idnaMapped, 0, "\u063a\u0645\u0645"},
+	{0xFD7A, idnaMapped, 0, "\u063a\u0645\u064a"},
+	{0xFD7B, idnaMapped, 0, "\u063a\u0645\u0649"},
+	{0xFD7C, idnaMapped, 0, "\u0641\u062e\u0645"},
+	{0xFD7E, idnaMapped, 0, "\u0642\u0645\u062d"},
+	{0xFD7F, idnaMapped, 0, "\u0642\u0645\u0645"},
+	{0xFD80, idnaMapped, 0, "\u0644\u062d\u0645"},
+	{0xFD81, idnaMapped, 0, "\u0644\u062d\u064a"},
+	{0xFD82, idnaMapped, 0, "\u0644\u062d\u0649"},
+	{0xFD83, idnaMapped, 0, "\u0644\u062c\u062c"},
+	{0xFD85, idnaMapped, 0, "\u0644\u062e\u0645"},
+	{0xFD87, idnaMapped, 0, "\u0644\u0645\u062d"},
+	{0xFD89, idnaMapped, 0, "\u0645\u062d\u062c"},
+	{0xFD8A, idnaMapped, 0, "\u0645\u062d\u0645"},
+	{0xFD8B, idnaMapped, 0, "\u0645\u062d\u064a"},
+	{0xFD8C, idnaMapped, 0, "\u0645\u062c\u062d"},
+	{0xFD8D, idnaMapped, 0, "\u0645\u062c\u0645"},
+	{0xFD8E, idnaMapped, 0, "\u0645\u062e\u062c"},
+	{0xFD8F, idnaMapped, 0, "\u0645\u062e\u0645"},
+	{0xFD90, idnaDisallowed, 0, ""},
+	{0xFD92, idnaMapped, 0, "\u0645\u062c\u062e"},
+	{0xFD93, idnaMapped, 0, "\u0647\u0645\u062c"},
+	{0xFD94, idnaMapped, 0, "\u0647\u0645\u0645"},
+	{0xFD95, idnaMapped, 0, "\u0646\u062d\u0645"},
+	{0xFD96, idnaMapped, 0, "\u0646\u062d\u0649"},
+	{0xFD97, idnaMapped, 0, "\u0646\u062c\u0645"},
+	{0xFD99, idnaMapped, 0, "\u0646\u062c\u0649"},
+	{0xFD9A, idnaMapped, 0, "\u0646\u0645\u064a"},
+	{0xFD9B, idnaMapped, 0, "\u0646\u0645\u0649"},
+	{0xFD9C, idnaMapped, 0, "\u064a\u0645\u0645"},
+	{0xFD9E, idnaMapped, 0, "\u0628\u062e\u064a"},
+	{0xFD9F, idnaMapped, 0, "\u062a\u062c\u064a"},
+	{0xFDA0, idnaMapped, 0, "\u062a\u062c\u0649"},
+	{0xFDA1, idnaMapped, 0, "\u062a\u062e\u064a"},
+	{0xFDA2, idnaMapped, 0, "\u062a\u062e\u0649"},
+	{0xFDA3, idnaMapped, 0, "\u062a\u0645\u064a"},
+	{0xFDA4, idnaMapped, 0, "\u062a\u0645\u0649"},
+	{0xFDA5, idnaMapped, 0, "\u062c\u0645\u064a"},
+	{0xFDA6, idnaMapped, 0, "\u062c\u062d\u0649"},
+	{0xFDA7, idnaMapped, 0, "\u062c\u0645\u0649"},
+	{0xFDA8, idnaMapped, 0, "\u0633\u062e\u0649"},
+	{0xFDA9, idnaMapped, 0, "\u0635\u062d\u064a"},
+	{0xFDAA, idnaMapped, 0, "\u0634\u062d\u064a"},
+	{0xFDAB, idnaMapped, 0, "\u0636\u062d\u064a"},
+	{0xFDAC, idnaMapped, 0, "\u0644\u062c\u064a"},
+	{0xFDAD, idnaMapped, 0, "\u0644\u0645\u064a"},
+	{0xFDAE, idnaMapped, 0, "\u064a\u062d\u064a"},
+	{0xFDAF, idnaMapped, 0, "\u064a\u062c\u064a"},
+	{0xFDB0, idnaMapped, 0, "\u064a\u0645\u064a"},
+	{0xFDB1, idnaMapped, 0, "\u0645\u0645\u064a"},
+	{0xFDB2, idnaMapped, 0, "\u0642\u0645\u064a"},
+	{0xFDB3, idnaMapped, 0, "\u0646\u062d\u064a"},
+	{0xFDB4, idnaMapped, 0, "\u0642\u0645\u062d"},
+	{0xFDB5, idnaMapped, 0, "\u0644\u062d\u0645"},
+	{0xFDB6, idnaMapped, 0, "\u0639\u0645\u064a"},
+	{0xFDB7, idnaMapped, 0, "\u0643\u0645\u064a"},
+	{0xFDB8, idnaMapped, 0, "\u0646\u062c\u062d"},
+	{0xFDB9, idnaMapped, 0, "\u0645\u062e\u064a"},
+	{0xFDBA, idnaMapped, 0, "\u0644\u062c\u0645"},
+	{0xFDBB, idnaMapped, 0, "\u0643\u0645\u0645"},
+	{0xFDBC, idnaMapped, 0, "\u0644\u062c\u0645"},
+	{0xFDBD, idnaMapped, 0, "\u0646\u062c\u062d"},
+	{0xFDBE, idnaMapped, 0, "\u062c\u062d\u064a"},
+	{0xFDBF, idnaMapped, 0, "\u062d\u062c\u064a"},
+	{0xFDC0, idnaMapped, 0, "\u0645\u062c\u064a"},
+	{0xFDC1, idnaMapped, 0, "\u0641\u0645\u064a"},
+	{0xFDC2, idnaMapped, 0, "\u0628\u062d\u064a"},
+	{0xFDC3, idnaMapped, 0, "\u0643\u0645\u0645"},
+	{0xFDC4, idnaMapped, 0, "\u0639\u062c\u0645"},
+	{0xFDC5, idnaMapped, 0, "\u0635\u0645\u0645"},
+	{0xFDC6, idnaMapped, 0, "\u0633\u062e\u064a"},
+	{0xFDC7, idnaMapped, 0, "\u0646\u062c\u064a"},
+	{0xFDC8, idnaDisallowed, 0, ""},
+	{0xFDCF, idnaValidNV8, 0, ""},
+	{0xFDD0, idnaDisallowed, 0, ""},
+	{0xFDF0, idnaMapped, 0, "\u0635\u0644\u06d2"},
+	{0xFDF1, idnaMapped, 0, "\u0642\u0644\u06d2"},
+	{0xFDF2, idnaMapped, 0, "\u0627\u0644\u0644\u0647"},
+	{0xFDF3, idnaMapped, 0, "\u0627\u0643\u0628\u0631"},
+	{0xFDF4, idnaMapped, 0, "\u0645\u062d\u0645\u062f"},
+	{0xFDF5, idnaMapped, 0, "\u0635\u0644\u0639\u0645"},
+	{0xFDF6, idnaMapped, 0, "\u0631\u0633\u0648\u0644"},
+	{0xFDF7, idnaMapped, 0, "\u0639\u0644\u064a\u0647"},
+	{0xFDF8, idnaMapped, 0, "\u0648\u0633\u0644\u0645"},
+	{0xFDF9, idnaMapped, 0, "\u0635\u0644\u0649"},
+	{0xFDFA, idnaDisallowed, 0, ""},
+	{0xFDFC, idnaMapped, 0, "\u0631\u06cc\u0627\u0644"},
+	{0xFDFD, idnaValidNV8, 0, ""},
+	{0xFE00, idnaIgnored, 0, ""},
+	{0xFE10, idnaDisallowed, 0, ""},
+	{0xFE11, idnaMapped, -52752, ""},
+	{0xFE12, idnaDisallowed, 0, ""},
+	{0xFE17, idnaMapped, -52737, ""},
+	{0xFE19, idnaDisallowed, 0, ""},
+	{0xFE20, idnaValid, 0, ""},
+	{0xFE30, idnaDisallowed, 0, ""},
+	{0xFE31, idnaMapped, -56861, ""},
+	{0xFE32, idnaMapped, -56863, ""},
+	{0xFE33, idnaDisallowed, 0, ""},
+	{0xFE39, idnaMapped, -52773, ""},
+	{0xFE3B, idnaMapped, -52779, ""},
+	{0xFE3D, idnaMapped, -52787, ""},
+	{0xFE3F, idnaMapped, -52791, ""},
+	{0xFE41, idnaMapped, -52789, ""},
+	{0xFE45, idnaValidNV8, 0, ""},
+	{0xFE47, idnaDisallowed, 0, ""},
+	{0xFE51, idnaMapped, -52816, ""},
+	{0xFE52, idnaDisallowed, 0, ""},
+	{0xFE58, idnaMapped, -56900, ""},
+	{0xFE59, idnaDisallowed, 0, ""},
+	{0xFE5D, idnaMapped, -52809, ""},
+	{0xFE5F, idnaDisallowed, 0, ""},
+	{0xFE63, idnaMapped, -65078, ""},
+	{0xFE64, idnaDisallowed, 0, ""},
+	{0xFE71, idnaMapped, 0, "\u0640\u064b"},
+	{0xFE72, idnaDisallowed, 0, ""},
+	{0xFE73, idnaValid, 0, ""},
+	{0xFE74, idnaDisallowed, 0, ""},
+	{0xFE77, idnaMapped, 0, "\u0640\u064e"},
+	{0xFE78, idnaDisallowed, 0, ""},
+	{0xFE79, idnaMapped, 0, "\u0640\u064f"},
+	{0xFE7A, idnaDisallowed, 0, ""},
+	{0xFE7B, idnaMapped, 0, "\u0640\u0650"},
+	{0xFE7C, idnaDisallowed, 0, ""},
+	{0xFE7D, idnaMapped, 0, "\u0640\u0651"},
+	{0xFE7E, idnaDisallowed, 0, ""},
+	{0xFE7F, idnaMapped, 0, "\u0640\u0652"},
+	{0xFE80, idnaMapped, -63583, ""},
+	{0xFE82, idnaMapped, -63584, ""},
+	{0xFE84, idnaMapped, -63585, ""},
+	{0xFE86, idnaMapped, -63586, ""},
+	{0xFE88, idnaMapped, -63587, ""},
+	{0xFE8A, idnaMapped, -63588, ""},
+	{0xFE8B, idnaMapped, -63589, ""},
+	{0xFE8C, idnaMapped, -63590, ""},
+	{0xFE8E, idnaMapped, -63591, ""},
+	{0xFE90, idnaMapped, -63592, ""},
+	{0xFE91, idnaMapped, -63593, ""},
+	{0xFE92, idnaMapped, -63594, ""},
+	{0xFE94, idnaMapped, -63595, ""},
+	{0xFE96, idnaMapped, -63596, ""},
+	{0xFE97, idnaMapped, -63597, ""},
+	{0xFE98, idnaMapped, -63598, ""},
+	{0xFE9A, idnaMapped, -63599, ""},
+	{0xFE9B, idnaMapped, -63600, ""},
+	{0xFE9C, idnaMapped, -63601, ""},
+	{0xFE9E, idnaMapped, -63602, ""},
+	{0xFE9F, idnaMapped, -63603, ""},
+	{0xFEA0, idnaMapped, -63604, ""},
+	{0xFEA2, idnaMapped, -63605, ""},
+	{0xFEA3, idnaMapped, -63606, ""},
+	{0xFEA4, idnaMapped, -63607, ""},
+	{0xFEA6, idnaMapped, -63608, ""},
+	{0xFEA7, idnaMapped, -63609, ""},
+	{0xFEA8, idnaMapped, -63610, ""},
+	{0xFEAA, idnaMapped, -63611, ""},
+	{0xFEAC, idnaMapped, -63612, ""},
+	{0xFEAE, idnaMapped, -63613, ""},
+	{0xFEB0, idnaMapped, -63614, ""},
+	{0xFEB2, idnaMapped, -63615, ""},
+	{0xFEB3, idnaMapped, -63616, ""},
+	{0xFEB4, idnaMapped, -63617, ""},
+	{0xFEB6, idnaMapped, -63618, ""},
+	{0xFEB7, idnaMapped, -63619, ""},
+	{0xFEB8, idnaMapped, -63620, ""},
+	{0xFEBA, idnaMapped, -63621, ""},
+	{0xFEBB, idnaMapped, -63622, ""},
+	{0xFEBC, idnaMapped, -63623, ""},
+	{0xFEBE, idnaMapped, -63624, ""},
+	{0xFEBF, idnaMapped, -63625, ""},
+	{0xFEC0, idnaMapped, -63626, ""},
+	{0xFEC2, idnaMapped, -63627, ""},
+	{0xFEC3, idnaMapped, -63628, ""},
+	{0xFEC4, idnaMapped, -63629, ""},
+	{0xFEC6, idnaMapped, -63630, ""},
+	{0xFEC7, idnaMapped, -63631, ""},
+	{0xFEC8, idnaMapped, -63632, ""},
+	{0xFECA, idnaMapped, -63633, ""},
+	{0xFECB, idnaMapped, -63634, ""},
+	{0xFECC, idnaMapped, -63635, ""},
+	{0xFECE, idnaMapped, -63636, ""},
+	{0xFECF, idnaMapped, -63637, ""},
+	{0xFED0, idnaMapped, -63638, ""},
+	{0xFED1, idnaMapped, -63632, ""},
+	{0xFED2, idnaMapped, -63633, ""},
+	{0xFED3, idnaMapped, -63634, ""},
+	{0xFED4, idnaMapped, -63635, ""},
+	{0xFED6, idnaMapped, -63636, ""},
+	{0xFED7, idnaMapped, -63637, ""},
+	{0xFED8, idnaMapped, -63638, ""},
+	{0xFEDA, idnaMapped, -63639, ""},
+	{0xFEDB, idnaMapped, -63640, ""},
+	{0xFEDC, idnaMapped, -63641, ""},
+	{0xFEDE, idnaMapped, -63642, ""},
+	{0xFEDF, idnaMapped, -63643, ""},
+	{0xFEE0, idnaMapped, -63644, ""},
+	{0xFEE2, idnaMapped, -63645, ""},
+	{0xFEE3, idnaMapped, -63646, ""},
+	{0xFEE4, idnaMapped, -63647, ""},
+	{0xFEE6, idnaMapped, -63648, ""},
+	{0xFEE7, idnaMapped, -63649, ""},
+	{0xFEE8, idnaMapped, -63650, ""},
+	{0xFEEA, idnaMapped, -63651, ""},
+	{0xFEEB, idnaMapped, -63652, ""},
+	{0xFEEC, idnaMapped, -63653, ""},
+	{0xFEEE, idnaMapped, -63654, ""},
+	{0xFEF0, idnaMapped, -63655, ""},
+	{0xFEF2, idnaMapped, -63656, ""},
+	{0xFEF3, idnaMapped, -63657, ""},
+	{0xFEF4, idnaMapped, -63658, ""},
+	{0xFEF5, idnaMapped, 0, "\u0644\u0622"},
+	{0xFEF7, idnaMapped, 0, "\u0644\u0623"},
+	{0xFEF9, idnaMapped, 0, "\u0644\u0625"},
+	{0xFEFB, idnaMapped, 0, "\u0644\u0627"},
+	{0xFEFD, idnaDisallowed, 0, ""},
+	{0xFEFF, idnaIgnored, 0, ""},
+	{0xFF00, idnaDisallowed, 0, ""},
+	{0xFF0D, idnaMapped, -65248, ""},
+	{0xFF0F, idnaDisallowed, 0, ""},
+	{0xFF10, idnaMapped, -65248, ""},
+	{0xFF1A, idnaDisallowed, 0, ""},
+	{0xFF21, idnaMapped, -65216, ""},
+	{0xFF3B, idnaDisallowed, 0, ""},
+	{0xFF41, idnaMapped, -65248, ""},
+	{0xFF5B, idnaDisallowed, 0, ""},
+	{0xFF5F, idnaMapped, -54746, ""},
+	{0xFF61, idnaMapped, -65331, ""},
+	{0xFF62, idnaMapped, -53078, ""},
+	{0xFF64, idnaMapped, -53091, ""},
+	{0xFF65, idnaMapped, -52842, ""},
+	{0xFF66, idnaMapped, -52852, ""},
+	{0xFF67, idnaMapped, -52934, ""},
+	{0xFF68, idnaMapped, -52933, ""},
+	{0xFF69, idnaMapped, -52932, ""},
+	{0xFF6A, idnaMapped, -52931, ""},
+	{0xFF6B, idnaMapped, -52930, ""},
+	{0xFF6C, idnaMapped, -52873, ""},
+	{0xFF6D, idnaMapped, -52872, ""},
+	{0xFF6E, idnaMapped, -52871, ""},
+	{0xFF6F, idnaMapped, -52908, ""},
+	{0xFF70, idnaMapped, -52852, ""},
+	{0xFF71, idnaMapped, -52943, ""},
+	{0xFF72, idnaMapped, -52942, ""},
+	{0xFF73, idnaMapped, -52941, ""},
+	{0xFF74, idnaMapped, -52940, ""},
+	{0xFF75, idnaMapped, -52939, ""},
+	{0xFF77, idnaMapped, -52938, ""},
+	{0xFF78, idnaMapped, -52937, ""},
+	{0xFF79, idnaMapped, -52936, ""},
+	{0xFF7A, idnaMapped, -52935, ""},
+	{0xFF7B, idnaMapped, -52934, ""},
+	{0xFF7C, idnaMapped, -52933, ""},
+	{0xFF7D, idnaMapped, -52932, ""},
+	{0xFF7E, idnaMapped, -52931, ""},
+	{0xFF7F, idnaMapped, -52930, ""},
+	{0xFF80, idnaMapped, -52929, ""},
+	{0xFF81, idnaMapped, -52928, ""},
+	{0xFF82, idnaMapped, -52926, ""},
+	{0xFF83, idnaMapped, -52925, ""},
+	{0xFF84, idnaMapped, -52924, ""},
+	{0xFF85, idnaMapped, -52923, ""},
+	{0xFF8B, idnaMapped, -52921, ""},
+	{0xFF8C, idnaMapped, -52919, ""},
+	{0xFF8D, idnaMapped, -52917, ""},
+	{0xFF8E, idnaMapped, -52915, ""},
+	{0xFF8F, idnaMapped, -52913, ""},
+	{0xFF94, idnaMapped, -52912, ""},
+	{0xFF95, idnaMapped, -52911, ""},
+	{0xFF96, idnaMapped, -52910, ""},
+	{0xFF9C, idnaMapped, -52909, ""},
+	{0xFF9D, idnaMapped, -52906, ""},
+	{0xFF9E, idnaMapped, -52997, ""},
+	{0xFFA0, idnaDisallowed, 0, ""},
+	{0xFFA1, idnaMapped, -61089, ""},
+	{0xFFA3, idnaMapped, -60921, ""},
+	{0xFFA4, idnaMapped, -61090, ""},
+	{0xFFA5, idnaMapped, -60921, ""},
+	{0xFFA7, idnaMapped, -61092, ""},
+	{0xFFAA, idnaMapped, -60922, ""},
+	{0xFFB0, idnaMapped, -61078, ""},
+	{0xFFB1, idnaMapped, -61099, ""},
+	{0xFFB4, idnaMapped, -61075, ""},
+	{0xFFB5, idnaMapped, -61100, ""},
+	{0xFFBF, idnaDisallowed, 0, ""},
+	{0xFFC2, idnaMapped, -61025, ""},
+	{0xFFC8, idnaDisallowed, 0, ""},
+	{0xFFCA, idnaMapped, -61027, ""},
+	{0xFFD0, idnaDisallowed, 0, ""},
+	{0xFFD2, idnaMapped, -61029, ""},
+	{0xFFD8, idnaDisallowed, 0, ""},
+	{0xFFDA, idnaMapped, -61031, ""},
+	{0xFFDD, idnaDisallowed, 0, ""},
+	{0xFFE0, idnaMapped, -65342, ""},
+	{0xFFE2, idnaMapped, -65334, ""},
+	{0xFFE3, idnaDisallowed, 0, ""},
+	{0xFFE4, idnaMapped, -65342, ""},
+	{0xFFE5, idnaMapped, -65344, ""},
+	{0xFFE6, idnaMapped, -57149, ""},
+	{0xFFE7, idnaDisallowed, 0, ""},
+	{0xFFE8, idnaMapped, -56038, ""},
+	{0xFFE9, idnaMapped, -56921, ""},
+	{0xFFED, idnaMapped, -55885, ""},
+	{0xFFEE, idnaMapped, -55843, ""},
+	{0xFFEF, idnaDisallowed, 0, ""},
+	{0x10000, idnaValid, 0, ""},
+	{0x1000C, idnaDisallowed, 0, ""},
+	{0x1000D, idnaValid, 0, ""},
+	{0x10027, idnaDisallowed, 0, ""},
+	{0x10028, idnaValid, 0, ""},
+	{0x1003B, idnaDisallowed, 0, ""},
+	{0x1003C, idnaValid, 0, ""},
+	{0x1003E, idnaDisallowed, 0, ""},
+	{0x1003F, idnaValid, 0, ""},
+	{0x1004E, idnaDisallowed, 0, ""},
+	{0x10050, idnaValid, 0, ""},
+	{0x1005E, idnaDisallowed, 0, ""},
+	{0x10080, idnaValid, 0, ""},
+	{0x100FB, idnaDisallowed, 0, ""},
+	{0x10100, idnaValidNV8, 0, ""},
+	{0x10103, idnaDisallowed, 0, ""},
+	{0x10107, idnaValidNV8, 0, ""},
+	{0x10134, idnaDisallowed, 0, ""},
+	{0x10137, idnaValidNV8, 0, ""},
+	{0x1018F, idnaDisallowed, 0, ""},
+	{0x10190, idnaValidNV8, 0, ""},
+	{0x1019D, idnaDisallowed, 0, ""},
+	{0x101A0, idnaValidNV8, 0, ""},
+	{0x101A1, idnaDisallowed, 0, ""},
+	{0x101D0, idnaValidNV8, 0, ""},
+	{0x101FD, idnaValid, 0, ""},
+	{0x101FE, idnaDisallowed, 0, ""},
+	{0x10280, idnaValid, 0, ""},
+	{0x1029D, idnaDisallowed, 0, ""},
+	{0x102A0, idnaValid, 0, ""},
+	{0x102D1, idnaDisallowed, 0, ""},
+	{0x102E0, idnaValid, 0, ""},
+	{0x102E1, idnaValidNV8, 0, ""},
+	{0x102FC, idnaDisallowed, 0, ""},
+	{0x10300, idnaValid, 0, ""},
+	{0x10320, idnaValidNV8, 0, ""},
+	{0x10324, idnaDisallowed, 0, ""},
+	{0x1032D, idnaValid, 0, ""},
+	{0x10341, idnaValidNV8, 0, ""},
+	{0x10342, idnaValid, 0, ""},
+	{0x1034A, idnaValidNV8, 0, ""},
+	{0x1034B, idnaDisallowed, 0, ""},
+	{0x10350, idnaValid, 0, ""},
+	{0x1037B, idnaDisallowed, 0, ""},
+	{0x10380, idnaValid, 0, ""},
+	{0x1039E, idnaDisallowed, 0, ""},
+	{0x1039F, idnaValidNV8, 0, ""},
+	{0x103A0, idnaValid, 0, ""},
+	{0x103C4, idnaDisallowed, 0, ""},
+	{0x103C8, idnaValid, 0, ""},
+	{0x103D0, idnaValidNV8, 0, ""},
+	{0x103D6, idnaDisallowed, 0, ""},
+	{0x10400, idnaMapped, 40, ""},
+	{0x10428, idnaValid, 0, ""},
+	{0x1049E, idnaDisallowed, 0, ""},
+	{0x104A0, idnaValid, 0, ""},
+	{0x104AA, idnaDisallowed, 0, ""},
+	{0x104B0, idnaMapped, 40, ""},
+	{0x104D4, idnaDisallowed, 0, ""},
+	{0x104D8, idnaValid, 0, ""},
+	{0x104FC, idnaDisallowed, 0, ""},
+	{0x10500, idnaValid, 0, ""},
+	{0x10528, idnaDisallowed, 0, ""},
+	{0x10530, idnaValid, 0, ""},
+	{0x10564, idnaDisallowed, 0, ""},
+	{0x1056F, idnaValidNV8, 0, ""},
+	{0x10570, idnaMapped, 39, ""},
+	{0x1057B, idnaDisallowed, 0, ""},
+	{0x1057C, idnaMapped, 39, ""},
+	{0x1058B, idnaDisallowed, 0, ""},
+	{0x1058C, idnaMapped, 39, ""},
+	{0x10593, idnaDisallowed, 0, ""},
+	{0x10594, idnaMapped, 39, ""},
+	{0x10596, idnaDisallowed, 0, ""},
+	{0x10597, idnaValid, 0, ""},
+	{0x105A2, idnaDisallowed, 0, ""},
+	{0x105A3, idnaValid, 0, ""},
+	{0x105B2, idnaDisallowed, 0, ""},
+	{0x105B3, idnaValid, 0, ""},
+	{0x105BA, idnaDisallowed, 0, ""},
+	{0x105BB, idnaValid, 0, ""},
+	{0x105BD, idnaDisallowed, 0, ""},
+	{0x10600, idnaValid, 0, ""},
+	{0x10737, idnaDisallowed, 0, ""},
+	{0x10740, idnaValid, 0, ""},
+	{0x10756, idnaDisallowed, 0, ""},
+	{0x10760, idnaValid, 0, ""},
+	{0x10768, idnaDisallowed, 0, ""},
+	{0x10780, idnaValid, 0, ""},
+	{0x10781, idnaMapped, -66737, ""},
+	{0x10783, idnaMapped, -67229, ""},
+	{0x10784, idnaMapped, -66795, ""},
+	{0x10785, idnaMapped, -66866, ""},
+	{0x10786, idnaDisallowed, 0, ""},
+	{0x10787, idnaMapped, -66788, ""},
+	{0x10788, idnaMapped, -23586, ""},
+	{0x10789, idnaMapped, -66788, ""},
+	{0x1078A, idnaMapped, -66790, ""},
+	{0x1078B, idnaMapped, -66869, ""},
+	{0x1078D, idnaMapped, -59900, ""},
+	{0x1078E, idnaMapped, -66870, ""},
+	{0x1078F, idnaMapped, -66865, ""},
+	{0x10790, idnaMapped, -66791, ""},
+	{0x10791, idnaMapped, -66861, ""},
+	{0x10792, idnaMapped, -66864, ""},
+	{0x10793, idnaMapped, -66867, ""},
+	{0x10794, idnaMapped, -66809, ""},
+	{0x10795, idnaMapped, -67182, ""},
+	{0x10796, idnaMapped, -66810, ""},
+	{0x10797, idnaMapped, -66864, ""},
+	{0x10798, idnaMapped, -66836, ""},
+	{0x10799, idnaMapped, -66799, ""},
+	{0x1079B, idnaMapped, -66863, ""},
+	{0x1079C, idnaMapped, 55144, ""},
+	{0x1079D, idnaMapped, -24591, ""},
+	{0x1079E, idnaMapped, -66864, ""},
+	{0x1079F, idnaMapped, 55142, ""},
+	{0x107A0, idnaMapped, -66834, ""},
+	{0x107A1, idnaMapped, 55141, ""},
+	{0x107A2, idnaMapped, -67242, ""},
+	{0x107A3, idnaMapped, -66861, ""},
+	{0x107A5, idnaMapped, -67380, ""},
+	{0x107A6, idnaMapped, -66860, ""},
+	{0x107A7, idnaMapped, 55137, ""},
+	{0x107A8, idnaMapped, -66859, ""},
+	{0x107AA, idnaMapped, -66858, ""},
+	{0x107AB, idnaMapped, -66819, ""},
+	{0x107AC, idnaMapped, -66822, ""},
+	{0x107AD, idnaMapped, -23622, ""},
+	{0x107AE, idnaMapped, -66823, ""},
+	{0x107AF, idnaMapped, -66855, ""},
+	{0x107B0, idnaMapped, -56127, ""},
+	{0x107B1, idnaDisallowed, 0, ""},
+	{0x107B2, idnaMapped, -66851, ""},
+	{0x107B3, idnaMapped, -66834, ""},
+	{0x107B5, idnaMapped, -66845, ""},
+	{0x107B6, idnaMapped, -67062, ""},
+	{0x107B9, idnaMapped, 55121, ""},
+	{0x107BA, idnaMapped, 55140, ""},
+	{0x107BB, idnaDisallowed, 0, ""},
+	{0x10800, idnaValid, 0, ""},
+	{0x10806, idnaDisallowed, 0, ""},
+	{0x10808, idnaValid, 0, ""},
+	{0x10809, idnaDisallowed, 0, ""},
+	{0x1080A, idnaValid, 0, ""},
+	{0x10836, idnaDisallowed, 0, ""},
+	{0x10837, idnaValid, 0, ""},
+	{0x10839, idnaDisallowed, 0, ""},
+	{0x1083C, idnaValid, 0, ""},
+	{0x1083D, idnaDisallowed, 0, ""},
+	{0x1083F, idnaValid, 0, ""},
+	{0x10856, idnaDisallowed, 0, ""},
+	{0x10857, idnaValidNV8, 0, ""},
+	{0x10860, idnaValid, 0, ""},
+	{0x10877, idnaValidNV8, 0, ""},
+	{0x10880, idnaValid, 0, ""},
+	{0x1089F, idnaDisallowed, 0, ""},
+	{0x108A7, idnaValidNV8, 0, ""},
+	{0x108B0, idnaDisallowed, 0, ""},
+	{0x108E0, idnaValid, 0, ""},
+	{0x108F3, idnaDisallowed, 0, ""},
+	{0x108F4, idnaValid, 0, ""},
+	{0x108F6, idnaDisallowed, 0, ""},
+	{0x108FB, idnaValidNV8, 0, ""},
+	{0x10900, idnaValid, 0, ""},
+	{0x10916, idnaValidNV8, 0, ""},
+	{0x1091C, idnaDisallowed, 0, ""},
+	{0x1091F, idnaValidNV8, 0, ""},
+	{0x10920, idnaValid, 0, ""},
+	{0x1093A, idnaDisallowed, 0, ""},
+	{0x1093F, idnaValidNV8, 0, ""},
+	{0x10940, idnaDisallowed, 0, ""},
+	{0x10980, idnaValid, 0, ""},
+	{0x109B8, idnaDisallowed, 0, ""},
+	{0x109BC, idnaValidNV8, 0, ""},
+	{0x109BE, idnaValid, 0, ""},
+	{0x109C0, idnaValidNV8, 0, ""},
+	{0x109D0, idnaDisallowed, 0, ""},
+	{0x109D2, idnaValidNV8, 0, ""},
+	{0x10A00, idnaValid, 0, ""},
+	{0x10A04, idnaDisallowed, 0, ""},
+	{0x10A05, idnaValid, 0, ""},
+	{0x10A07, idnaDisallowed, 0, ""},
+	{0x10A0C, idnaValid, 0, ""},
+	{0x10A14, idnaDisallowed, 0, ""},
+	{0x10A15, idnaValid, 0, ""},
+	{0x10A18, idnaDisallowed, 0, ""},
+	{0x10A19, idnaValid, 0, ""},
+	{0x10A36, idnaDisallowed, 0, ""},
+	{0x10A38, idnaValid, 0, ""},
+	{0x10A3B, idnaDisallowed, 0, ""},
+	{0x10A3F, idnaValid, 0, ""},
+	{0x10A40, idnaValidNV8, 0, ""},
+	{0x10A49, idnaDisallowed, 0, ""},
+	{0x10A50, idnaValidNV8, 0, ""},
+	{0x10A59, idnaDisallowed, 0, ""},
+	{0x10A60, idnaValid, 0, ""},
+	{0x10A7D, idnaValidNV8, 0, ""},
+	{0x10A80, idnaValid, 0, ""},
+	{0x10A9D, idnaValidNV8, 0, ""},
+	{0x10AA0, idnaDisallowed, 0, ""},
+	{0x10AC0, idnaValid, 0, ""},
+	{0x10AC8, idnaValidNV8, 0, ""},
+	{0x10AC9, idnaValid, 0, ""},
+	{0x10AE7, idnaDisallowed, 0, ""},
+	{0x10AEB, idnaValidNV8, 0, ""},
+	{0x10AF7, idnaDisallowed, 0, ""},
+	{0x10B00, idnaValid, 0, ""},
+	{0x10B36, idnaDisallowed, 0, ""},
+	{0x10B39, idnaValidNV8, 0, ""},
+	{0x10B40, idnaValid, 0, ""},
+	{0x10B56, idnaDisallowed, 0, ""},
+	{0x10B58, idnaValidNV8, 0, ""},
+	{0x10B60, idnaValid, 0, ""},
+	{0x10B73, idnaDisallowed, 0, ""},
+	{0x10B78, idnaValidNV8, 0, ""},
+	{0x10B80, idnaValid, 0, ""},
+	{0x10B92, idnaDisallowed, 0, ""},
+	{0x10B99, idnaValidNV8, 0, ""},
+	{0x10B9D, idnaDisallowed, 0, ""},
+	{0x10BA9, idnaValidNV8, 0, ""},
+	{0x10BB0, idnaDisallowed, 0, ""},
+	{0x10C00, idnaValid, 0, ""},
+	{0x10C49, idnaDisallowed, 0, ""},
+	{0x10C80, idnaMapped, 64, ""},
+	{0x10CB3, idnaDisallowed, 0, ""},
+	{0x10CC0, idnaValid, 0, ""},
+	{0x10CF3, idnaDisallowed, 0, ""},
+	{0x10CFA, idnaValidNV8, 0, ""},
+	{0x10D00, idnaValid, 0, ""},
+	{0x10D28, idnaDisallowed, 0, ""},
+	{0x10D30, idnaValid, 0, ""},
+	{0x10D3A, idnaDisallowed, 0, ""},
+	{0x10E60, idnaValidNV8, 0, ""},
+	{0x10E7F, idnaDisallowed, 0, ""},
+	{0x10E80, idnaValid, 0, ""},
+	{0x10EAA, idnaDisallowed, 0, ""},
+	{0x10EAB, idnaValid, 0, ""},
+	{0x10EAD, idnaValidNV8, 0, ""},
+	{0x10EAE, idnaDisallowed, 0, ""},
+	{0x10EB0, idnaValid, 0, ""},
+	{0x10EB2, idnaDisallowed, 0, ""},
+	{0x10EFD, idnaValid, 0, ""},
+	{0x10F1D, idnaValidNV8, 0, ""},
+	{0x10F27, idnaValid, 0, ""},
+	{0x10F28, idnaDisallowed, 0, ""},
+	{0x10F30, idnaValid, 0, ""},
+	{0x10F51, idnaValidNV8, 0, ""},
+	{0x10F5A, idnaDisallowed, 0, ""},
+	{0x10F70, idnaValid, 0, ""},
+	{0x10F86, idnaValidNV8, 0, ""},
+	{0x10F8A, idnaDisallowed, 0, ""},
+	{0x10FB0, idnaValid, 0, ""},
+	{0x10FC5, idnaValidNV8, 0, ""},
+	{0x10FCC, idnaDisallowed, 0, ""},
+	{0x10FE0, idnaValid, 0, ""},
+	{0x10FF7, idnaDisallowed, 0, ""},
+	{0x11000, idnaValid, 0, ""},
+	{0x11047, idnaValidNV8, 0, ""},
+	{0x1104E, idnaDisallowed, 0, ""},
+	{0x11052, idnaValidNV8, 0, ""},
+	{0x11066, idnaValid, 0, ""},
+	{0x11076, idnaDisallowed, 0, ""},
+	{0x1107F, idnaValid, 0, ""},
+	{0x110BB, idnaValidNV8, 0, ""},
+	{0x110BD, idnaDisallowed, 0, ""},
+	{0x110BE, idnaValidNV8, 0, ""},
+	{0x110C2, idnaValid, 0, ""},
+	{0x110C3, idnaDisallowed, 0, ""},
+	{0x110D0, idnaValid, 0, ""},
+	{0x110E9, idnaDisallowed, 0, ""},
+	{0x110F0, idnaValid, 0, ""},
+	{0x110FA, idnaDisallowed, 0, ""},
+	{0x11100, idnaValid, 0, ""},
+	{0x11135, idnaDisallowed, 0, ""},
+	{0x11136, idnaValid, 0, ""},
+	{0x11140, idnaValidNV8, 0, ""},
+	{0x11144, idnaValid, 0, ""},
+	{0x11148, idnaDisallowed, 0, ""},
+	{0x11150, idnaValid, 0, ""},
+	{0x11174, idnaValidNV8, 0, ""},
+	{0x11176, idnaValid, 0, ""},
+	{0x11177, idnaDisallowed, 0, ""},
+	{0x11180, idnaValid, 0, ""},
+	{0x111C5, idnaValidNV8, 0, ""},
+	{0x111C9, idnaValid, 0, ""},
+	{0x111CD, idnaValidNV8, 0, ""},
+	{0x111CE, idnaValid, 0, ""},
+	{0x111DB, idnaValidNV8, 0, ""},
+	{0x111DC, idnaValid, 0, ""},
+	{0x111DD, idnaValidNV8, 0, ""},
+	{0x111E0, idnaDisallowed, 0, ""},
+	{0x111E1, idnaValidNV8, 0, ""},
+	{0x111F5, idnaDisallowed, 0, ""},
+	{0x11200, idnaValid, 0, ""},
+	{0x11212, idnaDisallowed, 0, ""},
+	{0x11213, idnaValid, 0, ""},
+	{0x11238, idnaValidNV8, 0, ""},
+	{0x1123E, idnaValid, 0, ""},
+	{0x11242, idnaDisallowed, 0, ""},
+	{0x11280, idnaValid, 0, ""},
+	{0x11287, idnaDisallowed, 0, ""},
+	{0x11288, idnaValid, 0, ""},
+	{0x11289, idnaDisallowed, 0, ""},
+	{0x1128A, idnaValid, 0, ""},
+	{0x1128E, idnaDisallowed, 0, ""},
+	{0x1128F, idnaValid, 0, ""},
+	{0x1129E, idnaDisallowed, 0, ""},
+	{0x1129F, idnaValid, 0, ""},
+	{0x112A9, idnaValidNV8, 0, ""},
+	{0x112AA, idnaDisallowed, 0, ""},
+	{0x112B0, idnaValid, 0, ""},
+	{0x112EB, idnaDisallowed, 0, ""},
+	{0x112F0, idnaValid, 0, ""},
+	{0x112FA, idnaDisallowed, 0, ""},
+	{0x11300, idnaValid, 0, ""},
+	{0x11304, idnaDisallowed, 0, ""},
+	{0x11305, idnaValid, 0, ""},
+	{0x1130D, idnaDisallowed, 0, ""},
+	{0x1130F, idnaValid, 0, ""},
+	{0x11311, idnaDisallowed, 0, ""},
+	{0x11313, idnaValid, 0, ""},
+	{0x11329, idnaDisallowed, 0, ""},
+	{0x1132A, idnaValid, 0, ""},
+	{0x11331, idnaDisallowed, 0, ""},
+	{0x11332, idnaValid, 0, ""},
+	{0x11334, idnaDisallowed, 0, ""},
+	{0x11335, idnaValid, 0, ""},
+	{0x1133A, idnaDisallowed, 0, ""},
+	{0x1133B, idnaValid, 0, ""},
+	{0x11345, idnaDisallowed, 0, ""},
+	{0x11347, idnaValid, 0, ""},
+	{0x11349, idnaDisallowed, 0, ""},
+	{0x1134B, idnaValid, 0, ""},
+	{0x1134E, idnaDisallowed, 0, ""},
+	{0x11350, idnaValid, 0, ""},
+	{0x11351, idnaDisallowed, 0, ""},
+	{0x11357, idnaValid, 0, ""},
+	{0x11358, idnaDisallowed, 0, ""},
+	{0x1135D, idnaValid, 0, ""},
+	{0x11364, idnaDisallowed, 0, ""},
+	{0x11366, idnaValid, 0, ""},
+	{0x1136D, idnaDisallowed, 0, ""},
+	{0x11370, idnaValid, 0, ""},
+	{0x11375, idnaDisallowed, 0, ""},
+	{0x11400, idnaValid, 0, ""},
+	{0x1144B, idnaValidNV8, 0, ""},
+	{0x11450, idnaValid, 0, ""},
+	{0x1145A, idnaValidNV8, 0, ""},
+	{0x1145C, idnaDisallowed, 0, ""},
+	{0x1145D, idnaValidNV8, 0, ""},
+	{0x1145E, idnaValid, 0, ""},
+	{0x11462, idnaDisallowed, 0, ""},
+	{0x11480, idnaValid, 0, ""},
+	{0x114C6, idnaValidNV8, 0, ""},
+	{0x114C7, idnaValid, 0, ""},
+	{0x114C8, idnaDisallowed, 0, ""},
+	{0x114D0, idnaValid, 0, ""},
+	{0x114DA, idnaDisallowed, 0, ""},
+	{0x11580, idnaValid, 0, ""},
+	{0x115B6, idnaDisallowed, 0, ""},
+	{0x115B8, idnaValid, 0, ""},
+	{0x115C1, idnaValidNV8, 0, ""},
+	{0x115D8, idnaValid, 0, ""},
+	{0x115DE, idnaDisallowed, 0, ""},
+	{0x11600, idnaValid, 0, ""},
+	{0x11641, idnaValidNV8, 0, ""},
+	{0x11644, idnaValid, 0, ""},
+	{0x11645, idnaDisallowed, 0, ""},
+	{0x11650, idnaValid, 0, ""},
+	{0x1165A, idnaDisallowed, 0, ""},
+	{0x11660, idnaValidNV8, 0, ""},
+	{0x1166D, idnaDisallowed, 0, ""},
+	{0x11680, idnaValid, 0, ""},
+	{0x116B9, idnaValidNV8, 0, ""},
+	{0x116BA, idnaDisallowed, 0, ""},
+	{0x116C0, idnaValid, 0, ""},
+	{0x116CA, idnaDisallowed, 0, ""},
+	{0x11700, idnaValid, 0, ""},
+	{0x1171B, idnaDisallowed, 0, ""},
+	{0x1171D, idnaValid, 0, ""},
+	{0x1172C, idnaDisallowed, 0, ""},
+	{0x11730, idnaValid, 0, ""},
+	{0x1173A, idnaValidNV8, 0, ""},
+	{0x11740, idnaValid, 0, ""},
+	{0x11747, idnaDisallowed, 0, ""},
+	{0x11800, idnaValid, 0, ""},
+	{0x1183B, idnaValidNV8, 0, ""},
+	{0x1183C, idnaDisallowed, 0, ""},
+	{0x118A0, idnaMapped, 32, ""},
+	{0x118C0, idnaValid, 0, ""},
+	{0x118EA, idnaValidNV8, 0, ""},
+	{0x118F3, idnaDisallowed, 0, ""},
+	{0x118FF, idnaValid, 0, ""},
+	{0x11907, idnaDisallowed, 0, ""},
+	{0x11909, idnaValid, 0, ""},
+	{0x1190A, idnaDisallowed, 0, ""},
+	{0x1190C, idnaValid, 0, ""},
+	{0x11914, idnaDisallowed, 0, ""},
+	{0x11915, idnaValid, 0, ""},
+	{0x11917, idnaDisallowed, 0, ""},
+	{0x11918, idnaValid, 0, ""},
+	{0x11936, idnaDisallowed, 0, ""},
+	{0x11937, idnaValid, 0, ""},
+	{0x11939, idnaDisallowed, 0, ""},
+	{0x1193B, idnaValid, 0, ""},
+	{0x11944, idnaValidNV8, 0, ""},
+	{0x11947, idnaDisallowed, 0, ""},
+	{0x11950, idnaValid, 0, ""},
+	{0x1195A, idnaDisallowed, 0, ""},
+	{0x119A0, idnaValid, 0, ""},
+	{0x119A8, idnaDisallowed, 0, ""},
+	{0x119AA, idnaValid, 0, ""},
+	{0x119D8, idnaDisallowed, 0, ""},
+	{0x119DA, idnaValid, 0, ""},
+	{0x119E2, idnaValidNV8, 0, ""},
+	{0x119E3, idnaValid, 0, ""},
+	{0x119E5, idnaDisallowed, 0, ""},
+	{0x11A00, idnaValid, 0, ""},
+	{0x11A3F, idnaValidNV8, 0, ""},
+	{0x11A47, idnaValid, 0, ""},
+	{0x11A48, idnaDisallowed, 0, ""},
+	{0x11A50, idnaValid, 0, ""},
+	{0x11A9A, idnaValidNV8, 0, ""},
+	{0x11A9D, idnaValid, 0, ""},
+	{0x11A9E, idnaValidNV8, 0, ""},
+	{0x11AA3, idnaDisallowed, 0, ""},
+	{0x11AB0, idnaValid, 0, ""},
+	{0x11AF9, idnaDisallowed, 0, ""},
+	{0x11B00, idnaValidNV8, 0, ""},
+	{0x11B0A, idnaDisallowed, 0, ""},
+	{0x11C00, idnaValid, 0, ""},
+	{0x11C09, idnaDisallowed, 0, ""},
+	{0x11C0A, idnaValid, 0, ""},
+	{0x11C37, idnaDisallowed, 0, ""},
+	{0x11C38, idnaValid, 0, ""},
+	{0x11C41, idnaValidNV8, 0, ""},
+	{0x11C46, idnaDisallowed, 0, ""},
+	{0x11C50, idnaValid, 0, ""},
+	{0x11C5A, idnaValidNV8, 0, ""},
+	{0x11C6D, idnaDisallowed, 0, ""},
+	{0x11C70, idnaValidNV8, 0, ""},
+	{0x11C72, idnaValid, 0, ""},
+	{0x11C90, idnaDisallowed, 0, ""},
+	{0x11C92, idnaValid, 0, ""},
+	{0x11CA8, idnaDisallowed, 0, ""},
+	{0x11CA9, idnaValid, 0, ""},
+	{0x11CB7, idnaDisallowed, 0, ""},
+	{0x11D00, idnaValid, 0, ""},
+	{0x11D07, idnaDisallowed, 0, ""},
+	{0x11D08, idnaValid, 0, ""},
+	{0x11D0A, idnaDisallowed, 0, ""},
+	{0x11D0B, idnaValid, 0, ""},
+	{0x11D37, idnaDisallowed, 0, ""},
+	{0x11D3A, idnaValid, 0, ""},
+	{0x11D3B, idnaDisallowed, 0, ""},
+	{0x11D3C, idnaValid, 0, ""},
+	{0x11D3E, idnaDisallowed, 0, ""},
+	{0x11D3F, idnaValid, 0, ""},
+	{0x11D48, idnaDisallowed, 0, ""},
+	{0x11D50, idnaValid, 0, ""},
+	{0x11D5A, idnaDisallowed, 0, ""},
+	{0x11D60, idnaValid, 0, ""},
+	{0x11D66, idnaDisallowed, 0, ""},
+	{0x11D67, idnaValid, 0, ""},
+	{0x11D69, idnaDisallowed, 0, ""},
+	{0x11D6A, idnaValid, 0, ""},
+	{0x11D8F, idnaDisallowed, 0, ""},
+	{0x11D90, idnaValid, 0, ""},
+	{0x11D92, idnaDisallowed, 0, ""},
+	{0x11D93, idnaValid, 0, ""},
+	{0x11D99, idnaDisallowed, 0, ""},
+	{0x11DA0, idnaValid, 0, ""},
+	{0x11DAA, idnaDisallowed, 0, ""},
+	{0x11EE0, idnaValid, 0, ""},
+	{0x11EF7, idnaValidNV8, 0, ""},
+	{0x11EF9, idnaDisallowed, 0, ""},
+	{0x11F00, idnaValid, 0, ""},
+	{0x11F11, idnaDisallowed, 0, ""},
+	{0x11F12, idnaValid, 0, ""},
+	{0x11F3B, idnaDisallowed, 0, ""},
+	{0x11F3E, idnaValid, 0, ""},
+	{0x11F43, idnaValidNV8, 0, ""},
+	{0x11F50, idnaValid, 0, ""},
+	{0x11F5A, idnaDisallowed, 0, ""},
+	{0x11FB0, idnaValid, 0, ""},
+	{0x11FB1, idnaDisallowed, 0, ""},
+	{0x11FC0, idnaValidNV8, 0, ""},
+	{0x11FF2, idnaDisallowed, 0, ""},
+	{0x11FFF, idnaValidNV8, 0, ""},
+	{0x12000, idnaValid, 0, ""},
+	{0x1239A, idnaDisallowed, 0, ""},
+	{0x12400, idnaValidNV8, 0, ""},
+	{0x1246F, idnaDisallowed, 0, ""},
+	{0x12470, idnaValidNV8, 0, ""},
+	{0x12475, idnaDisallowed, 0, ""},
+	{0x12480, idnaValid, 0, ""},
+	{0x12544, idnaDisallowed, 0, ""},
+	{0x12F90, idnaValid, 0, ""},
+	{0x12FF1, idnaValidNV8, 0, ""},
+	{0x12FF3, idnaDisallowed, 0, ""},
+	{0x13000, idnaValid, 0, ""},
+	{0x13430, idnaDisallowed, 0, ""},
+	{0x13440, idnaValid, 0, ""},
+	{0x13456, idnaDisallowed, 0, ""},
+	{0x14400, idnaValid, 0, ""},
+	{0x14647, idnaDisallowed, 0, ""},
+	{0x16800, idnaValid, 0, ""},
+	{0x16A39, idnaDisallowed, 0, ""},
+	{0x16A40, idnaValid, 0, ""},
+	{0x16A5F, idnaDisallowed, 0, ""},
+	{0x16A60, idnaValid, 0, ""},
+	{0x16A6A, idnaDisallowed, 0, ""},
+	{0x16A6E, idnaValidNV8, 0, ""},
+	{0x16A70, idnaValid, 0, ""},
+	{0x16ABF, idnaDisallowed, 0, ""},
+	{0x16AC0, idnaValid, 0, ""},
+	{0x16ACA, idnaDisallowed, 0, ""},
+	{0x16AD0, idnaValid, 0, ""},
+	{0x16AEE, idnaDisallowed, 0, ""},
+	{0x16AF0, idnaValid, 0, ""},
+	{0x16AF5, idnaValidNV8, 0, ""},
+	{0x16AF6, idnaDisallowed, 0, ""},
+	{0x16B00, idnaValid, 0, ""},
+	{0x16B37, idnaValidNV8, 0, ""},
+	{0x16B40, idnaValid, 0, ""},
+	{0x16B44, idnaValidNV8, 0, ""},
+	{0x16B46, idnaDisallowed, 0, ""},
+	{0x16B50, idnaValid, 0, ""},
+	{0x16B5A, idnaDisallowed, 0, ""},
+	{0x16B5B, idnaValidNV8, 0, ""},
+	{0x16B62, idnaDisallowed, 0, ""},
+	{0x16B63, idnaValid, 0, ""},
+	{0x16B78, idnaDisallowed, 0, ""},
+	{0x16B7D, idnaValid, 0, ""},
+	{0x16B90, idnaDisallowed, 0, ""},
+	{0x16E40, idnaMapped, 32, ""},
+	{0x16E60, idnaValid, 0, ""},
+	{0x16E80, idnaValidNV8, 0, ""},
+	{0x16E9B, idnaDisallowed, 0, ""},
+	{0x16F00, idnaValid, 0, ""},
+	{0x16F4B, idnaDisallowed, 0, ""},
+	{0x16F4F, idnaValid, 0, ""},
+	{0x16F88, idnaDisallowed, 0, ""},
+	{0x16F8F, idnaValid, 0, ""},
+	{0x16FA0, idnaDisallowed, 0, ""},
+	{0x16FE0, idnaValid, 0, ""},
+	{0x16FE2, idnaValidNV8, 0, ""},
+	{0x16FE3, idnaValid, 0, ""},
+	{0x16FE5, idnaDisallowed, 0, ""},
+	{0x16FF0, idnaValid, 0, ""},
+	{0x16FF2, idnaDisallowed, 0, ""},
+	{0x17000, idnaValid, 0, ""},
+	{0x187F8, idnaDisallowed, 0, ""},
+	{0x18800, idnaValid, 0, ""},
+	{0x18CD6, idnaDisallowed, 0, ""},
+	{0x18D00, idnaValid, 0, ""},
+	{0x18D09, idnaDisallowed, 0, ""},
+	{0x1AFF0, idnaValid, 0, ""},
+	{0x1AFF4, idnaDisallowed, 0, ""},
+	{0x1AFF5, idnaValid, 0, ""},
+	{0x1AFFC, idnaDisallowed, 0, ""},
+	{0x1AFFD, idnaValid, 0, ""},
+	{0x1AFFF, idnaDisallowed, 0, ""},
+	{0x1B000, idnaValid, 0, ""},
+	{0x1B123, idnaDisallowed, 0, ""},
+	{0x1B132, idnaValid, 0, ""},
+	{0x1B133, idnaDisallowed, 0, ""},
+	{0x1B150, idnaValid, 0, ""},
+	{0x1B153, idnaDisallowed, 0, ""},
+	{0x1B155, idnaValid, 0, ""},
+	{0x1B156, idnaDisallowed, 0, ""},
+	{0x1B164, idnaValid, 0, ""},
+	{0x1B168, idnaDisallowed, 0, ""},
+	{0x1B170, idnaValid, 0, ""},
+	{0x1B2FC, idnaDisallowed, 0, ""},
+	{0x1BC00, idnaValid, 0, ""},
+	{0x1BC6B, idnaDisallowed, 0, ""},
+	{0x1BC70, idnaValid, 0, ""},
+	{0x1BC7D, idnaDisallowed, 0, ""},
+	{0x1BC80, idnaValid, 0, ""},
+	{0x1BC89, idnaDisallowed, 0, ""},
+	{0x1BC90, idnaValid, 0, ""},
+	{0x1BC9A, idnaDisallowed, 0, ""},
+	{0x1BC9C, idnaValidNV8, 0, ""},
+	{0x1BC9D, idnaValid, 0, ""},
+	{0x1BC9F, idnaValidNV8, 0, ""},
+	{0x1BCA0, idnaIgnored, 0, ""},
+	{0x1BCA4, idnaDisallowed, 0, ""},
+	{0x1CF00, idnaValid, 0, ""},
+	{0x1CF2E, idnaDisallowed, 0, ""},
+	{0x1CF30, idnaValid, 0, ""},
+	{0x1CF47, idnaDisallowed, 0, ""},
+	{0x1CF50, idnaValidNV8, 0, ""},
+	{0x1CFC4, idnaDisallowed, 0, ""},
+	{0x1D000, idnaValidNV8, 0, ""},
+	{0x1D0F6, idnaDisallowed, 0, ""},
+	{0x1D100, idnaValidNV8, 0, ""},
+	{0x1D127, idnaDisallowed, 0, ""},
+	{0x1D129, idnaValidNV8, 0, ""},
+	{0x1D15E, idnaMapped, 0, "\U0001d157\U0001d165"},
+	{0x1D15F, idnaMapped, 0, "\U0001d158\U0001d165"},
+	{0x1D160, idnaMapped, 0, "\U0001d158\U0001d165\U0001d16e"},
+	{0x1D161, idnaMapped, 0, "\U0001d158\U0001d165\U0001d16f"},
+	{0x1D162, idnaMapped, 0, "\U0001d158\U0001d165\U0001d170"},
+	{0x1D163, idnaMapped, 0, "\U0001d158\U0001d165\U0001d171"},
+	{0x1D164, idnaMapped, 0, "\U0001d158\U0001d165\U0001d172"},
+	{0x1D165, idnaValidNV8, 0, ""},
+	{0x1D173, idnaDisallowed, 0, ""},
+	{0x1D17B, idnaValidNV8, 0, ""},
+	{0x1D1BB, idnaMapped, 0, "\U0001d1b9\U0001d165"},
+	{0x1D1BC, idnaMapped, 0, "\U0001d1ba\U0001d165"},
+	{0x1D1BD, idnaMapped, 0, "\U0001d1b9\U0001d165\U0001d16e"},
+	{0x1D1BE, idnaMapped, 0, "\U0001d1ba\U0001d165\U0001d16e"},
+	{0x1D1BF, idnaMapped, 0, "\U0001d1b9\U0001d165\U0001d16f"},
+	{0x1D1C0, idnaMapped, 0, "\U0001d1ba\U0001d165\U0001d16f"},
+	{0x1D1C1, idnaValidNV8, 0, ""},
+	{0x1D1EB, idnaDisallowed, 0, ""},
+	{0x1D200, idnaValidNV8, 0, ""},
+	{0x1D246, idnaDisallowed, 0, ""},
+	{0x1D2C0, idnaValidNV8, 0, ""},
+	{0x1D2D4, idnaDisallowed, 0, ""},
+	{0x1D2E0, idnaValidNV8, 0, ""},
+	{0x1D2F4, idnaDisallowed, 0, ""},
+	{0x1D300, idnaValidNV8, 0, ""},
+	{0x1D357, idnaDisallowed, 0, ""},
+	{0x1D360, idnaValidNV8, 0, ""},
+	{0x1D379, idnaDisallowed, 0, ""},
+	{0x1D400, idnaMapped, -119711, ""},
+	{0x1D41A, idnaMapped, -119737, ""},
+	{0x1D434, idnaMapped, -119763, ""},
+	{0x1D44E, idnaMapped, -119789, ""},
+	{0x1D455, idnaDisallowed, 0, ""},
+	{0x1D456, idnaMapped, -119789, ""},
+	{0x1D468, idnaMapped, -119815, ""},
+	{0x1D482, idnaMapped, -119841, ""},
+	{0x1D49C, idnaMapped, -119867, ""},
+	{0x1D49D, idnaDisallowed, 0, ""},
+	{0x1D49E, idnaMapped, -119867, ""},
+	{0x1D4A0, idnaDisallowed, 0, ""},
+	{0x1D4A2, idnaMapped, -119867, ""},
+	{0x1D4A3, idnaDisallowed, 0, ""},
+	{0x1D4A5, idnaMapped, -119867, ""},
+	{0x1D4A7, idnaDisallowed, 0, ""},
+	{0x1D4A9, idnaMapped, -119867, ""},
+	{0x1D4AD, idnaDisallowed, 0, ""},
+	{0x1D4AE, idnaMapped, -119867, ""},
+	{0x1D4B6, idnaMapped, -119893, ""},
+	{0x1D4BA, idnaDisallowed, 0, ""},
+	{0x1D4BB, idnaMapped, -119893, ""},
+	{0x1D4BC, idnaDisallowed, 0, ""},
+	{0x1D4BD, idnaMapped, -119893, ""},
+	{0x1D4C4, idnaDisallowed, 0, ""},
+	{0x1D4C5, idnaMapped, -119893, ""},
+	{0x1D4D0, idnaMapped, -119919, ""},
+	{0x1D4EA, idnaMapped, -119945, ""},
+	{0x1D504, idnaMapped, -119971, ""},
+	{0x1D506, idnaDisallowed, 0, ""},
+	{0x1D507, idnaMapped, -119971, ""},
+	{0x1D50B, idnaDisallowed, 0, ""},
+	{0x1D50D, idnaMapped, -119971, ""},
+	{0x1D515, idnaDisallowed, 0, ""},
+	{0x1D516, idnaMapped, -119971, ""},
+	{0x1D51D, idnaDisallowed, 0, ""},
+	{0x1D51E, idnaMapped, -119997, ""},
+	{0x1D538, idnaMapped, -120023, ""},
+	{0x1D53A, idnaDisallowed, 0, ""},
+	{0x1D53B, idnaMapped, -120023, ""},
+	{0x1D53F, idnaDisallowed, 0, ""},
+	{0x1D540, idnaMapped, -120023, ""},
+	{0x1D545, idnaDisallowed, 0, ""},
+	{0x1D546, idnaMapped, -120023, ""},
+	{0x1D547, idnaDisallowed, 0, ""},
+	{0x1D54A, idnaMapped, -120023, ""},
+	{0x1D551, idnaDisallowed, 0, ""},
+	{0x1D552, idnaMapped, -120049, ""},
+	{0x1D56C, idnaMapped, -120075, ""},
+	{0x1D586, idnaMapped, -120101, ""},
+	{0x1D5A0, idnaMapped, -120127, ""},
+	{0x1D5BA, idnaMapped, -120153, ""},
+	{0x1D5D4, idnaMapped, -120179, ""},
+	{0x1D5EE, idnaMapped, -120205, ""},
+	{0x1D608, idnaMapped, -120231, ""},
+	{0x1D622, idnaMapped, -120257, ""},
+	{0x1D63C, idnaMapped, -120283, ""},
+	{0x1D656, idnaMapped, -120309, ""},
+	{0x1D670, idnaMapped, -120335, ""},
+	{0x1D68A, idnaMapped, -120361, ""},
+	{0x1D6A4, idnaMapped, -120179, ""},
+	{0x1D6A5, idnaMapped, -119918, ""},
+	{0x1D6A6, idnaDisallowed, 0, ""},
+	{0x1D6A8, idnaMapped, -119543, ""},
+	{0x1D6B9, idnaMapped, -119553, ""},
+	{0x1D6BA, idnaMapped, -119543, ""},
+	{0x1D6C1, idnaMapped, -111802, ""},
+	{0x1D6C2, idnaMapped, -119569, ""},
+	{0x1D6D3, idnaMapped, -119568, ""},
+	{0x1D6D4, idnaMapped, -119569, ""},
+	{0x1D6DB, idnaMapped, -111833, ""},
+	{0x1D6DC, idnaMapped, -119591, ""},
+	{0x1D6DD, idnaMapped, -119589, ""},
+	{0x1D6DE, idnaMapped, -119588, ""},
+	{0x1D6DF, idnaMapped, -119577, ""},
+	{0x1D6E0, idnaMapped, -119583, ""},
+	{0x1D6E1, idnaMapped, -119585, ""},
+	{0x1D6E2, idnaMapped, -119601, ""},
+	{0x1D6F3, idnaMapped, -119611, ""},
+	{0x1D6F4, idnaMapped, -119601, ""},
+	{0x1D6FB, idnaMapped, -111860, ""},
+	{0x1D6FC, idnaMapped, -119627, ""},
+	{0x1D70D, idnaMapped, -119626, ""},
+	{0x1D70E, idnaMapped, -119627, ""},
+	{0x1D715, idnaMapped, -111891, ""},
+	{0x1D716, idnaMapped, -119649, ""},
+	{0x1D717, idnaMapped, -119647, ""},
+	{0x1D718, idnaMapped, -119646, ""},
+	{0x1D719, idnaMapped, -119635, ""},
+	{0x1D71A, idnaMapped, -119641, ""},
+	{0x1D71B, idnaMapped, -119643, ""},
+	{0x1D71C, idnaMapped, -119659, ""},
+	{0x1D72D, idnaMapped, -119669, ""},
+	{0x1D72E, idnaMapped, -119659, ""},
+	{0x1D735, idnaMapped, -111918, ""},
+	{0x1D736, idnaMapped, -119685, ""},
+	{0x1D747, idnaMapped, -119684, ""},
+	{0x1D748, idnaMapped, -119685, ""},
+	{0x1D74F, idnaMapped, -111949, ""},
+	{0x1D750, idnaMapped, -119707, ""},
+	{0x1D751, idnaMapped, -119705, ""},
+	{0x1D752, idnaMapped, -119704, ""},
+	{0x1D753, idnaMapped, -119693, ""},
+	{0x1D754, idnaMapped, -119699, ""},
+	{0x1D755, idnaMapped, -119701, ""},
+	{0x1D756, idnaMapped, -119717, ""},
+	{0x1D767, idnaMapped, -119727, ""},
+	{0x1D768, idnaMapped, -119717, ""},
+	{0x1D76F, idnaMapped, -111976, ""},
+	{0x1D770, idnaMapped, -119743, ""},
+	{0x1D781, idnaMapped, -119742, ""},
+	{0x1D782, idnaMapped, -119743, ""},
+	{0x1D789, idnaMapped, -112007, ""},
+	{0x1D78A, idnaMapped, -119765, ""},
+	{0x1D78B, idnaMapped, -119763, ""},
+	{0x1D78C, idnaMapped, -119762, ""},
+	{0x1D78D, idnaMapped, -119751, ""},
+	{0x1D78E, idnaMapped, -119757, ""},
+	{0x1D78F, idnaMapped, -119759, ""},
+	{0x1D790, idnaMapped, -119775, ""},
+	{0x1D7A1, idnaMapped, -119785, ""},
+	{0x1D7A2, idnaMapped, -119775, ""},
+	{0x1D7A9, idnaMapped, -112034, ""},
+	{0x1D7AA, idnaMapped, -119801, ""},
+	{0x1D7BB, idnaMapped, -119800, ""},
+	{0x1D7BC, idnaMapped, -119801, ""},
+	{0x1D7C3, idnaMapped, -112065, ""},
+	{0x1D7C4, idnaMapped, -119823, ""},
+	{0x1D7C5, idnaMapped, -119821, ""},
+	{0x1D7C6, idnaMapped, -119820, ""},
+	{0x1D7C7, idnaMapped, -119809, ""},
+	{0x1D7C8, idnaMapped, -119815, ""},
+	{0x1D7C9, idnaMapped, -119817, ""},
+	{0x1D7CA, idnaMapped, -119789, ""},
+	{0x1D7CB, idnaMapped, -119790, ""},
+	{0x1D7CC, idnaDisallowed, 0, ""},
+	{0x1D7CE, idnaMapped, -120734, ""},
+	{0x1D7D8, idnaMapped, -120744, ""},
+	{0x1D7E2, idnaMapped, -120754, ""},
+	{0x1D7EC, idnaMapped, -120764, ""},
+	{0x1D7F6, idnaMapped, -120774, ""},
+	{0x1D800, idnaValidNV8, 0, ""},
+	{0x1DA00, idnaValid, 0, ""},
+	{0x1DA37, idnaValidNV8, 0, ""},
+	{0x1DA3B, idnaValid, 0, ""},
+	{0x1DA6D, idnaValidNV8, 0, ""},
+	{0x1DA75, idnaValid, 0, ""},
+	{0x1DA76, idnaValidNV8, 0, ""},
+	{0x1DA84, idnaValid, 0, ""},
+	{0x1DA85, idnaValidNV8, 0, ""},
+	{0x1DA8C, idnaDisallowed, 0, ""},
+	{0x1DA9B, idnaValid, 0, ""},
+	{0x1DAA0, idnaDisallowed, 0, ""},
+	{0x1DAA1, idnaValid, 0, ""},
+	{0x1DAB0, idnaDisallowed, 0, ""},
+	{0x1DF00, idnaValid, 0, ""},
+	{0x1DF1F, idnaDisallowed, 0, ""},
+	{0x1DF25, idnaValid, 0, ""},
+	{0x1DF2B, idnaDisallowed, 0, ""},
+	{0x1E000, idnaValid, 0, ""},
+	{0x1E007, idnaDisallowed, 0, ""},
+	{0x1E008, idnaValid, 0, ""},
+	{0x1E019, idnaDisallowed, 0, ""},
+	{0x1E01B, idnaValid, 0, ""},
+	{0x1E022, idnaDisallowed, 0, ""},
+	{0x1E023, idnaValid, 0, ""},
+	{0x1E025, idnaDisallowed, 0, ""},
+	{0x1E026, idnaValid, 0, ""},
+	{0x1E02B, idnaDisallowed, 0, ""},
+	{0x1E030, idnaMapped, -121856, ""},
+	{0x1E039, idnaMapped, -121855, ""},
+	{0x1E03C, idnaMapped, -121854, ""},
+	{0x1E047, idnaMapped, -121852, ""},
+	{0x1E048, idnaMapped, -121851, ""},
+	{0x1E04A, idnaMapped, -80321, ""},
+	{0x1E04B, idnaMapped, -121714, ""},
+	{0x1E04C, idnaMapped, -121846, ""},
+	{0x1E04D, idnaMapped, -121845, ""},
+	{0x1E04E, idnaMapped, -121701, ""},
+	{0x1E04F, idnaMapped, -121760, ""},
+	{0x1E050, idnaMapped, -121729, ""},
+	{0x1E051, idnaMapped, -121889, ""},
+	{0x1E05A, idnaMapped, -121888, ""},
+	{0x1E05C, idnaMapped, -121886, ""},
+	{0x1E05E, idnaMapped, -121885, ""},
+	{0x1E05F, idnaMapped, -121884, ""},
+	{0x1E065, idnaMapped, -121883, ""},
+	{0x1E067, idnaMapped, -121814, ""},
+	{0x1E068, idnaMapped, -121874, ""},
+	{0x1E069, idnaMapped, -121876, ""},
+	{0x1E06A, idnaMapped, -121867, ""},
+	{0x1E06B, idnaMapped, -121792, ""},
+	{0x1E06C, idnaMapped, -80411, ""},
+	{0x1E06D, idnaMapped, -121788, ""},
+	{0x1E06E, idnaDisallowed, 0, ""},
+	{0x1E08F, idnaValid, 0, ""},
+	{0x1E090, idnaDisallowed, 0, ""},
+	{0x1E100, idnaValid, 0, ""},
+	{0x1E12D, idnaDisallowed, 0, ""},
+	{0x1E130, idnaValid, 0, ""},
+	{0x1E13E, idnaDisallowed, 0, ""},
+	{0x1E140, idnaValid, 0, ""},
+	{0x1E14A, idnaDisallowed, 0, ""},
+	{0x1E14E, idnaValid, 0, ""},
+	{0x1E14F, idnaValidNV8, 0, ""},
+	{0x1E150, idnaDisallowed, 0, ""},
+	{0x1E290, idnaValid, 0, ""},
+	{0x1E2AF, idnaDisallowed, 0, ""},
+	{0x1E2C0, idnaValid, 0, ""},
+	{0x1E2FA, idnaDisallowed, 0, ""},
+	{0x1E2FF, idnaValidNV8, 0, ""},
+	{0x1E300, idnaDisallowed, 0, ""},
+	{0x1E4D0, idnaValid, 0, ""},
+	{0x1E4FA, idnaDisallowed, 0, ""},
+	{0x1E7E0, idnaValid, 0, ""},
+	{0x1E7E7, idnaDisallowed, 0, ""},
+	{0x1E7E8, idnaValid, 0, ""},
+	{0x1E7EC, idnaDisallowed, 0, ""},
+	{0x1E7ED, idnaValid, 0, ""},
+	{0x1E7EF, idnaDisallowed, 0, ""},
+	{0x1E7F0, idnaValid, 0, ""},
+	{0x1E7FF, idnaDisallowed, 0, ""},
+	{0x1E800, idnaValid, 0, ""},
+	{0x1E8C5, idnaDisallowed, 0, ""},
+	{0x1E8C7, idnaValidNV8, 0, ""},
+	{0x1E8D0, idnaValid, 0, ""},
+	{0x1E8D7, idnaDisallowed, 0, ""},
+	{0x1E900, idnaMapped, 34, ""},
+	{0x1E922, idnaValid, 0, ""},
+	{0x1E94C, idnaDisallowed, 0, ""},
+	{0x1E950, idnaValid, 0, ""},
+	{0x1E95A, idnaDisallowed, 0, ""},
+	{0x1E95E, idnaValidNV8, 0, ""},
+	{0x1E960, idnaDisallowed, 0, ""},
+	{0x1EC71, idnaValidNV8, 0, ""},
+	{0x1ECB5, idnaDisallowed, 0, ""},
+	{0x1ED01, idnaValidNV8, 0, ""},
+	{0x1ED3E, idnaDisallowed, 0, ""},
+	{0x1EE00, idnaMapped, -124889, ""},
+	{0x1EE02, idnaMapped, -124886, ""},
+	{0x1EE03, idnaMapped, -124884, ""},
+	{0x1EE04, idnaDisallowed, 0, ""},
+	{0x1EE05, idnaMapped, -124861, ""},
+	{0x1EE06, idnaMapped, -124884, ""},
+	{0x1EE07, idnaMapped, -124890, ""},
+	{0x1EE08, idnaMapped, -124881, ""},
+	{0x1EE09, idnaMapped, -124863, ""},
+	{0x1EE0A, idnaMapped, -124871, ""},
+	{0x1EE0E, idnaMapped, -124891, ""},
+	{0x1EE0F, idnaMapped, -124886, ""},
+	{0x1EE10, idnaMapped, -124879, ""},
+	{0x1EE11, idnaMapped, -124892, ""},
+	{0x1EE12, idnaMapped, -124880, ""},
+	{0x1EE13, idnaMapped, -124898, ""},
+	{0x1EE14, idnaMapped, -124896, ""},
+	{0x1EE15, idnaMapped, -124907, ""},
+	{0x1EE17, idnaMapped, -124905, ""},
+	{0x1EE18, idnaMapped, -124904, ""},
+	{0x1EE19, idnaMapped, -124899, ""},
+	{0x1EE1A, idnaMapped, -124898, ""},
+	{0x1EE1B, idnaMapped, -124897, ""},
+	{0x1EE1C, idnaMapped, -124846, ""},
+	{0x1EE1D, idnaMapped, -124771, ""},
+	{0x1EE1E, idnaMapped, -124797, ""},
+	{0x1EE1F, idnaMapped, -124848, ""},
+	{0x1EE20, idnaDisallowed, 0, ""},
+	{0x1EE21, idnaMapped, -124921, ""},
+	{0x1EE22, idnaMapped, -124918, ""},
+	{0x1EE23, idnaDisallowed, 0, ""},
+	{0x1EE24, idnaMapped, -124893, ""},
+	{0x1EE25, idnaDisallowed, 0, ""},
+	{0x1EE27, idnaMapped, -124922, ""},
+	{0x1EE28, idnaDisallowed, 0, ""},
+	{0x1EE29, idnaMapped, -124895, ""},
+	{0x1EE2A, idnaMapped, -124903, ""},
+	{0x1EE2E, idnaMapped, -124923, ""},
+	{0x1EE2F, idnaMapped, -124918, ""},
+	{0x1EE30, idnaMapped, -124911, ""},
+	{0x1EE31, idnaMapped, -124924, ""},
+	{0x1EE32, idnaMapped, -124912, ""},
+	{0x1EE33, idnaDisallowed, 0, ""},
+	{0x1EE34, idnaMapped, -124928, ""},
+	{0x1EE35, idnaMapped, -124939, ""},
+	{0x1EE37, idnaMapped, -124937, ""},
+	{0x1EE38, idnaDisallowed, 0, ""},
+	{0x1EE39, idnaMapped, -124931, ""},
+	{0x1EE3A, idnaDisallowed, 0, ""},
+	{0x1EE3B, idnaMapped, -124929, ""},
+	{0x1EE3C, idnaDisallowed, 0, ""},
+	{0x1EE42, idnaMapped, -124950, ""},
+	{0x1EE43, idnaDisallowed, 0, ""},
+	{0x1EE47, idnaMapped, -124954, ""},
+	{0x1EE48, idnaDisallowed, 0, ""},
+	{0x1EE49, idnaMapped, -124927, ""},
+	{0x1EE4A, idnaDisallowed, 0, ""},
+	{0x1EE4B, idnaMapped, -124935, ""},
+	{0x1EE4C, idnaDisallowed, 0, ""},
+	{0x1EE4D, idnaMapped, -124935, ""},
+	{0x1EE4E, idnaMapped, -124955, ""},
+	{0x1EE4F, idnaMapped, -124950, ""},
+	{0x1EE50, idnaDisallowed, 0, ""},
+	{0x1EE51, idnaMapped, -124956, ""},
+	{0x1EE52, idnaMapped, -124944, ""},
+	{0x1EE53, idnaDisallowed, 0, ""},
+	{0x1EE54, idnaMapped, -124960, ""},
+	{0x1EE55, idnaDisallowed, 0, ""},
+	{0x1EE57, idnaMapped, -124969, ""},
+	{0x1EE58, idnaDisallowed, 0, ""},
+	{0x1EE59, idnaMapped, -124963, ""},
+	{0x1EE5A, idnaDisallowed, 0, ""},
+	{0x1EE5B, idnaMapped, -124961, ""},
+	{0x1EE5C, idnaDisallowed, 0, ""},
+	{0x1EE5D, idnaMapped, -124835, ""},
+	{0x1EE5E, idnaDisallowed, 0, ""},
+	{0x1EE5F, idnaMapped, -124912, ""},
+	{0x1EE60, idnaDisallowed, 0, ""},
+	{0x1EE61, idnaMapped, -124985, ""},
+	{0x1EE62, idnaMapped, -124982, ""},
+	{0x1EE63, idnaDisallowed, 0, ""},
+	{0x1EE64, idnaMapped, -124957, ""},
+	{0x1EE65, idnaDisallowed, 0, ""},
+	{0x1EE67, idnaMapped, -124986, ""},
+	{0x1EE68, idnaMapped, -124977, ""},
+	{0x1EE69, idnaMapped, -124959, ""},
+	{0x1EE6A, idnaMapped, -124967, ""},
+	{0x1EE6B, idnaDisallowed, 0, ""},
+	{0x1EE6C, idnaMapped, -124967, ""},
+	{0x1EE6E, idnaMapped, -124987, ""},
+	{0x1EE6F, idnaMapped, -124982, ""},
+	{0x1EE70, idnaMapped, -124975, ""},
+	{0x1EE71, idnaMapped, -124988, ""},
+	{0x1EE72, idnaMapped, -124976, ""},
+	{0x1EE73, idnaDisallowed, 0, ""},
+	{0x1EE74, idnaMapped, -124992, ""},
+	{0x1EE75, idnaMapped, -125003, ""},
+	{0x1EE77, idnaMapped, -125001, ""},
+	{0x1EE78, idnaDisallowed, 0, ""},
+	{0x1EE79, idnaMapped, -124995, ""},
+	{0x1EE7A, idnaMapped, -124994, ""},
+	{0x1EE7B, idnaMapped, -124993, ""},
+	{0x1EE7C, idnaMapped, -124942, ""},
+	{0x1EE7D, idnaDisallowed, 0, ""},
+	{0x1EE7E, idnaMapped, -124893, ""},
+	{0x1EE7F, idnaDisallowed, 0, ""},
+	{0x1EE80, idnaMapped, -125017, ""},
+	{0x1EE82, idnaMapped, -125014, ""},
+	{0x1EE83, idnaMapped, -125012, ""},
+	{0x1EE84, idnaMapped, -124989, ""},
+	{0x1EE86, idnaMapped, -125012, ""},
+	{0x1EE87, idnaMapped, -125018, ""},
+	{0x1EE88, idnaMapped, -125009, ""},
+	{0x1EE89, idnaMapped, -124991, ""},
+	{0x1EE8A, idnaDisallowed, 0, ""},
+	{0x1EE8B, idnaMapped, -124999, ""},
+	{0x1EE8E, idnaMapped, -125019, ""},
+	{0x1EE8F, idnaMapped, -125014, ""},
+	{0x1EE90, idnaMapped, -125007, ""},
+	{0x1EE91, idnaMapped, -125020, ""},
+	{0x1EE92, idnaMapped, -125008, ""},
+	{0x1EE93, idnaMapped, -125026, ""},
+	{0x1EE94, idnaMapped, -125024, ""},
+	{0x1EE95, idnaMapped, -125035, ""},
+	{0x1EE97, idnaMapped, -125033, ""},
+	{0x1EE98, idnaMapped, -125032, ""},
+	{0x1EE99, idnaMapped, -125027, ""},
+	{0x1EE9A, idnaMapped, -125026, ""},
+	{0x1EE9B, idnaMapped, -125025, ""},
+	{0x1EE9C, idnaDisallowed, 0, ""},
+	{0x1EEA1, idnaMapped, -125049, ""},
+	{0x1EEA2, idnaMapped, -125046, ""},
+	{0x1EEA3, idnaMapped, -125044, ""},
+	{0x1EEA4, idnaDisallowed, 0, ""},
+	{0x1EEA5, idnaMapped, -125021, ""},
+	{0x1EEA6, idnaMapped, -125044, ""},
+	{0x1EEA7, idnaMapped, -125050, ""},
+	{0x1EEA8, idnaMapped, -125041, ""},
+	{0x1EEA9, idnaMapped, -125023, ""},
+	{0x1EEAA, idnaDisallowed, 0, ""},
+	{0x1EEAB, idnaMapped, -125031, ""},
+	{0x1EEAE, idnaMapped, -125051, ""},
+	{0x1EEAF, idnaMapped, -125046, ""},
+	{0x1EEB0, idnaMapped, -125039, ""},
+	{0x1EEB1, idnaMapped, -125052, ""},
+	{0x1EEB2, idnaMapped, -125040, ""},
+	{0x1EEB3, idnaMapped, -125058, ""},
+	{0x1EEB4, idnaMapped, -125056, ""},
+	{0x1EEB5, idnaMapped, -125067, ""},
+	{0x1EEB7, idnaMapped, -125065, ""},
+	{0x1EEB8, idnaMapped, -125064, ""},
+	{0x1EEB9, idnaMapped, -125059, ""},
+	{0x1EEBA, idnaMapped, -125058, ""},
+	{0x1EEBB, idnaMapped, -125057, ""},
+	{0x1EEBC, idnaDisallowed, 0, ""},
+	{0x1EEF0, idnaValidNV8, 0, ""},
+	{0x1EEF2, idnaDisallowed, 0, ""},
+	{0x1F000, idnaValidNV8, 0, ""},
+	{0x1F02C, idnaDisallowed, 0, ""},
+	{0x1F030, idnaValidNV8, 0, ""},
+	{0x1F094, idnaDisallowed, 0, ""},
+	{0x1F0A0, idnaValidNV8, 0, ""},
+	{0x1F0AF, idnaDisallowed, 0, ""},
+	{0x1F0B1, idnaValidNV8, 0, ""},
+	{0x1F0C0, idnaDisallowed, 0, ""},
+	{0x1F0C1, idnaValidNV8, 0, ""},
+	{0x1F0D0, idnaDisallowed, 0, ""},
+	{0x1F0D1, idnaValidNV8, 0, ""},
+	{0x1F0F6, idnaDisallowed, 0, ""},
+	{0x1F10B, idnaValidNV8, 0, ""},
+	{0x1F110, idnaDisallowed, 0, ""},
+	{0x1F12A, idnaMapped, 0, "\u3014s\u3015"},
+	{0x1F12B, idnaMapped, -127176, ""},
+	{0x1F12C, idnaMapped, -127162, ""},
+	{0x1F12D, idnaMapped, 0, "cd"},
+	{0x1F12E, idnaMapped, 0, "wz"},
+	{0x1F12F, idnaValidNV8, 0, ""},
+	{0x1F130, idnaMapped, -127183, ""},
+	{0x1F14A, idnaMapped, 0, "hv"},
+	{0x1F14B, idnaMapped, 0, "mv"},
+	{0x1F14C, idnaMapped, 0, "sd"},
+	{0x1F14D, idnaMapped, 0, "ss"},
+	{0x1F14E, idnaMapped, 0, "ppv"},
+	{0x1F14F, idnaMapped, 0, "wc"},
+	{0x1F150, idnaValidNV8, 0, ""},
+	{0x1F16A, idnaMapped, 0, "mc"},
+	{0x1F16B, idnaMapped, 0, "md"},
+	{0x1F16C, idnaMapped, 0, "mr"},
+	{0x1F16D, idnaValidNV8, 0, ""},
+	{0x1F190, idnaMapped, 0, "dj"},
+	{0x1F191, idnaValidNV8, 0, ""},
+	{0x1F1AE, idnaDisallowed, 0, ""},
+	{0x1F1E6, idnaValidNV8, 0, ""},
+	{0x1F200, idnaMapped, 0, "\u307b\u304b"},
+	{0x1F201, idnaMapped, 0, "\u30b3\u30b3"},
+	{0x1F202, idnaMapped, -115021, ""},
+	{0x1F203, idnaDisallowed, 0, ""},
+	{0x1F210, idnaMapped, -102341, ""},
+	{0x1F211, idnaMapped, -104122, ""},
+	{0x1F212, idnaMapped, -106054, ""},
+	{0x1F213, idnaMapped, -115020, ""},
+	{0x1F214, idnaMapped, -107400, ""},
+	{0x1F215, idnaMapped, -104699, ""},
+	{0x1F216, idnaMapped, -92211, ""},
+	{0x1F217, idnaMapped, -104686, ""},
+	{0x1F218, idnaMapped, -107380, ""},
+	{0x1F219, idnaMapped, -101369, ""},
+	{0x1F21A, idnaMapped, -98553, ""},
+	{0x1F21B, idnaMapped, -101506, ""},
+	{0x1F21C, idnaMapped, -106447, ""},
+	{0x1F21D, idnaMapped, -103057, ""},
+	{0x1F21E, idnaMapped, -106641, ""},
+	{0x1F21F, idnaMapped, -101487, ""},
+	{0x1F220, idnaMapped, -106499, ""},
+	{0x1F221, idnaMapped, -95455, ""},
+	{0x1F222, idnaMapped, -97539, ""},
+	{0x1F223, idnaMapped, -91514, ""},
+	{0x1F224, idnaMapped, -104756, ""},
+	{0x1F225, idnaMapped, -105964, ""},
+	{0x1F226, idnaMapped, -99090, ""},
+	{0x1F227, idnaMapped, -102290, ""},
+	{0x1F228, idnaMapped, -102099, ""},
+	{0x1F229, idnaMapped, -107561, ""},
+	{0x1F22A, idnaMapped, -107553, ""},
+	{0x1F22B, idnaMapped, -90593, ""},
+	{0x1F22C, idnaMapped, -103494, ""},
+	{0x1F22D, idnaMapped, -107520, ""},
+	{0x1F22E, idnaMapped, -106043, ""},
+	{0x1F22F, idnaMapped, -102184, ""},
+	{0x1F230, idnaMapped, -91328, ""},
+	{0x1F231, idnaMapped, -102366, ""},
+	{0x1F232, idnaMapped, -96433, ""},
+	{0x1F233, idnaMapped, -96185, ""},
+	{0x1F234, idnaMapped, -106028, ""},
+	{0x1F235, idnaMapped, -99253, ""},
+	{0x1F236, idnaMapped, -101165, ""},
+	{0x1F237, idnaMapped, -101167, ""},
+	{0x1F238, idnaMapped, -97541, ""},
+	{0x1F239, idnaMapped, -106439, ""},
+	{0x1F23A, idnaMapped, -105604, ""},
+	{0x1F23B, idnaMapped, -90350, ""},
+	{0x1F23C, idnaDisallowed, 0, ""},
+	{0x1F240, idnaMapped, 0, "\u3014\u672c\u3015"},
+	{0x1F241, idnaMapped, 0, "\u3014\u4e09\u3015"},
+	{0x1F242, idnaMapped, 0, "\u3014\u4e8c\u3015"},
+	{0x1F243, idnaMapped, 0, "\u3014\u5b89\u3015"},
+	{0x1F244, idnaMapped, 0, "\u3014\u70b9\u3015"},
+	{0x1F245, idnaMapped, 0, "\u3014\u6253\u3015"},
+	{0x1F246, idnaMapped, 0, "\u3014\u76d7\u3015"},
+	{0x1F247, idnaMapped, 0, "\u3014\u52dd\u3015"},
+	{0x1F248, idnaMapped, 0, "\u3014\u6557\u3015"},
+	{0x1F249, idnaDisallowed, 0, ""},
+	{0x1F250, idnaMapped, -103097, ""},
+	{0x1F251, idnaMapped, -106082, ""},
+	{0x1F252, idnaDisallowed, 0, ""},
+	{0x1F260, idnaValidNV8, 0, ""},
+	{0x1F266, idnaDisallowed, 0, ""},
+	{0x1F300, idnaValidNV8, 0, ""},
+	{0x1F6D8, idnaDisallowed, 0, ""},
+	{0x1F6DC, idnaValidNV8, 0, ""},
+	{0x1F6ED, idnaDisallowed, 0, ""},
+	{0x1F6F0, idnaValidNV8, 0, ""},
+	{0x1F6FD, idnaDisallowed, 0, ""},
+	{0x1F700, idnaValidNV8, 0, ""},
+	{0x1F777, idnaDisallowed, 0, ""},
+	{0x1F77B, idnaValidNV8, 0, ""},
+	{0x1F7DA, idnaDisallowed, 0, ""},
+	{0x1F7E0, idnaValidNV8, 0, ""},
+	{0x1F7EC, idnaDisallowed, 0, ""},
+	{0x1F7F0, idnaValidNV8, 0, ""},
+	{0x1F7F1, idnaDisallowed, 0, ""},
+	{0x1F800, idnaValidNV8, 0, ""},
+	{0x1F80C, idnaDisallowed, 0, ""},
+	{0x1F810, idnaValidNV8, 0, ""},
+	{0x1F848, idnaDisallowed, 0, ""},
+	{0x1F850, idnaValidNV8, 0, ""},
+	{0x1F85A, idnaDisallowed, 0, ""},
+	{0x1F860, idnaValidNV8, 0, ""},
+	{0x1F888, idnaDisallowed, 0, ""},
+	{0x1F890, idnaValidNV8, 0, ""},
+	{0x1F8AE, idnaDisallowed, 0, ""},
+	{0x1F8B0, idnaValidNV8, 0, ""},
+	{0x1F8B2, idnaDisallowed, 0, ""},
+	{0x1F900, idnaValidNV8, 0, ""},
+	{0x1FA54, idnaDisallowed, 0, ""},
+	{0x1FA60, idnaValidNV8, 0, ""},
+	{0x1FA6E, idnaDisallowed, 0, ""},
+	{0x1FA70, idnaValidNV8, 0, ""},
+	{0x1FA7D, idnaDisallowed, 0, ""},
+	{0x1FA80, idnaValidNV8, 0, ""},
+	{0x1FA89, idnaDisallowed, 0, ""},
+	{0x1FA90, idnaValidNV8, 0, ""},
+	{0x1FABE, idnaDisallowed, 0, ""},
+	{0x1FABF, idnaValidNV8, 0, ""},
+	{0x1FAC6, idnaDisallowed, 0, ""},
+	{0x1FACE, idnaValidNV8, 0, ""},
+	{0x1FADC, idnaDisallowed, 0, ""},
+	{0x1FAE0, idnaValidNV8, 0, ""},
+	{0x1FAE9, idnaDisallowed, 0, ""},
+	{0x1FAF0, idnaValidNV8, 0, ""},
+	{0x1FAF9, idnaDisallowed, 0, ""},
+	{0x1FB00, idnaValidNV8, 0, ""},
+	{0x1FB93, idnaDisallowed, 0, ""},
+	{0x1FB94, idnaValidNV8, 0, ""},
+	{0x1FBCB, idnaDisallowed, 0, ""},
+	{0x1FBF0, idnaMapped, -129984, ""},
+	{0x1FBFA, idnaDisallowed, 0, ""},
+	{0x20000, idnaValid, 0, ""},
+	{0x2A6E0, idnaDisallowed, 0, ""},
+	{0x2A700, idnaValid, 0, ""},
+	{0x2B73A, idnaDisallowed, 0, ""},
+	{0x2B740, idnaValid, 0, ""},
+	{0x2B81E, idnaDisallowed, 0, ""},
+	{0x2B820, idnaValid, 0, ""},
+	{0x2CEA2, idnaDisallowed, 0, ""},
+	{0x2CEB0, idnaValid, 0, ""},
+	{0x2EBE1, idnaDisallowed, 0, ""},
+	{0x2EBF0, idnaValid, 0, ""},
+	{0x2EE5E, idnaDisallowed, 0, ""},
+	{0x2F800, idnaMapped, -174531, ""},
+	{0x2F801, idnaMapped, -174537, ""},
+	{0x2F802, idnaMapped, -174529, ""},
+	{0x2F803, idnaMapped, -63201, ""},
+	{0x2F804, idnaMapped, -174244, ""},
+	{0x2F805, idnaMapped, -174167, ""},
+	{0x2F806, idnaMapped, -174155, ""},
+	{0x2F807, idnaMapped, -174085, ""},
+	{0x2F808, idnaMapped, -173966, ""},
+	{0x2F809, idnaMapped, -173936, ""},
+	{0x2F80A, idnaMapped, -173859, ""},
+	{0x2F80B, idnaMapped, -173884, ""},
+	{0x2F80C, idnaMapped, -181102, ""},
+	{0x2F80D, idnaMapped, -61907, ""},
+	{0x2F80E, idnaMapped, -173761, ""},
+	{0x2F80F, idnaMapped, -173755, ""},
+	{0x2F810, idnaMapped, -173740, ""},
+	{0x2F811, idnaMapped, -173722, ""},
+	{0x2F812, idnaMapped, -62198, ""},
+	{0x2F813, idnaMapped, -181082, ""},
+	{0x2F814, idnaMapped, -173741, ""},
+	{0x2F815, idnaMapped, -173704, ""},
+	{0x2F816, idnaMapped, -62155, ""},
+	{0x2F817, idnaMapped, -173696, ""},
+	{0x2F818, idnaMapped, -173684, ""},
+	{0x2F819, idnaMapped, -174413, ""},
+	{0x2F81A, idnaMapped, -173678, ""},
+	{0x2F81B, idnaMapped, -173670, ""},
+	{0x2F81C, idnaMapped, -26173, ""},
+	{0x2F81D, idnaMapped, -173608, ""},
+	{0x2F81E, idnaMapped, -173595, ""},
+	{0x2F81F, idnaMapped, -181056, ""},
+	{0x2F820, idnaMapped, -173541, ""},
+	{0x2F821, idnaMapped, -173531, ""},
+	{0x2F822, idnaMapped, -173488, ""},
+	{0x2F823, idnaMapped, -173484, ""},
+	{0x2F824, idnaMapped, -181007, ""},
+	{0x2F825, idnaMapped, -173406, ""},
+	{0x2F826, idnaMapped, -173405, ""},
+	{0x2F827, idnaMapped, -173379, ""},
+	{0x2F828, idnaMapped, -173358, ""},
+	{0x2F829, idnaMapped, -173348, ""},
+	{0x2F82B, idnaMapped, -173332, ""},
+	{0x2F82C, idnaMapped, -173283, ""},
+	{0x2F82D, idnaMapped, -173276, ""},
+	{0x2F82E, idnaMapped, -173268, ""},
+	{0x2F82F, idnaMapped, -173244, ""},
+	{0x2F830, idnaMapped, -173235, ""},
+	{0x2F831, idnaMapped, -173234, ""},
+	{0x2F832, idnaMapped, -173235, ""},
+	{0x2F833, idnaMapped, -173236, ""},
+	{0x2F834, idnaMapped, -60936, ""},
+	{0x2F835, idnaMapped, -165829, ""},
+	{0x2F836, idnaMapped, -173164, ""},
+	{0x2F837, idnaMapped, -173144, ""},
+	{0x2F838, idnaMapped, -60629, ""},
+	{0x2F839, idnaMapped, -173134, ""},
+	{0x2F83A, idnaMapped, -173129, ""},
+	{0x2F83B, idnaMapped, -173109, ""},
+	{0x2F83C, idnaMapped, -172958, ""},
+	{0x2F83D, idnaMapped, -173061, ""},
+	{0x2F83E, idnaMapped, -173046, ""},
+	{0x2F83F, idnaMapped, -173015, ""},
+	{0x2F840, idnaMapped, -172958, ""},
+	{0x2F841, idnaMapped, -172875, ""},
+	{0x2F842, idnaMapped, -172850, ""},
+	{0x2F843, idnaMapped, -172784, ""},
+	{0x2F844, idnaMapped, -172769, ""},
+	{0x2F845, idnaMapped, -172737, ""},
+	{0x2F846, idnaMapped, -172738, ""},
+	{0x2F847, idnaMapped, -172718, ""},
+	{0x2F848, idnaMapped, -172701, ""},
+	{0x2F849, idnaMapped, -172694, ""},
+	{0x2F84A, idnaMapped, -172680, ""},
+	{0x2F84B, idnaMapped, -172341, ""},
+	{0x2F84C, idnaMapped, -172614, ""},
+	{0x2F84D, idnaMapped, -172342, ""},
+	{0x2F84E, idnaMapped, -172541, ""},
+	{0x2F84F, idnaMapped, -172507, ""},
+	{0x2F850, idnaMapped, -173641, ""},
+	{0x2F851, idnaMapped, -171875, ""},
+	{0x2F852, idnaMapped, -172164, ""},
+	{0x2F853, idnaMapped, -172127, ""},
+	{0x2F854, idnaMapped, -172103, ""},
+	{0x2F855, idnaMapped, -172234, ""},
+	{0x2F856, idnaMapped, -172068, ""},
+	{0x2F857, idnaMapped, -172070, ""},
+	{0x2F858, idnaMapped, -171948, ""},
+	{0x2F859, idnaMapped, -58229, ""},
+	{0x2F85A, idnaMapped, -171880, ""},
+	{0x2F85B, idnaMapped, -171876, ""},
+	{0x2F85C, idnaMapped, -171862, ""},
+	{0x2F85D, idnaMapped, -171843, ""},
+	{0x2F85E, idnaMapped, -171836, ""},
+	{0x2F85F, idnaMapped, -171773, ""},
+	{0x2F860, idnaMapped, -57784, ""},
+	{0x2F861, idnaMapped, -57719, ""},
+	{0x2F862, idnaMapped, -171638, ""},
+	{0x2F863, idnaMapped, -171592, ""},
+	{0x2F864, idnaMapped, -171581, ""},
+	{0x2F865, idnaMapped, -171661, ""},
+	{0x2F866, idnaMapped, -171520, ""},
+	{0x2F867, idnaMapped, -180601, ""},
+	{0x2F868, idnaDisallowed, 0, ""},
+	{0x2F869, idnaMapped, -171361, ""},
+	{0x2F86A, idnaMapped, -171308, ""},
+	{0x2F86B, idnaMapped, -171309, ""},
+	{0x2F86C, idnaMapped, -56996, ""},
+	{0x2F86D, idnaMapped, -171178, ""},
+	{0x2F86E, idnaMapped, -171158, ""},
+	{0x2F86F, idnaMapped, -171144, ""},
+	{0x2F870, idnaMapped, -171133, ""},
+	{0x2F871, idnaMapped, -56665, ""},
+	{0x2F872, idnaMapped, -171123, ""},
+	{0x2F873, idnaMapped, -171117, ""},
+	{0x2F874, idnaDisallowed, 0, ""},
+	{0x2F875, idnaMapped, -171091, ""},
+	{0x2F876, idnaMapped, -180469, ""},
+	{0x2F877, idnaMapped, -171031, ""},
+	{0x2F878, idnaMapped, -171018, ""},
+	{0x2F879, idnaMapped, -170937, ""},
+	{0x2F87A, idnaMapped, -170989, ""},
+	{0x2F87B, idnaMapped, -55959, ""},
+	{0x2F87C, idnaMapped, -170809, ""},
+	{0x2F87D, idnaMapped, -55959, ""},
+	{0x2F87E, idnaMapped, -170768, ""},
+	{0x2F87F, idnaMapped, -170772, ""},
+	{0x2F880, idnaMapped, -170756, ""},
+	{0x2F881, idnaMapped, -170656, ""},
+	{0x2F883, idnaMapped, -180308, ""},
+	{0x2F884, idnaMapped, -170631, ""},
+	{0x2F885, idnaMapped, -170589, ""},
+	{0x2F886, idnaMapped, -170569, ""},
+	{0x2F887, idnaMapped, -170526, ""},
+	{0x2F888, idnaMapped, -180262, ""},
+	{0x2F889, idnaMapped, -55046, ""},
+	{0x2F88A, idnaMapped, -180238, ""},
+	{0x2F88B, idnaMapped, -170459, ""},
+	{0x2F88C, idnaMapped, -170457, ""},
+	{0x2F88D, idnaMapped, -170455, ""},
+	{0x2F88E, idnaMapped, -170436, ""},
+	{0x2F88F, idnaMapped, -21757, ""},
+	{0x2F890, idnaMapped, -170386, ""},
+	{0x2F891, idnaMapped, -54624, ""},
+	{0x2F892, idnaMapped, -54625, ""},
+	{0x2F893, idnaMapped, -161426, ""},
+	{0x2F894, idnaMapped, -170354, ""},
+	{0x2F895, idnaMapped, -170355, ""},
+	{0x2F896, idnaMapped, -180175, ""},
+	{0x2F897, idnaMapped, -50655, ""},
+	{0x2F898, idnaMapped, -38590, ""},
+	{0x2F899, idnaMapped, -170295, ""},
+	{0x2F89A, idnaMapped, -170287, ""},
+	{0x2F89B, idnaMapped, -180152, ""},
+	{0x2F89C, idnaMapped, -170242, ""},
+	{0x2F89D, idnaMapped, -170192, ""},
+	{0x2F89E, idnaMapped, -170183, ""},
+	{0x2F89F, idnaMapped, -170150, ""},
+	{0x2F8A0, idnaMapped, -170015, ""},
+	{0x2F8A1, idnaMapped, -180071, ""},
+	{0x2F8A2, idnaMapped, -180102, ""},
+	{0x2F8A3, idnaMapped, -169999, ""},
+	{0x2F8A4, idnaMapped, -53712, ""},
+	{0x2F8A5, idnaMapped, -169950, ""},
+	{0x2F8A6, idnaMapped, -169822, ""},
+	{0x2F8A7, idnaMapped, -169819, ""},
+	{0x2F8A8, idnaMapped, -169818, ""},
+	{0x2F8A9, idnaMapped, -169821, ""},
+	{0x2F8AA, idnaMapped, -169776, ""},
+	{0x2F8AB, idnaMapped, -169757, ""},
+	{0x2F8AC, idnaMapped, -169722, ""},
+	{0x2F8AD, idnaMapped, -169737, ""},
+	{0x2F8AE, idnaMapped, -169727, ""},
+	{0x2F8AF, idnaMapped, -169681, ""},
+	{0x2F8B0, idnaMapped, -169662, ""},
+	{0x2F8B1, idnaMapped, -169659, ""},
+	{0x2F8B2, idnaMapped, -169634, ""},
+	{0x2F8B3, idnaMapped, -169624, ""},
+	{0x2F8B4, idnaMapped, -169559, ""},
+	{0x2F8B5, idnaMapped, -169476, ""},
+	{0x2F8B6, idnaMapped, -169442, ""},
+	{0x2F8B7, idnaMapped, -169319, ""},
+	{0x2F8B8, idnaMapped, -52652, ""},
+	{0x2F8B9, idnaMapped, -169340, ""},
+	{0x2F8BA, idnaMapped, -169406, ""},
+	{0x2F8BB, idnaMapped, -169299, ""},
+	{0x2F8BC, idnaMapped, -169273, ""},
+	{0x2F8BD, idnaMapped, -169177, ""},
+	{0x2F8BE, idnaMapped, -52429, ""},
+	{0x2F8BF, idnaMapped, -169117, ""},
+	{0x2F8C0, idnaMapped, -169211, ""},
+	{0x2F8C1, idnaMapped, -169240, ""},
+	{0x2F8C2, idnaMapped, -179860, ""},
+	{0x2F8C3, idnaMapped, -169050, ""},
+	{0x2F8C4, idnaMapped, -169030, ""},
+	{0x2F8C5, idnaMapped, -169000, ""},
+	{0x2F8C6, idnaMapped, -169039, ""},
+	{0x2F8C7, idnaMapped, -179803, ""},
+	{0x2F8C8, idnaMapped, -168825, ""},
+	{0x2F8C9, idnaMapped, -168797, ""},
+	{0x2F8CA, idnaMapped, -51392, ""},
+	{0x2F8CB, idnaMapped, -168680, ""},
+	{0x2F8CC, idnaMapped, -168404, ""},
+	{0x2F8CD, idnaMapped, -168580, ""},
+	{0x2F8CE, idnaMapped, -179637, ""},
+	{0x2F8CF, idnaMapped, -168510, ""},
+	{0x2F8D0, idnaMapped, -179656, ""},
+	{0x2F8D1, idnaMapped, -179693, ""},
+	{0x2F8D2, idnaMapped, -173888, ""},
+	{0x2F8D3, idnaMapped, -173886, ""},
+	{0x2F8D4, idnaMapped, -168404, ""},
+	{0x2F8D5, idnaMapped, -168505, ""},
+	{0x2F8D6, idnaMapped, -161833, ""},
+	{0x2F8D7, idnaMapped, -177406, ""},
+	{0x2F8D8, idnaMapped, -168385, ""},
+	{0x2F8D9, idnaMapped, -168382, ""},
+	{0x2F8DA, idnaMapped, -168377, ""},
+	{0x2F8DB, idnaMapped, -168317, ""},
+	{0x2F8DC, idnaMapped, -168329, ""},
+	{0x2F8DD, idnaMapped, -50458, ""},
+	{0x2F8DE, idnaMapped, -179605, ""},
+	{0x2F8DF, idnaMapped, -168165, ""},
+	{0x2F8E0, idnaMapped, -168283, ""},
+	{0x2F8E1, idnaMapped, -168079, ""},
+	{0x2F8E2, idnaMapped, -168029, ""},
+	{0x2F8E3, idnaMapped, -50294, ""},
+	{0x2F8E4, idnaMapped, -168022, ""},
+	{0x2F8E5, idnaMapped, -168134, ""},
+	{0x2F8E6, idnaMapped, -167890, ""},
+	{0x2F8E7, idnaMapped, -179530, ""},
+	{0x2F8E8, idnaMapped, -167846, ""},
+	{0x2F8E9, idnaMapped, -167750, ""},
+	{0x2F8EA, idnaMapped, -167680, ""},
+	{0x2F8EB, idnaMapped, -167491, ""},
+	{0x2F8EC, idnaMapped, -49737, ""},
+	{0x2F8ED, idnaMapped, -167442, ""},
+	{0x2F8EE, idnaMapped, -179414, ""},
+	{0x2F8EF, idnaMapped, -167374, ""},
+	{0x2F8F0, idnaMapped, -49225, ""},
+	{0x2F8F1, idnaMapped, -167325, ""},
+	{0x2F8F2, idnaMapped, -179364, ""},
+	{0x2F8F3, idnaMapped, -167297, ""},
+	{0x2F8F4, idnaMapped, -167253, ""},
+	{0x2F8F5, idnaMapped, -167227, ""},
+	{0x2F8F7, idnaMapped, -48746, ""},
+	{0x2F8F8, idnaMapped, -56301, ""},
+	{0x2F8F9, idnaMapped, -48639, ""},
+	{0x2F8FA, idnaMapped, -167084, ""},
+	{0x2F8FB, idnaMapped, -48191, ""},
+	{0x2F8FC, idnaMapped, -166973, ""},
+	{0x2F8FD, idnaMapped, -166960, ""},
+	{0x2F8FE, idnaMapped, -167063, ""},
+	{0x2F8FF, idnaMapped, -166889, ""},
+	{0x2F900, idnaMapped, -166850, ""},
+	{0x2F901, idnaMapped, -166794, ""},
+	{0x2F902, idnaMapped, -166849, ""},
+	{0x2F903, idnaMapped, -166810, ""},
+	{0x2F904, idnaMapped, -166796, ""},
+	{0x2F905, idnaMapped, -166784, ""},
+	{0x2F906, idnaMapped, -48104, ""},
+	{0x2F907, idnaMapped, -166867, ""},
+	{0x2F908, idnaMapped, -166617, ""},
+	{0x2F909, idnaMapped, -166555, ""},
+	{0x2F90A, idnaMapped, -179159, ""},
+	{0x2F90B, idnaMapped, -166464, ""},
+	{0x2F90C, idnaMapped, -166469, ""},
+	{0x2F90D, idnaMapped, -47676, ""},
+	{0x2F90E, idnaMapped, -166677, ""},
+	{0x2F90F, idnaMapped, -166305, ""},
+	{0x2F910, idnaMapped, -47538, ""},
+	{0x2F911, idnaMapped, -47491, ""},
+	{0x2F912, idnaMapped, -166220, ""},
+	{0x2F913, idnaMapped, -166106, ""},
+	{0x2F914, idnaMapped, -166134, ""},
+	{0x2F915, idnaMapped, -166138, ""},
+	{0x2F916, idnaMapped, -179072, ""},
+	{0x2F917, idnaMapped, -166093, ""},
+	{0x2F918, idnaMapped, -166043, ""},
+	{0x2F919, idnaMapped, -166050, ""},
+	{0x2F91A, idnaMapped, -165997, ""},
+	{0x2F91B, idnaMapped, -62454, ""},
+	{0x2F91C, idnaMapped, -165847, ""},
+	{0x2F91D, idnaMapped, -46778, ""},
+	{0x2F91E, idnaMapped, -165762, ""},
+	{0x2F91F, idnaDisallowed, 0, ""},
+	{0x2F920, idnaMapped, -165624, ""},
+	{0x2F921, idnaMapped, -165612, ""},
+	{0x2F922, idnaMapped, -165586, ""},
+	{0x2F923, idnaMapped, -45851, ""},
+	{0x2F924, idnaMapped, -165540, ""},
+	{0x2F925, idnaMapped, -165520, ""},
+	{0x2F926, idnaMapped, -45553, ""},
+	{0x2F927, idnaMapped, -45331, ""},
+	{0x2F928, idnaMapped, -165294, ""},
+	{0x2F929, idnaMapped, -165278, ""},
+	{0x2F92A, idnaMapped, -178814, ""},
+	{0x2F92B, idnaMapped, -165254, ""},
+	{0x2F92C, idnaMapped, -178804, ""},
+	{0x2F92D, idnaMapped, -178805, ""},
+	{0x2F92E, idnaMapped, -165095, ""},
+	{0x2F92F, idnaMapped, -165075, ""},
+	{0x2F930, idnaMapped, -165055, ""},
+	{0x2F931, idnaMapped, -165036, ""},
+	{0x2F932, idnaMapped, -164968, ""},
+	{0x2F933, idnaMapped, -178712, ""},
+	{0x2F934, idnaMapped, -164880, ""},
+	{0x2F935, idnaMapped, -44287, ""},
+	{0x2F936, idnaMapped, -164856, ""},
+	{0x2F937, idnaMapped, -44197, ""},
+	{0x2F938, idnaMapped, -164808, ""},
+	{0x2F939, idnaMapped, -55194, ""},
+	{0x2F93A, idnaMapped, -164650, ""},
+	{0x2F93B, idnaMapped, -43418, ""},
+	{0x2F93C, idnaMapped, -43396, ""},
+	{0x2F93D, idnaMapped, -43257, ""},
+	{0x2F93E, idnaMapped, -178498, ""},
+	{0x2F93F, idnaMapped, -178487, ""},
+	{0x2F940, idnaMapped, -164428, ""},
+	{0x2F941, idnaMapped, -43086, ""},
+	{0x2F942, idnaMapped, -43088, ""},
+	{0x2F943, idnaMapped, -43050, ""},
+	{0x2F944, idnaMapped, -43025, ""},
+	{0x2F945, idnaMapped, -164391, ""},
+	{0x2F947, idnaMapped, -164392, ""},
+	{0x2F948, idnaMapped, -164350, ""},
+	{0x2F949, idnaMapped, -178448, ""},
+	{0x2F94A, idnaMapped, -164287, ""},
+	{0x2F94B, idnaMapped, -178437, ""},
+	{0x2F94C, idnaMapped, -178358, ""},
+	{0x2F94D, idnaMapped, -42288, ""},
+	{0x2F94E, idnaMapped, -164096, ""},
+	{0x2F94F, idnaMapped, -164035, ""},
+	{0x2F950, idnaMapped, -163972, ""},
+	{0x2F951, idnaMapped, -178286, ""},
+	{0x2F952, idnaMapped, -41772, ""},
+	{0x2F953, idnaMapped, -163837, ""},
+	{0x2F954, idnaMapped, -41658, ""},
+	{0x2F955, idnaMapped, -41616, ""},
+	{0x2F956, idnaMapped, -163783, ""},
+	{0x2F957, idnaMapped, -163692, ""},
+	{0x2F958, idnaMapped, -178217, ""},
+	{0x2F959, idnaMapped, -163609, ""},
+	{0x2F95A, idnaMapped, -163600, ""},
+	{0x2F95B, idnaMapped, -163596, ""},
+	{0x2F95C, idnaMapped, -40928, ""},
+	{0x2F95D, idnaMapped, -40630, ""},
+	{0x2F95E, idnaMapped, -40631, ""},
+	{0x2F95F, idnaDisallowed, 0, ""},
+	{0x2F960, idnaMapped, -178014, ""},
+	{0x2F961, idnaMapped, -40374, ""},
+	{0x2F962, idnaMapped, -163228, ""},
+	{0x2F963, idnaMapped, -163226, ""},
+	{0x2F964, idnaMapped, -177981, ""},
+	{0x2F965, idnaMapped, -40165, ""},
+	{0x2F966, idnaMapped, -162964, ""},
+	{0x2F967, idnaMapped, -177863, ""},
+	{0x2F968, idnaMapped, -162944, ""},
+	{0x2F969, idnaMapped, -162950, ""},
+	{0x2F96A, idnaMapped, -162922, ""},
+	{0x2F96B, idnaMapped, -39397, ""},
+	{0x2F96C, idnaMapped, -162825, ""},
+	{0x2F96D, idnaMapped, -177772, ""},
+	{0x2F96E, idnaMapped, -162727, ""},
+	{0x2F96F, idnaMapped, -162669, ""},
+	{0x2F970, idnaMapped, -162603, ""},
+	{0x2F971, idnaMapped, -177725, ""},
+	{0x2F972, idnaMapped, -38730, ""},
+	{0x2F973, idnaMapped, -38700, ""},
+	{0x2F974, idnaMapped, -177691, ""},
+	{0x2F975, idnaMapped, -38556, ""},
+	{0x2F976, idnaMapped, -162300, ""},
+	{0x2F977, idnaMapped, -38457, ""},
+	{0x2F978, idnaMapped, -162275, ""},
+	{0x2F979, idnaMapped, -162175, ""},
+	{0x2F97A, idnaMapped, -162165, ""},
+	{0x2F97B, idnaMapped, -38049, ""},
+	{0x2F97C, idnaMapped, -37977, ""},
+	{0x2F97D, idnaMapped, -162077, ""},
+	{0x2F97E, idnaMapped, -37846, ""},
+	{0x2F97F, idnaMapped, -162063, ""},
+	{0x2F980, idnaMapped, -50721, ""},
+	{0x2F981, idnaMapped, -177580, ""},
+	{0x2F982, idnaMapped, -162000, ""},
+	{0x2F983, idnaMapped, -161920, ""},
+	{0x2F984, idnaMapped, -177529, ""},
+	{0x2F985, idnaMapped, -161863, ""},
+	{0x2F986, idnaMapped, -171729, ""},
+	{0x2F987, idnaMapped, -37344, ""},
+	{0x2F988, idnaMapped, -37331, ""},
+	{0x2F989, idnaMapped, -50678, ""},
+	{0x2F98A, idnaMapped, -50670, ""},
+	{0x2F98B, idnaMapped, -161674, ""},
+	{0x2F98C, idnaMapped, -161672, ""},
+	{0x2F98D, idnaMapped, -158191, ""},
+	{0x2F98E, idnaMapped, -177443, ""},
+	{0x2F98F, idnaMapped, -161534, ""},
+	{0x2F990, idnaMapped, -161541, ""},
+	{0x2F991, idnaMapped, -161524, ""},
+	{0x2F992, idnaMapped, -173791, ""},
+	{0x2F993, idnaMapped, -161506, ""},
+	{0x2F994, idnaMapped, -161505, ""},
+	{0x2F995, idnaMapped, -161496, ""},
+	{0x2F996, idnaMapped, -161456, ""},
+	{0x2F997, idnaMapped, -36443, ""},
+	{0x2F998, idnaMapped, -161459, ""},
+	{0x2F999, idnaMapped, -161404, ""},
+	{0x2F99A, idnaMapped, -161335, ""},
+	{0x2F99B, idnaMapped, -161262, ""},
+	{0x2F99C, idnaMapped, -161401, ""},
+	{0x2F99D, idnaMapped, -161248, ""},
+	{0x2F99E, idnaMapped, -161207, ""},
+	{0x2F99F, idnaMapped, -161096, ""},
+	{0x2F9A0, idnaMapped, -161357, ""},
+	{0x2F9A1, idnaMapped, -161239, ""},
+	{0x2F9A2, idnaMapped, -161238, ""},
+	{0x2F9A3, idnaMapped, -161223, ""},
+	{0x2F9A4, idnaMapped, -36206, ""},
+	{0x2F9A5, idnaMapped, -35898, ""},
+	{0x2F9A6, idnaMapped, -36049, ""},
+	{0x2F9A7, idnaMapped, -177276, ""},
+	{0x2F9A8, idnaMapped, -160951, ""},
+	{0x2F9A9, idnaMapped, -160950, ""},
+	{0x2F9AA, idnaMapped, -160916, ""},
+	{0x2F9AB, idnaMapped, -34273, ""},
+	{0x2F9AC, idnaMapped, -160840, ""},
+	{0x2F9AD, idnaMapped, -35457, ""},
+	{0x2F9AE, idnaMapped, -177233, ""},
+	{0x2F9AF, idnaMapped, -177230, ""},
+	{0x2F9B0, idnaMapped, -35327, ""},
+	{0x2F9B1, idnaMapped, -35039, ""},
+	{0x2F9B2, idnaMapped, -177223, ""},
+	{0x2F9B3, idnaMapped, -160611, ""},
+	{0x2F9B4, idnaMapped, -160600, ""},
+	{0x2F9B5, idnaMapped, -160590, ""},
+	{0x2F9B6, idnaMapped, -160589, ""},
+	{0x2F9B7, idnaMapped, -160526, ""},
+	{0x2F9B8, idnaMapped, -160560, ""},
+	{0x2F9B9, idnaMapped, -160427, ""},
+	{0x2F9BA, idnaMapped, -160472, ""},
+	{0x2F9BB, idnaMapped, -160322, ""},
+	{0x2F9BC, idnaMapped, -160404, ""},
+	{0x2F9BD, idnaMapped, -160338, ""},
+	{0x2F9BE, idnaMapped, -160312, ""},
+	{0x2F9BF, idnaDisallowed, 0, ""},
+	{0x2F9C0, idnaMapped, -160223, ""},
+	{0x2F9C1, idnaMapped, -160192, ""},
+	{0x2F9C2, idnaMapped, -177097, ""},
+	{0x2F9C3, idnaMapped, -160099, ""},
+	{0x2F9C4, idnaMapped, -160097, ""},
+	{0x2F9C5, idnaMapped, -33630, ""},
+	{0x2F9C6, idnaMapped, -159983, ""},
+	{0x2F9C7, idnaMapped, -159977, ""},
+	{0x2F9C8, idnaMapped, -177043, ""},
+	{0x2F9C9, idnaMapped, -159951, ""},
+	{0x2F9CA, idnaMapped, -181519, ""},
+	{0x2F9CB, idnaMapped, -33053, ""},
+	{0x2F9CC, idnaMapped, -32870, ""},
+	{0x2F9CD, idnaMapped, -176911, ""},
+	{0x2F9CE, idnaMapped, -176903, ""},
+	{0x2F9CF, idnaMapped, -159535, ""},
+	{0x2F9D0, idnaMapped, -159459, ""},
+	{0x2F9D1, idnaMapped, -159303, ""},
+	{0x2F9D2, idnaMapped, -159101, ""},
+	{0x2F9D3, idnaMapped, -32043, ""},
+	{0x2F9D4, idnaMapped, -159017, ""},
+	{0x2F9D5, idnaMapped, -158996, ""},
+	{0x2F9D6, idnaMapped, -158907, ""},
+	{0x2F9D7, idnaMapped, -158816, ""},
+	{0x2F9D8, idnaMapped, -31401, ""},
+	{0x2F9D9, idnaMapped, -61909, ""},
+	{0x2F9DA, idnaMapped, -158735, ""},
+	{0x2F9DB, idnaMapped, -158751, ""},
+	{0x2F9DC, idnaMapped, -158700, ""},
+	{0x2F9DD, idnaMapped, -61695, ""},
+	{0x2F9DE, idnaMapped, -158474, ""},
+	{0x2F9DF, idnaMapped, -158375, ""},
+	{0x2F9E0, idnaMapped, -29710, ""},
+	{0x2F9E1, idnaMapped, -29684, ""},
+	{0x2F9E2, idnaMapped, -158030, ""},
+	{0x2F9E3, idnaMapped, -157938, ""},
+	{0x2F9E4, idnaMapped, -157907, ""},
+	{0x2F9E5, idnaMapped, -29367, ""},
+	{0x2F9E6, idnaMapped, -157899, ""},
+	{0x2F9E7, idnaMapped, -157615, ""},
+	{0x2F9E8, idnaMapped, -157457, ""},
+	{0x2F9EA, idnaMapped, -157550, ""},
+	{0x2F9EB, idnaMapped, -157170, ""},
+	{0x2F9EC, idnaMapped, -157143, ""},
+	{0x2F9ED, idnaMapped, -28147, ""},
+	{0x2F9EE, idnaMapped, -156771, ""},
+	{0x2F9EF, idnaMapped, -176218, ""},
+	{0x2F9F0, idnaMapped, -156729, ""},
+	{0x2F9F1, idnaMapped, -27770, ""},
+	{0x2F9F2, idnaMapped, -176140, ""},
+	{0x2F9F3, idnaMapped, -156464, ""},
+	{0x2F9F4, idnaMapped, -171074, ""},
+	{0x2F9F5, idnaMapped, -156370, ""},
+	{0x2F9F6, idnaMapped, -26801, ""},
+	{0x2F9F7, idnaMapped, -26589, ""},
+	{0x2F9F8, idnaMapped, -176010, ""},
+	{0x2F9F9, idnaMapped, -176003, ""},
+	{0x2F9FA, idnaMapped, -156186, ""},
+	{0x2F9FB, idnaMapped, -26097, ""},
+	{0x2F9FC, idnaMapped, -175946, ""},
+	{0x2F9FD, idnaMapped, -25959, ""},
+	{0x2F9FE, idnaMapped, -156147, ""},
+	{0x2F9FF, idnaMapped, -156148, ""},
+	{0x2FA00, idnaMapped, -156119, ""},
+	{0x2FA01, idnaMapped, -25675, ""},
+	{0x2FA02, idnaMapped, -155936, ""},
+	{0x2FA03, idnaMapped, -175824, ""},
+	{0x2FA04, idnaMapped, -155867, ""},
+	{0x2FA05, idnaMapped, -155742, ""},
+	{0x2FA06, idnaMapped, -155716, ""},
+	{0x2FA07, idnaMapped, -155657, ""},
+	{0x2FA08, idnaMapped, -175674, ""},
+	{0x2FA09, idnaMapped, -24281, ""},
+	{0x2FA0A, idnaMapped, -155384, ""},
+	{0x2FA0B, idnaMapped, -155083, ""},
+	{0x2FA0C, idnaMapped, -154895, ""},
+	{0x2FA0D, idnaMapped, -175423, ""},
+	{0x2FA0E, idnaMapped, -175393, ""},
+	{0x2FA0F, idnaMapped, -154792, ""},
+	{0x2FA10, idnaMapped, -22850, ""},
+	{0x2FA11, idnaMapped, -175385, ""},
+	{0x2FA12, idnaMapped, -22797, ""},
+	{0x2FA13, idnaMapped, -22533, ""},
+	{0x2FA14, idnaMapped, -22403, ""},
+	{0x2FA15, idnaMapped, -154458, ""},
+	{0x2FA16, idnaMapped, -175296, ""},
+	{0x2FA17, idnaMapped, -154398, ""},
+	{0x2FA18, idnaMapped, -154394, ""},
+	{0x2FA19, idnaMapped, -154388, ""},
+	{0x2FA1A, idnaMapped, -154379, ""},
+	{0x2FA1B, idnaMapped, -154373, ""},
+	{0x2FA1C, idnaMapped, -154337, ""},
+	{0x2FA1D, idnaMapped, -21533, ""},
+	{0x2FA1E, idnaDisallowed, 0, ""},
+	{0x30000, idnaValid, 0, ""},
+	{0x3134B, idnaDisallowed, 0, ""},
+	{0x31350, idnaValid, 0, ""},
+	{0x323B0, idnaDisallowed, 0, ""},
+	{0xE0100, idnaIgnored, 0, ""},
+	{0xE01F0, idnaDisallowed, 0, ""},
+}
+
+// cccTable lists the code points with a non-zero Canonical_Combining_Class.
+var cccTable = []propertyRange{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0898, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ACE, 230},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+// nfcDecompositions are the canonical decompositions of the Unicode
+// Character Database. The second code point is 0 for singletons.
+var nfcDecompositions = map[rune][2]rune{
+	0x00C0:  {0x0041, 0x0300},
+	0x00C1:  {0x0041, 0x0301},
+	0x00C2:  {0x0041, 0x0302},
+	0x00C3:  {0x0041, 0x0303},
+	0x00C4:  {0x0041, 0x0308},
+	0x00C5:  {0x0041, 0x030A},
+	0x00C7:  {0x0043, 0x0327},
+	0x00C8:  {0x0045, 0x0300},
+	0x00C9:  {0x0045, 0x0301},
+	0x00CA:  {0x0045, 0x0302},
+	0x00CB:  {0x0045, 0x0308},
+	0x00CC:  {0x0049, 0x0300},
+	0x00CD:  {0x0049, 0x0301},
+	0x00CE:  {0x0049, 0x0302},
+	0x00CF:  {0x0049, 0x0308},
+	0x00D1:  {0x004E, 0x0303},
+	0x00D2:  {0x004F, 0x0300},
+	0x00D3:  {0x004F, 0x0301},
+	0x00D4:  {0x004F, 0x0302},
+	0x00D5:  {0x004F, 0x0303},
+	0x00D6:  {0x004F, 0x0308},
+	0x00D9:  {0x0055, 0x0300},
+	0x00DA:  {0x0055, 0x0301},
+	0x00DB:  {0x0055, 0x0302},
+	0x00DC:  {0x0055, 0x0308},
+	0x00DD:  {0x0059, 0x0301},
+	0x00E0:  {0x0061, 0x0300},
+	0x00E1:  {0x0061, 0x0301},
+	0x00E2:  {0x0061, 0x0302},
+	0x00E3:  {0x0061, 0x0303},
+	0x00E4:  {0x0061, 0x0308},
+	0x00E5:  {0x0061, 0x030A},
+	0x00E7:  {0x0063, 0x0327},
+	0x00E8:  {0x0065, 0x0300},
+	0x00E9:  {0x0065, 0x0301},
+	0x00EA:  {0x0065, 0x0302},
+	0x00EB:  {0x0065, 0x0308},
+	0x00EC:  {0x0069, 0x0300},
+	0x00ED:  {0x0069, 0x0301},
+	0x00EE:  {0x0069, 0x0302},
+	0x00EF:  {0x0069, 0x0308},
+	0x00F1:  {0x006E, 0x0303},
+	0x00F2:  {0x006F, 0x0300},
+	0x00F3:  {0x006F, 0x0301},
+	0x00F4:  {0x006F, 0x0302},
+	0x00F5:  {0x006F, 0x0303},
+	0x00F6:  {0x006F, 0x0308},
+	0x00F9:  {0x0075, 0x0300},
+	0x00FA:  {0x0075, 0x0301},
+	0x00FB:  {0x0075, 0x0302},
+	0x00FC:  {0x0075, 0x0308},
+	0x00FD:  {0x0079, 0x0301},
+	0x00FF:  {0x0079, 0x0308},
+	0x0100:  {0x0041, 0x0304},
+	0x0101:  {0x0061, 0x0304},
+	0x0102:  {0x0041, 0x0306},
+	0x0103:  {0x0061, 0x0306},
+	0x0104:  {0x0041, 0x0328},
+	0x0105:  {0x0061, 0x0328},
+	0x0106:  {0x0043, 0x0301},
+	0x0107:  {0x0063, 0x0301},
+	0x0108:  {0x0043, 0x0302},
+	0x0109:  {0x0063, 0x0302},
+	0x010A:  {0x0043, 0x0307},
+	0x010B:  {0x0063, 0x0307},
+	0x010C:  {0x0043, 0x030C},
+	0x010D:  {0x0063, 0x030C},
+	0x010E:  {0x0044, 0x030C},
+	0x010F:  {0x0064, 0x030C},
+	0x0112:  {0x0045, 0x0304},
+	0x0113:  {0x0065, 0x0304},
+	0x0114:  {0x0045, 0x0306},
+	0x0115:  {0x0065, 0x0306},
+	0x0116:  {0x0045, 0x0307},
+	0x0117:  {0x0065, 0x0307},
+	0x0118:  {0x0045, 0x0328},
+	0x0119:  {0x0065, 0x0328},
+	0x011A:  {0x0045, 0x030C},
+	0x011B:  {0x0065, 0x030C},
+	0x011C:  {0x0047, 0x0302},
+	0x011D:  {0x0067, 0x0302},
+	0x011E:  {0x0047, 0x0306},
+	0x011F:  {0x0067, 0x0306},
+	0x0120:  {0x0047, 0x0307},
+	0x0121:  {0x0067, 0x0307},
+	0x0122:  {0x0047, 0x0327},
+	0x0123:  {0x0067, 0x0327},
+	0x0124:  {0x0048, 0x0302},
+	0x0125:  {0x0068, 0x0302},
+	0x0128:  {0x0049, 0x0303},
+	0x0129:  {0x0069, 0x0303},
+	0x012A:  {0x0049, 0x0304},
+	0x012B:  {0x0069, 0x0304},
+	0x012C:  {0x0049, 0x0306},
+	0x012D:  {0x0069, 0x0306},
+	0x012E:  {0x0049, 0x0328},
+	0x012F:  {0x0069, 0x0328},
+	0x0130:  {0x0049, 0x0307},
+	0x0134:  {0x004A, 0x0302},
+	0x0135:  {0x006A, 0x0302},
+	0x0136:  {0x004B, 0x0327},
+	0x0137:  {0x006B, 0x0327},
+	0x0139:  {0x004C, 0x0301},
+	0x013A:  {0x006C, 0x0301},
+	0x013B:  {0x004C, 0x0327},
+	0x013C:  {0x006C, 0x0327},
+	0x013D:  {0x004C, 0x030C},
+	0x013E:  {0x006C, 0x030C},
+	0x0143:  {0x004E, 0x0301},
+	0x0144:  {0x006E, 0x0301},
+	0x0145:  {0x004E, 0x0327},
+	0x0146:  {0x006E, 0x0327},
+	0x0147:  {0x004E, 0x030C},
+	0x0148:  {0x006E, 0x030C},
+	0x014C:  {0x004F, 0x0304},
+	0x014D:  {0x006F, 0x0304},
+	0x014E:  {0x004F, 0x0306},
+	0x014F:  {0x006F, 0x0306},
+	0x0150:  {0x004F, 0x030B},
+	0x0151:  {0x006F, 0x030B},
+	0x0154:  {0x0052, 0x0301},
+	0x0155:  {0x0072, 0x0301},
+	0x0156:  {0x0052, 0x0327},
+	0x0157:  {0x0072, 0x0327},
+	0x0158:  {0x0052, 0x030C},
+	0x0159:  {0x0072, 0x030C},
+	0x015A:  {0x0053, 0x0301},
+	0x015B:  {0x0073, 0x0301},
+	0x015C:  {0x0053, 0x0302},
+	0x015D:  {0x0073, 0x0302},
+	0x015E:  {0x0053, 0x0327},
+	0x015F:  {0x0073, 0x0327},
+	0x0160:  {0x0053, 0x030C},
+	0x0161:  {0x0073, 0x030C},
+	0x0162:  {0x0054, 0x0327},
+	0x0163:  {0x0074, 0x0327},
+	0x0164:  {0x0054, 0x030C},
+	0x0165:  {0x0074, 0x030C},
+	0x0168:  {0x0055, 0x0303},
+	0x0169:  {0x0075, 0x0303},
+	0x016A:  {0x0055, 0x0304},
+	0x016B:  {0x0075, 0x0304},
+	0x016C:  {0x0055, 0x0306},
+	0x016D:  {0x0075, 0x0306},
+	0x016E:  {0x0055, 0x030A},
+	0x016F:  {0x0075, 0x030A},
+	0x0170:  {0x0055, 0x030B},
+	0x0171:  {0x0075, 0x030B},
+	0x0172:  {0x0055, 0x0328},
+	0x0173:  {0x0075, 0x0328},
+	0x0174:  {0x0057, 0x0302},
+	0x0175:  {0x0077, 0x0302},
+	0x0176:  {0x0059, 0x0302},
+	0x0177:  {0x0079, 0x0302},
+	0x0178:  {0x0059, 0x0308},
+	0x0179:  {0x005A, 0x0301},
+	0x017A:  {0x007A, 0x0301},
+	0x017B:  {0x005A, 0x0307},
+	0x017C:  {0x007A, 0x0307},
+	0x017D:  {0x005A, 0x030C},
+	0x017E:  {0x007A, 0x030C},
+	0x01A0:  {0x004F, 0x031B},
+	0x01A1:  {0x006F, 0x031B},
+	0x01AF:  {0x0055, 0x031B},
+	0x01B0:  {0x0075, 0x031B},
+	0x01CD:  {0x0041, 0x030C},
+	0x01CE:  {0x0061, 0x030C},
+	0x01CF:  {0x0049, 0x030C},
+	0x01D0:  {0x0069, 0x030C},
+	0x01D1:  {0x004F, 0x030C},
+	0x01D2:  {0x006F, 0x030C},
+	0x01D3:  {0x0055, 0x030C},
+	0x01D4:  {0x0075, 0x030C},
+	0x01D5:  {0x00DC, 0x0304},
+	0x01D6:  {0x00FC, 0x0304},
+	0x01D7:  {0x00DC, 0x0301},
+	0x01D8:  {0x00FC, 0x0301},
+	0x01D9:  {0x00DC, 0x030C},
+	0x01DA:  {0x00FC, 0x030C},
+	0x01DB:  {0x00DC, 0x0300},
+	0x01DC:  {0x00FC, 0x0300},
+	0x01DE:  {0x00C4, 0x0304},
+	0x01DF:  {0x00E4, 0x0304},
+	0x01E0:  {0x0226, 0x0304},
+	0x01E1:  {0x0227, 0x0304},
+	0x01E2:  {0x00C6, 0x0304},
+	0x01E3:  {0x00E6, 0x0304},
+	0x01E6:  {0x0047, 0x030C},
+	0x01E7:  {0x0067, 0x030C},
+	0x01E8:  {0x004B, 0x030C},
+	0x01E9:  {0x006B, 0x030C},
+	0x01EA:  {0x004F, 0x0328},
+	0x01EB:  {0x006F, 0x0328},
+	0x01EC:  {0x01EA, 0x0304},
+	0x01ED:  {0x01EB, 0x0304},
+	0x01EE:  {0x01B7, 0x030C},
+	0x01EF:  {0x0292, 0x030C},
+	0x01F0:  {0x006A, 0x030C},
+	0x01F4:  {0x0047, 0x0301},
+	0x01F5:  {0x0067, 0x0301},
+	0x01F8:  {0x004E, 0x0300},
+	0x01F9:  {0x006E, 0x0300},
+	0x01FA:  {0x00C5, 0x0301},
+	0x01FB:  {0x00E5, 0x0301},
+	0x01FC:  {0x00C6, 0x0301},
+	0x01FD:  {0x00E6, 0x0301},
+	0x01FE:  {0x00D8, 0x0301},
+	0x01FF:  {0x00F8, 0x0301},
+	0x0200:  {0x0041, 0x030F},
+	0x0201:  {0x0061, 0x030F},
+	0x0202:  {0x0041, 0x0311},
+	0x0203:  {0x0061, 0x0311},
+	0x0204:  {0x0045, 0x030F},
+	0x0205:  {0x0065, 0x030F},
+	0x0206:  {0x0045, 0x0311},
+	0x0207:  {0x0065, 0x0311},
+	0x0208:  {0x0049, 0x030F},
+	0x0209:  {0x0069, 0x030F},
+	0x020A:  {0x0049, 0x0311},
+	0x020B:  {0x0069, 0x0311},
+	0x020C:  {0x004F, 0x030F},
+	0x020D:  {0x006F, 0x030F},
+	0x020E:  {0x004F, 0x0311},
+	0x020F:  {0x006F, 0x0311},
+	0x0210:  {0x0052, 0x030F},
+	0x0211:  {0x0072, 0x030F},
+	0x0212:  {0x0052, 0x0311},
+	0x0213:  {0x0072, 0x0311},
+	0x0214:  {0x0055, 0x030F},
+	0x0215:  {0x0075, 0x030F},
+	0x0216:  {0x0055, 0x0311},
+	0x0217:  {0x0075, 0x0311},
+	0x0218:  {0x0053, 0x0326},
+	0x0219:  {0x0073, 0x0326},
+	0x021A:  {0x0054, 0x0326},
+	0x021B:  {0x0074, 0x0326},
+	0x021E:  {0x0048, 0x030C},
+	0x021F:  {0x0068, 0x030C},
+	0x0226:  {0x0041, 0x0307},
+	0x0227:  {0x0061, 0x0307},
+	0x0228:  {0x0045, 0x0327},
+	0x0229:  {0x0065, 0x0327},
+	0x022A:  {0x00D6, 0x0304},
+	0x022B:  {0x00F6, 0x0304},
+	0x022C:  {0x00D5, 0x0304},
+	0x022D:  {0x00F5, 0x0304},
+	0x022E:  {0x004F, 0x0307},
+	0x022F:  {0x006F, 0x0307},
+	0x0230:  {0x022E, 0x0304},
+	0x0231:  {0x022F, 0x0304},
+	0x0232:  {0x0059, 0x0304},
+	0x0233:  {0x0079, 0x0304},
+	0x0340:  {0x0300, 0x0000},
+	0x0341:  {0x0301, 0x0000},
+	0x0343:  {0x0313, 0x0000},
+	0x0344:  {0x0308, 0x0301},
+	0x0374:  {0x02B9, 0x0000},
+	0x037E:  {0x003B, 0x0000},
+	0x0385:  {0x00A8, 0x0301},
+	0x0386:  {0x0391, 0x0301},
+	0x0387:  {0x00B7, 0x0000},
+	0x0388:  {0x0395, 0x0301},
+	0x0389:  {0x0397, 0x0301},
+	0x038A:  {0x0399, 0x0301},
+	0x038C:  {0x039F, 0x0301},
+	0x038E:  {0x03A5, 0x0301},
+	0x038F:  {0x03A9, 0x0301},
+	0x0390:  {0x03CA, 0x0301},
+	0x03AA:  {0x0399, 0x0308},
+	0x03AB:  {0x03A5, 0x0308},
+	0x03AC:  {0x03B1, 0x0301},
+	0x03AD:  {0x03B5, 0x0301},
+	0x03AE:  {0x03B7, 0x0301},
+	0x03AF:  {0x03B9, 0x0301},
+	0x03B0:  {0x03CB, 0x0301},
+	0x03CA:  {0x03B9, 0x0308},
+	0x03CB:  {0x03C5, 0x0308},
+	0x03CC:  {0x03BF, 0x0301},
+	0x03CD:  {0x03C5, 0x0301},
+	0x03CE:  {0x03C9, 0x0301},
+	0x03D3:  {0x03D2, 0x0301},
+	0x03D4:  {0x03D2, 0x0308},
+	0x0400:  {0x0415, 0x0300},
+	0x0401:  {0x0415, 0x0308},
+	0x0403:  {0x0413, 0x0301},
+	0x0407:  {0x0406, 0x0308},
+	0x040C:  {0x041A, 0x0301},
+	0x040D:  {0x0418, 0x0300},
+	0x040E:  {0x0423, 0x0306},
+	0x0419:  {0x0418, 0x0306},
+	0x0439:  {0x0438, 0x0306},
+	0x0450:  {0x0435, 0x0300},
+	0x0451:  {0x0435, 0x0308},
+	0x0453:  {0x0433, 0x0301},
+	0x0457:  {0x0456, 0x0308},
+	0x045C:  {0x043A, 0x0301},
+	0x045D:  {0x0438, 0x0300},
+	0x045E:  {0x0443, 0x0306},
+	0x0476:  {0x0474, 0x030F},
+	0x0477:  {0x0475, 0x030F},
+	0x04C1:  {0x0416, 0x0306},
+	0x04C2:  {0x0436, 0x0306},
+	0x04D0:  {0x0410, 0x0306},
+	0x04D1:  {0x0430, 0x0306},
+	0x04D2:  {0x0410, 0x0308},
+	0x04D3:  {0x0430, 0x0308},
+	0x04D6:  {0x0415, 0x0306},
+	0x04D7:  {0x0435, 0x0306},
+	0x04DA:  {0x04D8, 0x0308},
+	0x04DB:  {0x04D9, 0x0308},
+	0x04DC:  {0x0416, 0x0308},
+	0x04DD:  {0x0436, 0x0308},
+	0x04DE:  {0x0417, 0x0308},
+	0x04DF:  {0x0437, 0x0308},
+	0x04E2:  {0x0418, 0x0304},
+	0x04E3:  {0x0438, 0x0304},
+	0x04E4:  {0x0418, 0x0308},
+	0x04E5:  {0x0438, 0x0308},
+	0x04E6:  {0x041E, 0x0308},
+	0x04E7:  {0x043E, 0x0308},
+	0x04EA:  {0x04E8, 0x0308},
+	0x04EB:  {0x04E9, 0x0308},
+	0x04EC:  {0x042D, 0x0308},
+	0x04ED:  {0x044D, 0x0308},
+	0x04EE:  {0x0423, 0x0304},
+	0x04EF:  {0x0443, 0x0304},
+	0x04F0:  {0x0423, 0x0308},
+	0x04F1:  {0x0443, 0x0308},
+	0x04F2:  {0x0423, 0x030B},
+	0x04F3:  {0x0443, 0x030B},
+	0x04F4:  {0x0427, 0x0308},
+	0x04F5:  {0x0447, 0x0308},
+	0x04F8:  {0x042B, 0x0308},
+	0x04F9:  {0x044B, 0x0308},
+	0x0622:  {0x0627, 0x0653},
+	0x0623:  {0x0627, 0x0654},
+	0x0624:  {0x0648, 0x0654},
+	0x0625:  {0x0627, 0x0655},
+	0x0626:  {0x064A, 0x0654},
+	0x06C0:  {0x06D5, 0x0654},
+	0x06C2:  {0x06C1, 0x0654},
+	0x06D3:  {0x06D2, 0x0654},
+	0x0929:  {0x0928, 0x093C},
+	0x0931:  {0x0930, 0x093C},
+	0x0934:  {0x0933, 0x093C},
+	0x0958:  {0x0915, 0x093C},
+	0x0959:  {0x0916, 0x093C},
+	0x095A:  {0x0917, 0x093C},
+	0x095B:  {0x091C, 0x093C},
+	0x095C:  {0x0921, 0x093C},
+	0x095D:  {0x0922, 0x093C},
+	0x095E:  {0x092B, 0x093C},
+	0x095F:  {0x092F, 0x093C},
+	0x09CB:  {0x09C7, 0x09BE},
+	0x09CC:  {0x09C7, 0x09D7},
+	0x09DC:  {0x09A1, 0x09BC},
+	0x09DD:  {0x09A2, 0x09BC},
+	0x09DF:  {0x09AF, 0x09BC},
+	0x0A33:  {0x0A32, 0x0A3C},
+	0x0A36:  {0x0A38, 0x0A3C},
+	0x0A59:  {0x0A16, 0x0A3C},
+	0x0A5A:  {0x0A17, 0x0A3C},
+	0x0A5B:  {0x0A1C, 0x0A3C},
+	0x0A5E:  {0x0A2B, 0x0A3C},
+	0x0B48:  {0x0B47, 0x0B56},
+	0x0B4B:  {0x0B47, 0x0B3E},
+	0x0B4C:  {0x0B47, 0x0B57},
+	0x0B5C:  {0x0B21, 0x0B3C},
+	0x0B5D:  {0x0B22, 0x0B3C},
+	0x0B94:  {0x0B92, 0x0BD7},
+	0x0BCA:  {0x0BC6, 0x0BBE},
+	0x0BCB:  {0x0BC7, 0x0BBE},
+	0x0BCC:  {0x0BC6, 0x0BD7},
+	0x0C48:  {0x0C46, 0x0C56},
+	0x0CC0:  {0x0CBF, 0x0CD5},
+	0x0CC7:  {0x0CC6, 0x0CD5},
+	0x0CC8:  {0x0CC6, 0x0CD6},
+	0x0CCA:  {0x0CC6, 0x0CC2},
+	0x0CCB:  {0x0CCA, 0x0CD5},
+	0x0D4A:  {0x0D46, 0x0D3E},
+	0x0D4B:  {0x0D47, 0x0D3E},
+	0x0D4C:  {0x0D46, 0x0D57},
+	0x0DDA:  {0x0DD9, 0x0DCA},
+	0x0DDC:  {0x0DD9, 0x0DCF},
+	0x0DDD:  {0x0DDC, 0x0DCA},
+	0x0DDE:  {0x0DD9, 0x0DDF},
+	0x0F43:  {0x0F42, 0x0FB7},
+	0x0F4D:  {0x0F4C, 0x0FB7},
+	0x0F52:  {0x0F51, 0x0FB7},
+	0x0F57:  {0x0F56, 0x0FB7},
+	0x0F5C:  {0x0F5B, 0x0FB7},
+	0x0F69:  {0x0F40, 0x0FB5},
+	0x0F73:  {0x0F71, 0x0F72},
+	0x0F75:  {0x0F71, 0x0F74},
+	0x0F76:  {0x0FB2, 0x0F80},
+	0x0F78:  {0x0FB3, 0x0F80},
+	0x0F81:  {0x0F71, 0x0F80},
+	0x0F93:  {0x0F92, 0x0FB7},
+	0x0F9D:  {0x0F9C, 0x0FB7},
+	0x0FA2:  {0x0FA1, 0x0FB7},
+	0x0FA7:  {0x0FA6, 0x0FB7},
+	0x0FAC:  {0x0FAB, 0x0FB7},
+	0x0FB9:  {0x0F90, 0x0FB5},
+	0x1026:  {0x1025, 0x102E},
+	0x1B06:  {0x1B05, 0x1B35},
+	0x1B08:  {0x1B07, 0x1B35},
+	0x1B0A:  {0x1B09, 0x1B35},
+	0x1B0C:  {0x1B0B, 0x1B35},
+	0x1B0E:  {0x1B0D, 0x1B35},
+	0x1B12:  {0x1B11, 0x1B35},
+	0x1B3B:  {0x1B3A, 0x1B35},
+	0x1B3D:  {0x1B3C, 0x1B35},
+	0x1B40:  {0x1B3E, 0x1B35},
+	0x1B41:  {0x1B3F, 0x1B35},
+	0x1B43:  {0x1B42, 0x1B35},
+	0x1E00:  {0x0041, 0x0325},
+	0x1E01:  {0x0061, 0x0325},
+	0x1E02:  {0x0042, 0x0307},
+	0x1E03:  {0x0062, 0x0307},
+	0x1E04:  {0x0042, 0x0323},
+	0x1E05:  {0x0062, 0x0323},
+	0x1E06:  {0x0042, 0x0331},
+	0x1E07:  {0x0062, 0x0331},
+	0x1E08:  {0x00C7, 0x0301},
+	0x1E09:  {0x00E7, 0x0301},
+	0x1E0A:  {0x0044, 0x0307},
+	0x1E0B:  {0x0064, 0x0307},
+	0x1E0C:  {0x0044, 0x0323},
+	0x1E0D:  {0x0064, 0x0323},
+	0x1E0E:  {0x0044, 0x0331},
+	0x1E0F:  {0x0064, 0x0331},
+	0x1E10:  {0x0044, 0x0327},
+	0x1E11:  {0x0064, 0x0327},
+	0x1E12:  {0x0044, 0x032D},
+	0x1E13:  {0x0064, 0x032D},
+	0x1E14:  {0x0112, 0x0300},
+	0x1E15:  {0x0113, 0x0300},
+	0x1E16:  {0x0112, 0x0301},
+	0x1E17:  {0x0113, 0x0301},
+	0x1E18:  {0x0045, 0x032D},
+	0x1E19:  {0x0065, 0x032D},
+	0x1E1A:  {0x0045, 0x0330},
+	0x1E1B:  {0x0065, 0x0330},
+	0x1E1C:  {0x0228, 0x0306},
+	0x1E1D:  {0x0229, 0x0306},
+	0x1E1E:  {0x0046, 0x0307},
+	0x1E1F:  {0x0066, 0x0307},
+	0x1E20:  {0x0047, 0x0304},
+	0x1E21:  {0x0067, 0x0304},
+	0x1E22:  {0x0048, 0x0307},
+	0x1E23:  {0x0068, 0x0307},
+	0x1E24:  {0x0048, 0x0323},
+	0x1E25:  {0x0068, 0x0323},
+	0x1E26:  {0x0048, 0x0308},
+	0x1E27:  {0x0068, 0x0308},
+	0x1E28:  {0x0048, 0x0327},
+	0x1E29:  {0x0068, 0x0327},
+	0x1E2A:  {0x0048, 0x032E},
+	0x1E2B:  {0x0068, 0x032E},
+	0x1E2C:  {0x0049, 0x0330},
+	0x1E2D:  {0x0069, 0x0330},
+	0x1E2E:  {0x00CF, 0x0301},
+	0x1E2F:  {0x00EF, 0x0301},
+	0x1E30:  {0x004B, 0x0301},
+	0x1E31:  {0x006B, 0x0301},
+	0x1E32:  {0x004B, 0x0323},
+	0x1E33:  {0x006B, 0x0323},
+	0x1E34:  {0x004B, 0x0331},
+	0x1E35:  {0x006B, 0x0331},
+	0x1E36:  {0x004C, 0x0323},
+	0x1E37:  {0x006C, 0x0323},
+	0x1E38:  {0x1E36, 0x0304},
+	0x1E39:  {0x1E37, 0x0304},
+	0x1E3A:  {0x004C, 0x0331},
+	0x1E3B:  {0x006C, 0x0331},
+	0x1E3C:  {0x004C, 0x032D},
+	0x1E3D:  {0x006C, 0x032D},
+	0x1E3E:  {0x004D, 0x0301},
+	0x1E3F:  {0x006D, 0x0301},
+	0x1E40:  {0x004D, 0x0307},
+	0x1E41:  {0x006D, 0x0307},
+	0x1E42:  {0x004D, 0x0323},
+	0x1E43:  {0x006D, 0x0323},
+	0x1E44:  {0x004E, 0x0307},
+	0x1E45:  {0x006E, 0x0307},
+	0x1E46:  {0x004E, 0x0323},
+	0x1E47:  {0x006E, 0x0323},
+	0x1E48:  {0x004E, 0x0331},
+	0x1E49:  {0x006E, 0x0331},
+	0x1E4A:  {0x004E, 0x032D},
+	0x1E4B:  {0x006E, 0x032D},
+	0x1E4C:  {0x00D5, 0x0301},
+	0x1E4D:  {0x00F5, 0x0301},
+	0x1E4E:  {0x00D5, 0x0308},
+	0x1E4F:  {0x00F5, 0x0308},
+	0x1E50:  {0x014C, 0x0300},
+	0x1E51:  {0x014D, 0x0300},
+	0x1E52:  {0x014C, 0x0301},
+	0x1E53:  {0x014D, 0x0301},
+	0x1E54:  {0x0050, 0x0301},
+	0x1E55:  {0x0070, 0x0301},
+	0x1E56:  {0x0050, 0x0307},
+	0x1E57:  {0x0070, 0x0307},
+	0x1E58:  {0x0052, 0x0307},
+	0x1E59:  {0x0072, 0x0307},
+	0x1E5A:  {0x0052, 0x0323},
+	0x1E5B:  {0x0072, 0x0323},
+	0x1E5C:  {0x1E5A, 0x0304},
+	0x1E5D:  {0x1E5B, 0x0304},
+	0x1E5E:  {0x0052, 0x0331},
+	0x1E5F:  {0x0072, 0x0331},
+	0x1E60:  {0x0053, 0x0307},
+	0x1E61:  {0x0073, 0x0307},
+	0x1E62:  {0x0053, 0x0323},
+	0x1E63:  {0x0073, 0x0323},
+	0x1E64:  {0x015A, 0x0307},
+	0x1E65:  {0x015B, 0x0307},
+	0x1E66:  {0x0160, 0x0307},
+	0x1E67:  {0x0161, 0x0307},
+	0x1E68:  {0x1E62, 0x0307},
+	0x1E69:  {0x1E63, 0x0307},
+	0x1E6A:  {0x0054, 0x0307},
+	0x1E6B:  {0x0074, 0x0307},
+	0x1E6C:  {0x0054, 0x0323},
+	0x1E6D:  {0x0074, 0x0323},
+	0x1E6E:  {0x0054, 0x0331},
+	0x1E6F:  {0x0074, 0x0331},
+	0x1E70:  {0x0054, 0x032D},
+	0x1E71:  {0x0074, 0x032D},
+	0x1E72:  {0x0055, 0x0324},
+	0x1E73:  {0x0075, 0x0324},
+	0x1E74:  {0x0055, 0x0330},
+	0x1E75:  {0x0075, 0x0330},
+	0x1E76:  {0x0055, 0x032D},
+	0x1E77:  {0x0075, 0x032D},
+	0x1E78:  {0x0168, 0x0301},
+	0x1E79:  {0x0169, 0x0301},
+	0x1E7A:  {0x016A, 0x0308},
+	0x1E7B:  {0x016B, 0x0308},
+	0x1E7C:  {0x0056, 0x0303},
+	0x1E7D:  {0x0076, 0x0303},
+	0x1E7E:  {0x0056, 0x0323},
+	0x1E7F:  {0x0076, 0x0323},
+	0x1E80:  {0x0057, 0x0300},
+	0x1E81:  {0x0077, 0x0300},
+	0x1E82:  {0x0057, 0x0301},
+	0x1E83:  {0x0077, 0x0301},
+	0x1E84:  {0x0057, 0x0308},
+	0x1E85:  {0x0077, 0x0308},
+	0x1E86:  {0x0057, 0x0307},
+	0x1E87:  {0x0077, 0x0307},
+	0x1E88:  {0x0057, 0x0323},
+	0x1E89:  {0x0077, 0x0323},
+	0x1E8A:  {0x0058, 0x0307},
+	0x1E8B:  {0x0078, 0x0307},
+	0x1E8C:  {0x0058, 0x0308},
+	0x1E8D:  {0x0078, 0x0308},
+	0x1E8E:  {0x0059, 0x0307},
+	0x1E8F:  {0x0079, 0x0307},
+	0x1E90:  {0x005A, 0x0302},
+	0x1E91:  {0x007A, 0x0302},
+	0x1E92:  {0x005A, 0x0323},
+	0x1E93:  {0x007A, 0x0323},
+	0x1E94:  {0x005A, 0x0331},
+	0x1E95:  {0x007A, 0x0331},
+	0x1E96:  {0x0068, 0x0331},
+	0x1E97:  {0x0074, 0x0308},
+	0x1E98:  {0x0077, 0x030A},
+	0x1E99:  {0x0079, 0x030A},
+	0x1E9B:  {0x017F, 0x0307},
+	0x1EA0:  {0x0041, 0x0323},
+	0x1EA1:  {0x0061, 0x0323},
+	0x1EA2:  {0x0041, 0x0309},
+	0x1EA3:  {0x0061, 0x0309},
+	0x1EA4:  {0x00C2, 0x0301},
+	0x1EA5:  {0x00E2, 0x0301},
+	0x1EA6:  {0x00C2, 0x0300},
+	0x1EA7:  {0x00E2, 0x0300},
+	0x1EA8:  {0x00C2, 0x0309},
+	0x1EA9:  {0x00E2, 0x0309},
+	0x1EAA:  {0x00C2, 0x0303},
+	0x1EAB:  {0x00E2, 0x0303},
+	0x1EAC:  {0x1EA0, 0x0302},
+	0x1EAD:  {0x1EA1, 0x0302},
+	0x1EAE:  {0x0102, 0x0301},
+	0x1EAF:  {0x0103, 0x0301},
+	0x1EB0:  {0x0102, 0x0300},
+	0x1EB1:  {0x0103, 0x0300},
+	0x1EB2:  {0x0102, 0x0309},
+	0x1EB3:  {0x0103, 0x0309},
+	0x1EB4:  {0x0102, 0x0303},
+	0x1EB5:  {0x0103, 0x0303},
+	0x1EB6:  {0x1EA0, 0x0306},
+	0x1EB7:  {0x1EA1, 0x0306},
+	0x1EB8:  {0x0045, 0x0323},
+	0x1EB9:  {0x0065, 0x0323},
+	0x1EBA:  {0x0045, 0x0309},
+	0x1EBB:  {0x0065, 0x0309},
+	0x1EBC:  {0x0045, 0x0303},
+	0x1EBD:  {0x0065, 0x0303},
+	0x1EBE:  {0x00CA, 0x0301},
+	0x1EBF:  {0x00EA, 0x0301},
+	0x1EC0:  {0x00CA, 0x0300},
+	0x1EC1:  {0x00EA, 0x0300},
+	0x1EC2:  {0x00CA, 0x0309},
+	0x1EC3:  {0x00EA, 0x0309},
+	0x1EC4:  {0x00CA, 0x0303},
+	0x1EC5:  {0x00EA, 0x0303},
+	0x1EC6:  {0x1EB8, 0x0302},
+	0x1EC7:  {0x1EB9, 0x0302},
+	0x1EC8:  {0x0049, 0x0309},
+	0x1EC9:  {0x0069, 0x0309},
+	0x1ECA:  {0x0049, 0x0323},
+	0x1ECB:  {0x0069, 0x0323},
+	0x1ECC:  {0x004F, 0x0323},
+	0x1ECD:  {0x006F, 0x0323},
+	0x1ECE:  {0x004F, 0x0309},
+	0x1ECF:  {0x006F, 0x0309},
+	0x1ED0:  {0x00D4, 0x0301},
+	0x1ED1:  {0x00F4, 0x0301},
+	0x1ED2:  {0x00D4, 0x0300},
+	0x1ED3:  {0x00F4, 0x0300},
+	0x1ED4:  {0x00D4, 0x0309},
+	0x1ED5:  {0x00F4, 0x0309},
+	0x1ED6:  {0x00D4, 0x0303},
+	0x1ED7:  {0x00F4, 0x0303},
+	0x1ED8:  {0x1ECC, 0x0302},
+	0x1ED9:  {0x1ECD, 0x0302},
+	0x1EDA:  {0x01A0, 0x0301},
+	0x1EDB:  {0x01A1, 0x0301},
+	0x1EDC:  {0x01A0, 0x0300},
+	0x1EDD:  {0x01A1, 0x0300},
+	0x1EDE:  {0x01A0, 0x0309},
+	0x1EDF:  {0x01A1, 0x0309},
+	0x1EE0:  {0x01A0, 0x0303},
+	0x1EE1:  {0x01A1, 0x0303},
+	0x1EE2:  {0x01A0, 0x0323},
+	0x1EE3:  {0x01A1, 0x0323},
+	0x1EE4:  {0x0055, 0x0323},
+	0x1EE5:  {0x0075, 0x0323},
+	0x1EE6:  {0x0055, 0x0309},
+	0x1EE7:  {0x0075, 0x0309},
+	0x1EE8:  {0x01AF, 0x0301},
+	0x1EE9:  {0x01B0, 0x0301},
+	0x1EEA:  {0x01AF, 0x0300},
+	0x1EEB:  {0x01B0, 0x0300},
+	0x1EEC:  {0x01AF, 0x0309},
+	0x1EED:  {0x01B0, 0x0309},
+	0x1EEE:  {0x01AF, 0x0303},
+	0x1EEF:  {0x01B0, 0x0303},
+	0x1EF0:  {0x01AF, 0x0323},
+	0x1EF1:  {0x01B0, 0x0323},
+	0x1EF2:  {0x0059, 0x0300},
+	0x1EF3:  {0x0079, 0x0300},
+	0x1EF4:  {0x0059, 0x0323},
+	0x1EF5:  {0x0079, 0x0323},
+	0x1EF6:  {0x0059, 0x0309},
+	0x1EF7:  {0x0079, 0x0309},
+	0x1EF8:  {0x0059, 0x0303},
+	0x1EF9:  {0x0079, 0x0303},
+	0x1F00:  {0x03B1, 0x0313},
+	0x1F01:  {0x03B1, 0x0314},
+	0x1F02:  {0x1F00, 0x0300},
+	0x1F03:  {0x1F01, 0x0300},
+	0x1F04:  {0x1F00, 0x0301},
+	0x1F05:  {0x1F01, 0x0301},
+	0x1F06:  {0x1F00, 0x0342},
+	0x1F07:  {0x1F01, 0x0342},
+	0x1F08:  {0x0391, 0x0313},
+	0x1F09:  {0x0391, 0x0314},
+	0x1F0A:  {0x1F08, 0x0300},
+	0x1F0B:  {0x1F09, 0x0300},
+	0x1F0C:  {0x1F08, 0x0301},
+	0x1F0D:  {0x1F09, 0x0301},
+	0x1F0E:  {0x1F08, 0x0342},
+	0x1F0F:  {0x1F09, 0x0342},
+	0x1F10:  {0x03B5, 0x0313},
+	0x1F11:  {0x03B5, 0x0314},
+	0x1F12:  {0x1F10, 0x0300},
+	0x1F13:  {0x1F11, 0x0300},
+	0x1F14:  {0x1F10, 0x0301},
+	0x1F15:  {0x1F11, 0x0301},
+	0x1F18:  {0x0395, 0x0313},
+	0x1F19:  {0x0395, 0x0314},
+	0x1F1A:  {0x1F18, 0x0300},
+	0x1F1B:  {0x1F19, 0x0300},
+	0x1F1C:  {0x1F18, 0x0301},
+	0x1F1D:  {0x1F19, 0x0301},
+	0x1F20:  {0x03B7, 0x0313},
+	0x1F21:  {0x03B7, 0x0314},
+	0x1F22:  {0x1F20, 0x0300},
+	0x1F23:  {0x1F21, 0x0300},
+	0x1F24:  {0x1F20, 0x0301},
+	0x1F25:  {0x1F21, 0x0301},
+	0x1F26:  {0x1F20, 0x0342},
+	0x1F27:  {0x1F21, 0x0342},
+	0x1F28:  {0x0397, 0x0313},
+	0x1F29:  {0x0397, 0x0314},
+	0x1F2A:  {0x1F28, 0x0300},
+	0x1F2B:  {0x1F29, 0x0300},
+	0x1F2C:  {0x1F28, 0x0301},
+	0x1F2D:  {0x1F29, 0x0301},
+	0x1F2E:  {0x1F28, 0x0342},
+	0x1F2F:  {0x1F29, 0x0342},
+	0x1F30:  {0x03B9, 0x0313},
+	0x1F31:  {0x03B9, 0x0314},
+	0x1F32:  {0x1F30, 0x0300},
+	0x1F33:  {0x1F31, 0x0300},
+	0x1F34:  {0x1F30, 0x0301},
+	0x1F35:  {0x1F31, 0x0301},
+	0x1F36:  {0x1F30, 0x0342},
+	0x1F37:  {0x1F31, 0x0342},
+	0x1F38:  {0x0399, 0x0313},
+	0x1F39:  {0x0399, 0x0314},
+	0x1F3A:  {0x1F38, 0x0300},
+	0x1F3B:  {0x1F39, 0x0300},
+	0x1F3C:  {0x1F38, 0x0301},
+	0x1F3D:  {0x1F39, 0x0301},
+	0x1F3E:  {0x1F38, 0x0342},
+	0x1F3F:  {0x1F39, 0x0342},
+	0x1F40:  {0x03BF, 0x0313},
+	0x1F41:  {0x03BF, 0x0314},
+	0x1F42:  {0x1F40, 0x0300},
+	0x1F43:  {0x1F41, 0x0300},
+	0x1F44:  {0x1F40, 0x0301},
+	0x1F45:  {0x1F41, 0x0301},
+	0x1F48:  {0x039F, 0x0313},
+	0x1F49:  {0x039F, 0x0314},
+	0x1F4A:  {0x1F48, 0x0300},
+	0x1F4B:  {0x1F49, 0x0300},
+	0x1F4C:  {0x1F48, 0x0301},
+	0x1F4D:  {0x1F49, 0x0301},
+	0x1F50:  {0x03C5, 0x0313},
+	0x1F51:  {0x03C5, 0x0314},
+	0x1F52:  {0x1F50, 0x0300},
+	0x1F53:  {0x1F51, 0x0300},
+	0x1F54:  {0x1F50, 0x0301},
+	0x1F55:  {0x1F51, 0x0301},
+	0x1F56:  {0x1F50, 0x0342},
+	0x1F57:  {0x1F51, 0x0342},
+	0x1F59:  {0x03A5, 0x0314},
+	0x1F5B:  {0x1F59, 0x0300},
+	0x1F5D:  {0x1F59, 0x0301},
+	0x1F5F:  {0x1F59, 0x0342},
+	0x1F60:  {0x03C9, 0x0313},
+	0x1F61:  {0x03C9, 0x0314},
+	0x1F62:  {0x1F60, 0x0300},
+	0x1F63:  {0x1F61, 0x0300},
+	0x1F64:  {0x1F60, 0x0301},
+	0x1F65:  {0x1F61, 0x0301},
+	0x1F66:  {0x1F60, 0x0342},
+	0x1F67:  {0x1F61, 0x0342},
+	0x1F68:  {0x03A9, 0x0313},
+	0x1F69:  {0x03A9, 0x0314},
+	0x1F6A:  {0x1F68, 0x0300},
+	0x1F6B:  {0x1F69, 0x0300},
+	0x1F6C:  {0x1F68, 0x0301},
+	0x1F6D:  {0x1F69, 0x0301},
+	0x1F6E:  {0x1F68, 0x0342},
+	0x1F6F:  {0x1F69, 0x0342},
+	0x1F70:  {0x03B1, 0x0300},
+	0x1F71:  {0x03AC, 0x0000},
+	0x1F72:  {0x03B5, 0x0300},
+	0x1F73:  {0x03AD, 0x0000},
+	0x1F74:  {0x03B7, 0x0300},
+	0x1F75:  {0x03AE, 0x0000},
+	0x1F76:  {0x03B9, 0x0300},
+	0x1F77:  {0x03AF, 0x0000},
+	0x1F78:  {0x03BF, 0x0300},
+	0x1F79:  {0x03CC, 0x0000},
+	0x1F7A:  {0x03C5, 0x0300},
+	0x1F7B:  {0x03CD, 0x0000},
+	0x1F7C:  {0x03C9, 0x0300},
+	0x1F7D:  {0x03CE, 0x0000},
+	0x1F80:  {0x1F00, 0x0345},
+	0x1F81:  {0x1F01, 0x0345},
+	0x1F82:  {0x1F02, 0x0345},
+	0x1F83:  {0x1F03, 0x0345},
+	0x1F84:  {0x1F04, 0x0345},
+	0x1F85:  {0x1F05, 0x0345},
+	0x1F86:  {0x1F06, 0x0345},
+	0x1F87:  {0x1F07, 0x0345},
+	0x1F88:  {0x1F08, 0x0345},
+	0x1F89:  {0x1F09, 0x0345},
+	0x1F8A:  {0x1F0A, 0x0345},
+	0x1F8B:  {0x1F0B, 0x0345},
+	0x1F8C:  {0x1F0C, 0x0345},
+	0x1F8D:  {0x1F0D, 0x0345},
+	0x1F8E:  {0x1F0E, 0x0345},
+	0x1F8F:  {0x1F0F, 0x0345},
+	0x1F90:  {0x1F20, 0x0345},
+	0x1F91:  {0x1F21, 0x0345},
+	0x1F92:  {0x1F22, 0x0345},
+	0x1F93:  {0x1F23, 0x0345},
+	0x1F94:  {0x1F24, 0x0345},
+	0x1F95:  {0x1F25, 0x0345},
+	0x1F96:  {0x1F26, 0x0345},
+	0x1F97:  {0x1F27, 0x0345},
+	0x1F98:  {0x1F28, 0x0345},
+	0x1F99:  {0x1F29, 0x0345},
+	0x1F9A:  {0x1F2A, 0x0345},
+	0x1F9B:  {0x1F2B, 0x0345},
+	0x1F9C:  {0x1F2C, 0x0345},
+	0x1F9D:  {0x1F2D, 0x0345},
+	0x1F9E:  {0x1F2E, 0x0345},
+	0x1F9F:  {0x1F2F, 0x0345},
+	0x1FA0:  {0x1F60, 0x0345},
+	0x1FA1:  {0x1F61, 0x0345},
+	0x1FA2:  {0x1F62, 0x0345},
+	0x1FA3:  {0x1F63, 0x0345},
+	0x1FA4:  {0x1F64, 0x0345},
+	0x1FA5:  {0x1F65, 0x0345},
+	0x1FA6:  {0x1F66, 0x0345},
+	0x1FA7:  {0x1F67, 0x0345},
+	0x1FA8:  {0x1F68, 0x0345},
+	0x1FA9:  {0x1F69, 0x0345},
+	0x1FAA:  {0x1F6A, 0x0345},
+	0x1FAB:  {0x1F6B, 0x0345},
+	0x1FAC:  {0x1F6C, 0x0345},
+	0x1FAD:  {0x1F6D, 0x0345},
+	0x1FAE:  {0x1F6E, 0x0345},
+	0x1FAF:  {0x1F6F, 0x0345},
+	0x1FB0:  {0x03B1, 0x0306},
+	0x1FB1:  {0x03B1, 0x0304},
+	0x1FB2:  {0x1F70, 0x0345},
+	0x1FB3:  {0x03B1, 0x0345},
+	0x1FB4:  {0x03AC, 0x0345},
+	0x1FB6:  {0x03B1, 0x0342},
+	0x1FB7:  {0x1FB6, 0x0345},
+	0x1FB8:  {0x0391, 0x0306},
+	0x1FB9:  {0x0391, 0x0304},
+	0x1FBA:  {0x0391, 0x0300},
+	0x1FBB:  {0x0386, 0x0000},
+	0x1FBC:  {0x0391, 0x0345},
+	0x1FBE:  {0x03B9, 0x0000},
+	0x1FC1:  {0x00A8, 0x0342},
+	0x1FC2:  {0x1F74, 0x0345},
+	0x1FC3:  {0x03B7, 0x0345},
+	0x1FC4:  {0x03AE, 0x0345},
+	0x1FC6:  {0x03B7, 0x0342},
+	0x1FC7:  {0x1FC6, 0x0345},
+	0x1FC8:  {0x0395, 0x0300},
+	0x1FC9:  {0x0388, 0x0000},
+	0x1FCA:  {0x0397, 0x0300},
+	0x1FCB:  {0x0389, 0x0000},
+	0x1FCC:  {0x0397, 0x0345},
+	0x1FCD:  {0x1FBF, 0x0300},
+	0x1FCE:  {0x1FBF, 0x0301},
+	0x1FCF:  {0x1FBF, 0x0342},
+	0x1FD0:  {0x03B9, 0x0306},
+	0x1FD1:  {0x03B9, 0x0304},
+	0x1FD2:  {0x03CA, 0x0300},
+	0x1FD3:  {0x0390, 0x0000},
+	0x1FD6:  {0x03B9, 0x0342},
+	0x1FD7:  {0x03CA, 0x0342},
+	0x1FD8:  {0x0399, 0x0306},
+	0x1FD9:  {0x0399, 0x0304},
+	0x1FDA:  {0x0399, 0x0300},
+	0x1FDB:  {0x038A, 0x0000},
+	0x1FDD:  {0x1FFE, 0x0300},
+	0x1FDE:  {0x1FFE, 0x0301},
+	0x1FDF:  {0x1FFE, 0x0342},
+	0x1FE0:  {0x03C5, 0x0306},
+	0x1FE1:  {0x03C5, 0x0304},
+	0x1FE2:  {0x03CB, 0x0300},
+	0x1FE3:  {0x03B0, 0x0000},
+	0x1FE4:  {0x03C1, 0x0313},
+	0x1FE5:  {0x03C1, 0x0314},
+	0x1FE6:  {0x03C5, 0x0342},
+	0x1FE7:  {0x03CB, 0x0342},
+	0x1FE8:  {0x03A5, 0x0306},
+	0x1FE9:  {0x03A5, 0x0304},
+	0x1FEA:  {0x03A5, 0x0300},
+	0x1FEB:  {0x038E, 0x0000},
+	0x1FEC:  {0x03A1, 0x0314},
+	0x1FED:  {0x00A8, 0x0300},
+	0x1FEE:  {0x0385, 0x0000},
+	0x1FEF:  {0x0060, 0x0000},
+	0x1FF2:  {0x1F7C, 0x0345},
+	0x1FF3:  {0x03C9, 0x0345},
+	0x1FF4:  {0x03CE, 0x0345},
+	0x1FF6:  {0x03C9, 0x0342},
+	0x1FF7:  {0x1FF6, 0x0345},
+	0x1FF8:  {0x039F, 0x0300},
+	0x1FF9:  {0x038C, 0x0000},
+	0x1FFA:  {0x03A9, 0x0300},
+	0x1FFB:  {0x038F, 0x0000},
+	0x1FFC:  {0x03A9, 0x0345},
+	0x1FFD:  {0x00B4, 0x0000},
+	0x2000:  {0x2002, 0x0000},
+	0x2001:  {0x2003, 0x0000},
+	0x2126:  {0x03A9, 0x0000},
+	0x212A:  {0x004B, 0x0000},
+	0x212B:  {0x00C5, 0x0000},
+	0x219A:  {0x2190, 0x0338},
+	0x219B:  {0x2192, 0x0338},
+	0x21AE:  {0x2194, 0x0338},
+	0x21CD:  {0x21D0, 0x0338},
+	0x21CE:  {0x21D4, 0x0338},
+	0x21CF:  {0x21D2, 0x0338},
+	0x2204:  {0x2203, 0x0338},
+	0x2209:  {0x2208, 0x0338},
+	0x220C:  {0x220B, 0x0338},
+	0x2224:  {0x2223, 0x0338},
+	0x2226:  {0x2225, 0x0338},
+	0x2241:  {0x223C, 0x0338},
+	0x2244:  {0x2243, 0x0338},
+	0x2247:  {0x2245, 0x0338},
+	0x2249:  {0x2248, 0x0338},
+	0x2260:  {0x003D, 0x0338},
+	0x2262:  {0x2261, 0x0338},
+	0x226D:  {0x224D, 0x0338},
+	0x226E:  {0x003C, 0x0338},
+	0x226F:  {0x003E, 0x0338},
+	0x2270:  {0x2264, 0x0338},
+	0x2271:  {0x2265, 0x0338},
+	0x2274:  {0x2272, 0x0338},
+	0x2275:  {0x2273, 0x0338},
+	0x2278:  {0x2276, 0x0338},
+	0x2279:  {0x2277, 0x0338},
+	0x2280:  {0x227A, 0x0338},
+	0x2281:  {0x227B, 0x0338},
+	0x2284:  {0x2282, 0x0338},
+	0x2285:  {0x2283, 0x0338},
+	0x2288:  {0x2286, 0x0338},
+	0x2289:  {0x2287, 0x0338},
+	0x22AC:  {0x22A2, 0x0338},
+	0x22AD:  {0x22A8, 0x0338},
+	0x22AE:  {0x22A9, 0x0338},
+	0x22AF:  {0x22AB, 0x0338},
+	0x22E0:  {0x227C, 0x0338},
+	0x22E1:  {0x227D, 0x0338},
+	0x22E2:  {0x2291, 0x0338},
+	0x22E3:  {0x2292, 0x0338},
+	0x22EA:  {0x22B2, 0x0338},
+	0x22EB:  {0x22B3, 0x0338},
+	0x22EC:  {0x22B4, 0x0338},
+	0x22ED:  {0x22B5, 0x0338},
+	0x2329:  {0x3008, 0x0000},
+	0x232A:  {0x3009, 0x0000},
+	0x2ADC:  {0x2ADD, 0x0338},
+	0x304C:  {0x304B, 0x3099},
+	0x304E:  {0x304D, 0x3099},
+	0x3050:  {0x304F, 0x3099},
+	0x3052:  {0x3051, 0x3099},
+	0x3054:  {0x3053, 0x3099},
+	0x3056:  {0x3055, 0x3099},
+	0x3058:  {0x3057, 0x3099},
+	0x305A:  {0x3059, 0x3099},
+	0x305C:  {0x305B, 0x3099},
+	0x305E:  {0x305D, 0x3099},
+	0x3060:  {0x305F, 0x3099},
+	0x3062:  {0x3061, 0x3099},
+	0x3065:  {0x3064, 0x3099},
+	0x3067:  {0x3066, 0x3099},
+	0x3069:  {0x3068, 0x3099},
+	0x3070:  {0x306F, 0x3099},
+	0x3071:  {0x306F, 0x309A},
+	0x3073:  {0x3072, 0x3099},
+	0x3074:  {0x3072, 0x309A},
+	0x3076:  {0x3075, 0x3099},
+	0x3077:  {0x3075, 0x309A},
+	0x3079:  {0x3078, 0x3099},
+	0x307A:  {0x3078, 0x309A},
+	0x307C:  {0x307B, 0x3099},
+	0x307D:  {0x307B, 0x309A},
+	0x3094:  {0x3046, 0x3099},
+	0x309E:  {0x309D, 0x3099},
+	0x30AC:  {0x30AB, 0x3099},
+	0x30AE:  {0x30AD, 0x3099},
+	0x30B0:  {0x30AF, 0x3099},
+	0x30B2:  {0x30B1, 0x3099},
+	0x30B4:  {0x30B3, 0x3099},
+	0x30B6:  {0x30B5, 0x3099},
+	0x30B8:  {0x30B7, 0x3099},
+	0x30BA:  {0x30B9, 0x3099},
+	0x30BC:  {0x30BB, 0x3099},
+	0x30BE:  {0x30BD, 0x3099},
+	0x30C0:  {0x30BF, 0x3099},
+	0x30C2:  {0x30C1, 0x3099},
+	0x30C5:  {0x30C4, 0x3099},
+	0x30C7:  {0x30C6, 0x3099},
+	0x30C9:  {0x30C8, 0x3099},
+	0x30D0:  {0x30CF, 0x3099},
+	0x30D1:  {0x30CF, 0x309A},
+	0x30D3:  {0x30D2, 0x3099},
+	0x30D4:  {0x30D2, 0x309A},
+	0x30D6:  {0x30D5, 0x3099},
+	0x30D7:  {0x30D5, 0x309A},
+	0x30D9:  {0x30D8, 0x3099},
+	0x30DA:  {0x30D8, 0x309A},
+	0x30DC:  {0x30DB, 0x3099},
+	0x30DD:  {0x30DB, 0x309A},
+	0x30F4:  {0x30A6, 0x3099},
+	0x30F7:  {0x30EF, 0x3099},
+	0x30F8:  {0x30F0, 0x3099},
+	0x30F9:  {0x30F1, 0x3099},
+	0x30FA:  {0x30F2, 0x3099},
+	0x30FE:  {0x30FD, 0x3099},
+	0xF900:  {0x8C48, 0x0000},
+	0xF901:  {0x66F4, 0x0000},
+	0xF902:  {0x8ECA, 0x0000},
+	0xF903:  {0x8CC8, 0x0000},
+	0xF904:  {0x6ED1, 0x0000},
+	0xF905:  {0x4E32, 0x0000},
+	0xF906:  {0x53E5, 0x0000},
+	0xF907:  {0x9F9C, 0x0000},
+	0xF908:  {0x9F9C, 0x0000},
+	0xF909:  {0x5951, 0x0000},
+	0xF90A:  {0x91D1, 0x0000},
+	0xF90B:  {0x5587, 0x0000},
+	0xF90C:  {0x5948, 0x0000},
+	0xF90D:  {0x61F6, 0x0000},
+	0xF90E:  {0x7669, 0x0000},
+	0xF90F:  {0x7F85, 0x0000},
+	0xF910:  {0x863F, 0x0000},
+	0xF911:  {0x87BA, 0x0000},
+	0xF912:  {0x88F8, 0x0000},
+	0xF913:  {0x908F, 0x0000},
+	0xF914:  {0x6A02, 0x0000},
+	0xF915:  {0x6D1B, 0x0000},
+	0xF916:  {0x70D9, 0x0000},
+	0xF917:  {0x73DE, 0x0000},
+	0xF918:  {0x843D, 0x0000},
+	0xF919:  {0x916A, 0x0000},
+	0xF91A:  {0x99F1, 0x0000},
+	0xF91B:  {0x4E82, 0x0000},
+	0xF91C:  {0x5375, 0x0000},
+	0xF91D:  {0x6B04, 0x0000},
+	0xF91E:  {0x721B, 0x0000},
+	0xF91F:  {0x862D, 0x0000},
+	0xF920:  {0x9E1E, 0x0000},
+	0xF921:  {0x5D50, 0x0000},
+	0xF922:  {0x6FEB, 0x0000},
+	0xF923:  {0x85CD, 0x0000},
+	0xF924:  {0x8964, 0x0000},
+	0xF925:  {0x62C9, 0x0000},
+	0xF926:  {0x81D8, 0x0000},
+	0xF927:  {0x881F, 0x0000},
+	0xF928:  {0x5ECA, 0x0000},
+	0xF929:  {0x6717, 0x0000},
+	0xF92A:  {0x6D6A, 0x0000},
+	0xF92B:  {0x72FC, 0x0000},
+	0xF92C:  {0x90CE, 0x0000},
+	0xF92D:  {0x4F86, 0x0000},
+	0xF92E:  {0x51B7, 0x0000},
+	0xF92F:  {0x52DE, 0x0000},
+	0xF930:  {0x64C4, 0x0000},
+	0xF931:  {0x6AD3, 0x0000},
+	0xF932:  {0x7210, 0x0000},
+	0xF933:  {0x76E7, 0x0000},
+	0xF934:  {0x8001, 0x0000},
+	0xF935:  {0x8606, 0x0000},
+	0xF936:  {0x865C, 0x0000},
+	0xF937:  {0x8DEF, 0x0000},
+	0xF938:  {0x9732, 0x0000},
+	0xF939:  {0x9B6F, 0x0000},
+	0xF93A:  {0x9DFA, 0x0000},
+	0xF93B:  {0x788C, 0x0000},
+	0xF93C:  {0x797F, 0x0000},
+	0xF93D:  {0x7DA0, 0x0000},
+	0xF93E:  {0x83C9, 0x0000},
+	0xF93F:  {0x9304, 0x0000},
+	0xF940:  {0x9E7F, 0x0000},
+	0xF941:  {0x8AD6, 0x0000},
+	0xF942:  {0x58DF, 0x0000},
+	0xF943:  {0x5F04, 0x0000},
+	0xF944:  {0x7C60, 0x0000},
+	0xF945:  {0x807E, 0x0000},
+	0xF946:  {0x7262, 0x0000},
+	0xF947:  {0x78CA, 0x0000},
+	0xF948:  {0x8CC2, 0x0000},
+	0xF949:  {0x96F7, 0x0000},
+	0xF94A:  {0x58D8, 0x0000},
+	0xF94B:  {0x5C62, 0x0000},
+	0xF94C:  {0x6A13, 0x0000},
+	0xF94D:  {0x6DDA, 0x0000},
+	0xF94E:  {0x6F0F, 0x0000},
+	0xF94F:  {0x7D2F, 0x0000},
+	0xF950:  {0x7E37, 0x0000},
+	0xF951:  {0x964B, 0x0000},
+	0xF952:  {0x52D2, 0x0000},
+	0xF953:  {0x808B, 0x0000},
+	0xF954:  {0x51DC, 0x0000},
+	0xF955:  {0x51CC, 0x0000},
+	0xF956:  {0x7A1C, 0x0000},
+	0xF957:  {0x7DBE, 0x0000},
+	0xF958:  {0x83F1, 0x0000},
+	0xF959:  {0x9675, 0x0000},
+	0xF95A:  {0x8B80, 0x0000},
+	0xF95B:  {0x62CF, 0x0000},
+	0xF95C:  {0x6A02, 0x0000},
+	0xF95D:  {0x8AFE, 0x0000},
+	0xF95E:  {0x4E39, 0x0000},
+	0xF95F:  {0x5BE7, 0x0000},
+	0xF960:  {0x6012, 0x0000},
+	0xF961:  {0x7387, 0x0000},
+	0xF962:  {0x7570, 0x0000},
+	0xF963:  {0x5317, 0x0000},
+	0xF964:  {0x78FB, 0x0000},
+	0xF965:  {0x4FBF, 0x0000},
+	0xF966:  {0x5FA9, 0x0000},
+	0xF967:  {0x4E0D, 0x0000},
+	0xF968:  {0x6CCC, 0x0000},
+	0xF969:  {0x6578, 0x0000},
+	0xF96A:  {0x7D22, 0x0000},
+	0xF96B:  {0x53C3, 0x0000},
+	0xF96C:  {0x585E, 0x0000},
+	0xF96D:  {0x7701, 0x0000},
+	0xF96E:  {0x8449, 0x0000},
+	0xF96F:  {0x8AAA, 0x0000},
+	0xF970:  {0x6BBA, 0x0000},
+	0xF971:  {0x8FB0, 0x0000},
+	0xF972:  {0x6C88, 0x0000},
+	0xF973:  {0x62FE, 0x0000},
+	0xF974:  {0x82E5, 0x0000},
+	0xF975:  {0x63A0, 0x0000},
+	0xF976:  {0x7565, 0x0000},
+	0xF977:  {0x4EAE, 0x0000},
+	0xF978:  {0x5169, 0x0000},
+	0xF979:  {0x51C9, 0x0000},
+	0xF97A:  {0x6881, 0x0000},
+	0xF97B:  {0x7CE7, 0x0000},
+	0xF97C:  {0x826F, 0x0000},
+	0xF97D:  {0x8AD2, 0x0000},
+	0xF97E:  {0x91CF, 0x0000},
+	0xF97F:  {0x52F5, 0x0000},
+	0xF980:  {0x5442, 0x0000},
+	0xF981:  {0x5973, 0x0000},
+	0xF982:  {0x5EEC, 0x0000},
+	0xF983:  {0x65C5, 0x0000},
+	0xF984:  {0x6FFE, 0x0000},
+	0xF985:  {0x792A, 0x0000},
+	0xF986:  {0x95AD, 0x0000},
+	0xF987:  {0x9A6A, 0x0000},
+	0xF988:  {0x9E97, 0x0000},
+	0xF989:  {0x9ECE, 0x0000},
+	0xF98A:  {0x529B, 0x0000},
+	0xF98B:  {0x66C6, 0x0000},
+	0xF98C:  {0x6B77, 0x0000},
+	0xF98D:  {0x8F62, 0x0000},
+	0xF98E:  {0x5E74, 0x0000},
+	0xF98F:  {0x6190, 0x0000},
+	0xF990:  {0x6200, 0x0000},
+	0xF991:  {0x649A, 0x0000},
+	0xF992:  {0x6F23, 0x0000},
+	0xF993:  {0x7149, 0x0000},
+	0xF994:  {0x7489, 0x0000},
+	0xF995:  {0x79CA, 0x0000},
+	0xF996:  {0x7DF4, 0x0000},
+	0xF997:  {0x806F, 0x0000},
+	0xF998:  {0x8F26, 0x0000},
+	0xF999:  {0x84EE, 0x0000},
+	0xF99A:  {0x9023, 0x0000},
+	0xF99B:  {0x934A, 0x0000},
+	0xF99C:  {0x5217, 0x0000},
+	0xF99D:  {0x52A3, 0x0000},
+	0xF99E:  {0x54BD, 0x0000},
+	0xF99F:  {0x70C8, 0x0000},
+	0xF9A0:  {0x88C2, 0x0000},
+	0xF9A1:  {0x8AAA, 0x0000},
+	0xF9A2:  {0x5EC9, 0x0000},
+	0xF9A3:  {0x5FF5, 0x0000},
+	0xF9A4:  {0x637B, 0x0000},
+	0xF9A5:  {0x6BAE, 0x0000},
+	0xF9A6:  {0x7C3E, 0x0000},
+	0xF9A7:  {0x7375, 0x0000},
+	0xF9A8:  {0x4EE4, 0x0000},
+	0xF9A9:  {0x56F9, 0x0000},
+	0xF9AA:  {0x5BE7, 0x0000},
+	0xF9AB:  {0x5DBA, 0x0000},
+	0xF9AC:  {0x601C, 0x0000},
+	0xF9AD:  {0x73B2, 0x0000},
+	0xF9AE:  {0x7469, 0x0000},
+	0xF9AF:  {0x7F9A, 0x0000},
+	0xF9B0:  {0x8046, 0x0000},
+	0xF9B1:  {0x9234, 0x0000},
+	0xF9B2:  {0x96F6, 0x0000},
+	0xF9B3:  {0x9748, 0x0000},
+	0xF9B4:  {0x9818, 0x0000},
+	0xF9B5:  {0x4F8B, 0x0000},
+	0xF9B6:  {0x79AE, 0x0000},
+	0xF9B7:  {0x91B4, 0x0000},
+	0xF9B8:  {0x96B8, 0x0000},
+	0xF9B9:  {0x60E1, 0x0000},
+	0xF9BA:  {0x4E86, 0x0000},
+	0xF9BB:  {0x50DA, 0x0000},
+	0xF9BC:  {0x5BEE, 0x0000},
+	0xF9BD:  {0x5C3F, 0x0000},
+	0xF9BE:  {0x6599, 0x0000},
+	0xF9BF:  {0x6A02, 0x0000},
+	0xF9C0:  {0x71CE, 0x0000},
+	0xF9C1:  {0x7642, 0x0000},
+	0xF9C2:  {0x84FC, 0x0000},
+	0xF9C3:  {0x907C, 0x0000},
+	0xF9C4:  {0x9F8D, 0x0000},
+	0xF9C5:  {0x6688, 0x0000},
+	0xF9C6:  {0x962E, 0x0000},
+	0xF9C7:  {0x5289, 0x0000},
+	0xF9C8:  {0x677B, 0x0000},
+	0xF9C9:  {0x67F3, 0x0000},
+	0xF9CA:  {0x6D41, 0x0000},
+	0xF9CB:  {0x6E9C, 0x0000},
+	0xF9CC:  {0x7409, 0x0000},
+	0xF9CD:  {0x7559, 0x0000},
+	0xF9CE:  {0x786B, 0x0000},
+	0xF9CF:  {0x7D10, 0x0000},
+	0xF9D0:  {0x985E, 0x0000},
+	0xF9D1:  {0x516D, 0x0000},
+	0xF9D2:  {0x622E, 0x0000},
+	0xF9D3:  {0x9678, 0x0000},
+	0xF9D4:  {0x502B, 0x0000},
+	0xF9D5:  {0x5D19, 0x0000},
+	0xF9D6:  {0x6DEA, 0x0000},
+	0xF9D7:  {0x8F2A, 0x0000},
+	0xF9D8:  {0x5F8B, 0x0000},
+	0xF9D9:  {0x6144, 0x0000},
+	0xF9DA:  {0x6817, 0x0000},
+	0xF9DB:  {0x7387, 0x0000},
+	0xF9DC:  {0x9686, 0x0000},
+	0xF9DD:  {0x5229, 0x0000},
+	0xF9DE:  {0x540F, 0x0000},
+	0xF9DF:  {0x5C65, 0x0000},
+	0xF9E0:  {0x6613, 0x0000},
+	0xF9E1:  {0x674E, 0x0000},
+	0xF9E2:  {0x68A8, 0x0000},
+	0xF9E3:  {0x6CE5, 0x0000},
+	0xF9E4:  {0x7406, 0x0000},
+	0xF9E5:  {0x75E2, 0x0000},
+	0xF9E6:  {0x7F79, 0x0000},
+	0xF9E7:  {0x88CF, 0x0000},
+	0xF9E8:  {0x88E1, 0x0000},
+	0xF9E9:  {0x91CC, 0x0000},
+	0xF9EA:  {0x96E2, 0x0000},
+	0xF9EB:  {0x533F, 0x0000},
+	0xF9EC:  {0x6EBA, 0x0000},
+	0xF9ED:  {0x541D, 0x0000},
+	0xF9EE:  {0x71D0, 0x0000},
+	0xF9EF:  {0x7498, 0x0000},
+	0xF9F0:  {0x85FA, 0x0000},
+	0xF9F1:  {0x96A3, 0x0000},
+	0xF9F2:  {0x9C57, 0x0000},
+	0xF9F3:  {0x9E9F, 0x0000},
+	0xF9F4:  {0x6797, 0x0000},
+	0xF9F5:  {0x6DCB, 0x0000},
+	0xF9F6:  {0x81E8, 0x0000},
+	0xF9F7:  {0x7ACB, 0x0000},
+	0xF9F8:  {0x7B20, 0x0000},
+	0xF9F9:  {0x7C92, 0x0000},
+	0xF9FA:  {0x72C0, 0x0000},
+	0xF9FB:  {0x7099, 0x0000},
+	0xF9FC:  {0x8B58, 0x0000},
+	0xF9FD:  {0x4EC0, 0x0000},
+	0xF9FE:  {0x8336, 0x0000},
+	0xF9FF:  {0x523A, 0x0000},
+	0xFA00:  {0x5207, 0x0000},
+	0xFA01:  {0x5EA6, 0x0000},
+	0xFA02:  {0x62D3, 0x0000},
+	0xFA03:  {0x7CD6, 0x0000},
+	0xFA04:  {0x5B85, 0x0000},
+	0xFA05:  {0x6D1E, 0x0000},
+	0xFA06:  {0x66B4, 0x0000},
+	0xFA07:  {0x8F3B, 0x0000},
+	0xFA08:  {0x884C, 0x0000},
+	0xFA09:  {0x964D, 0x0000},
+	0xFA0A:  {0x898B, 0x0000},
+	0xFA0B:  {0x5ED3, 0x0000},
+	0xFA0C:  {0x5140, 0x0000},
+	0xFA0D:  {0x55C0, 0x0000},
+	0xFA10:  {0x585A, 0x0000},
+	0xFA12:  {0x6674, 0x0000},
+	0xFA15:  {0x51DE, 0x0000},
+	0xFA16:  {0x732A, 0x0000},
+	0xFA17:  {0x76CA, 0x0000},
+	0xFA18:  {0x793C, 0x0000},
+	0xFA19:  {0x795E, 0x0000},
+	0xFA1A:  {0x7965, 0x0000},
+	0xFA1B:  {0x798F, 0x0000},
+	0xFA1C:  {0x9756, 0x0000},
+	0xFA1D:  {0x7CBE, 0x0000},
+	0xFA1E:  {0x7FBD, 0x0000},
+	0xFA20:  {0x8612, 0x0000},
+	0xFA22:  {0x8AF8, 0x0000},
+	0xFA25:  {0x9038, 0x0000},
+	0xFA26:  {0x90FD, 0x0000},
+	0xFA2A:  {0x98EF, 0x0000},
+	0xFA2B:  {0x98FC, 0x0000},
+	0xFA2C:  {0x9928, 0x0000},
+	0xFA2D:  {0x9DB4, 0x0000},
+	0xFA2E:  {0x90DE, 0x0000},
+	0xFA2F:  {0x96B7, 0x0000},
+	0xFA30:  {0x4FAE, 0x0000},
+	0xFA31:  {0x50E7, 0x0000},
+	0xFA32:  {0x514D, 0x0000},
+	0xFA33:  {0x52C9, 0x0000},
+	0xFA34:  {0x52E4, 0x0000},
+	0xFA35:  {0x5351, 0x0000},
+	0xFA36:  {0x559D, 0x0000},
+	0xFA37:  {0x5606, 0x0000},
+	0xFA38:  {0x5668, 0x0000},
+	0xFA39:  {0x5840, 0x0000},
+	0xFA3A:  {0x58A8, 0x0000},
+	0xFA3B:  {0x5C64, 0x0000},
+	0xFA3C:  {0x5C6E, 0x0000},
+	0xFA3D:  {0x6094, 0x0000},
+	0xFA3E:  {0x6168, 0x0000},
+	0xFA3F:  {0x618E, 0x0000},
+	0xFA40:  {0x61F2, 0x0000},
+	0xFA41:  {0x654F, 0x0000},
+	0xFA42:  {0x65E2, 0x0000},
+	0xFA43:  {0x6691, 0x0000},
+	0xFA44:  {0x6885, 0x0000},
+	0xFA45:  {0x6D77, 0x0000},
+	0xFA46:  {0x6E1A, 0x0000},
+	0xFA47:  {0x6F22, 0x0000},
+	0xFA48:  {0x716E, 0x0000},
+	0xFA49:  {0x722B, 0x0000},
+	0xFA4A:  {0x7422, 0x0000},
+	0xFA4B:  {0x7891, 0x0000},
+	0xFA4C:  {0x793E, 0x0000},
+	0xFA4D:  {0x7949, 0x0000},
+	0xFA4E:  {0x7948, 0x0000},
+	0xFA4F:  {0x7950, 0x0000},
+	0xFA50:  {0x7956, 0x0000},
+	0xFA51:  {0x795D, 0x0000},
+	0xFA52:  {0x798D, 0x0000},
+	0xFA53:  {0x798E, 0x0000},
+	0xFA54:  {0x7A40, 0x0000},
+	0xFA55:  {0x7A81, 0x0000},
+	0xFA56:  {0x7BC0, 0x0000},
+	0xFA57:  {0x7DF4, 0x0000},
+	0xFA58:  {0x7E09, 0x0000},
+	0xFA59:  {0x7E41, 0x0000},
+	0xFA5A:  {0x7F72, 0x0000},
+	0xFA5B:  {0x8005, 0x0000},
+	0xFA5C:  {0x81ED, 0x0000},
+	0xFA5D:  {0x8279, 0x0000},
+	0xFA5E:  {0x8279, 0x0000},
+	0xFA5F:  {0x8457, 0x0000},
+	0xFA60:  {0x8910, 0x0000},
+	0xFA61:  {0x8996, 0x0000},
+	0xFA62:  {0x8B01, 0x0000},
+	0xFA63:  {0x8B39, 0x0000},
+	0xFA64:  {0x8CD3, 0x0000},
+	0xFA65:  {0x8D08, 0x0000},
+	0xFA66:  {0x8FB6, 0x0000},
+	0xFA67:  {0x9038, 0x0000},
+	0xFA68:  {0x96E3, 0x0000},
+	0xFA69:  {0x97FF, 0x0000},
+	0xFA6A:  {0x983B, 0x0000},
+	0xFA6B:  {0x6075, 0x0000},
+	0xFA6C:  {0x242EE, 0x0000},
+	0xFA6D:  {0x8218, 0x0000},
+	0xFA70:  {0x4E26, 0x0000},
+	0xFA71:  {0x51B5, 0x0000},
+	0xFA72:  {0x5168, 0x0000},
+	0xFA73:  {0x4F80, 0x0000},
+	0xFA74:  {0x5145, 0x0000},
+	0xFA75:  {0x5180, 0x0000},
+	0xFA76:  {0x52C7, 0x0000},
+	0xFA77:  {0x52FA, 0x0000},
+	0xFA78:  {0x559D, 0x0000},
+	0xFA79:  {0x5555, 0x0000},
+	0xFA7A:  {0x5599, 0x0000},
+	0xFA7B:  {0x55E2, 0x0000},
+	0xFA7C:  {0x585A, 0x0000},
+	0xFA7D:  {0x58B3, 0x0000},
+	0xFA7E:  {0x5944, 0x0000},
+	0xFA7F:  {0x5954, 0x0000},
+	0xFA80:  {0x5A62, 0x0000},
+	0xFA81:  {0x5B28, 0x0000},
+	0xFA82:  {0x5ED2, 0x0000},
+	0xFA83:  {0x5ED9, 0x0000},
+	0xFA84:  {0x5F69, 0x0000},
+	0xFA85:  {0x5FAD, 0x0000},
+	0xFA86:  {0x60D8, 0x0000},
+	0xFA87:  {0x614E, 0x0000},
+	0xFA88:  {0x6108, 0x0000},
+	0xFA89:  {0x618E, 0x0000},
+	0xFA8A:  {0x6160, 0x0000},
+	0xFA8B:  {0x61F2, 0x0000},
+	0xFA8C:  {0x6234, 0x0000},
+	0xFA8D:  {0x63C4, 0x0000},
+	0xFA8E:  {0x641C, 0x0000},
+	0xFA8F:  {0x6452, 0x0000},
+	0xFA90:  {0x6556, 0x0000},
+	0xFA91:  {0x6674, 0x0000},
+	0xFA92:  {0x6717, 0x0000},
+	0xFA93:  {0x671B, 0x0000},
+	0xFA94:  {0x6756, 0x0000},
+	0xFA95:  {0x6B79, 0x0000},
+	0xFA96:  {0x6BBA, 0x0000},
+	0xFA97:  {0x6D41, 0x0000},
+	0xFA98:  {0x6EDB, 0x0000},
+	0xFA99:  {0x6ECB, 0x0000},
+	0xFA9A:  {0x6F22, 0x0000},
+	0xFA9B:  {0x701E, 0x0000},
+	0xFA9C:  {0x716E, 0x0000},
+	0xFA9D:  {0x77A7, 0x0000},
+	0xFA9E:  {0x7235, 0x0000},
+	0xFA9F:  {0x72AF, 0x0000},
+	0xFAA0:  {0x732A, 0x0000},
+	0xFAA1:  {0x7471, 0x0000},
+	0xFAA2:  {0x7506, 0x0000},
+	0xFAA3:  {0x753B, 0x0000},
+	0xFAA4:  {0x761D, 0x0000},
+	0xFAA5:  {0x761F, 0x0000},
+	0xFAA6:  {0x76CA, 0x0000},
+	0xFAA7:  {0x76DB, 0x0000},
+	0xFAA8:  {0x76F4, 0x0000},
+	0xFAA9:  {0x774A, 0x0000},
+	0xFAAA:  {0x7740, 0x0000},
+	0xFAAB:  {0x78CC, 0x0000},
+	0xFAAC:  {0x7AB1, 0x0000},
+	0xFAAD:  {0x7BC0, 0x0000},
+	0xFAAE:  {0x7C7B, 0x0000},
+	0xFAAF:  {0x7D5B, 0x0000},
+	0xFAB0:  {0x7DF4, 0x0000},
+	0xFAB1:  {0x7F3E, 0x0000},
+	0xFAB2:  {0x8005, 0x0000},
+	0xFAB3:  {0x8352, 0x0000},
+	0xFAB4:  {0x83EF, 0x0000},
+	0xFAB5:  {0x8779, 0x0000},
+	0xFAB6:  {0x8941, 0x0000},
+	0xFAB7:  {0x8986, 0x0000},
+	0xFAB8:  {0x8996, 0x0000},
+	0xFAB9:  {0x8ABF, 0x0000},
+	0xFABA:  {0x8AF8, 0x0000},
+	0xFABB:  {0x8ACB, 0x0000},
+	0xFABC:  {0x8B01, 0x0000},
+	0xFABD:  {0x8AFE, 0x0000},
+	0xFABE:  {0x8AED, 0x0000},
+	0xFABF:  {0x8B39, 0x0000},
+	0xFAC0:  {0x8B8A, 0x0000},
+	0xFAC1:  {0x8D08, 0x0000},
+	0xFAC2:  {0x8F38, 0x0000},
+	0xFAC3:  {0x9072, 0x0000},
+	0xFAC4:  {0x9199, 0x0000},
+	0xFAC5:  {0x9276, 0x0000},
+	0xFAC6:  {0x967C, 0x0000},
+	0xFAC7:  {0x96E3, 0x0000},
+	0xFAC8:  {0x9756, 0x0000},
+	0xFAC9:  {0x97DB, 0x0000},
+	0xFACA:  {0x97FF, 0x0000},
+	0xFACB:  {0x980B, 0x0000},
+	0xFACC:  {0x983B, 0x0000},
+	0xFACD:  {0x9B12, 0x0000},
+	0xFACE:  {0x9F9C, 0x0000},
+	0xFACF:  {0x2284A, 0x0000},
+	0xFAD0:  {0x22844, 0x0000},
+	0xFAD1:  {0x233D5, 0x0000},
+	0xFAD2:  {0x3B9D, 0x0000},
+	0xFAD3:  {0x4018, 0x0000},
+	0xFAD4:  {0x4039, 0x0000},
+	0xFAD5:  {0x25249, 0x0000},
+	0xFAD6:  {0x25CD0, 0x0000},
+	0xFAD7:  {0x27ED3, 0x0000},
+	0xFAD8:  {0x9F43, 0x0000},
+	0xFAD9:  {0x9F8E, 0x0000},
+	0xFB1D:  {0x05D9, 0x05B4},
+	0xFB1F:  {0x05F2, 0x05B7},
+	0xFB2A:  {0x05E9, 0x05C1},
+	0xFB2B:  {0x05E9, 0x05C2},
+	0xFB2C:  {0xFB49, 0x05C1},
+	0xFB2D:  {0xFB49, 0x05C2},
+	0xFB2E:  {0x05D0, 0x05B7},
+	0xFB2F:  {0x05D0, 0x05B8},
+	0xFB30:  {0x05D0, 0x05BC},
+	0xFB31:  {0x05D1, 0x05BC},
+	0xFB32:  {0x05D2, 0x05BC},
+	0xFB33:  {0x05D3, 0x05BC},
+	0xFB34:  {0x05D4, 0x05BC},
+	0xFB35:  {0x05D5, 0x05BC},
+	0xFB36:  {0x05D6, 0x05BC},
+	0xFB38:  {0x05D8, 0x05BC},
+	0xFB39:  {0x05D9, 0x05BC},
+	0xFB3A:  {0x05DA, 0x05BC},
+	0xFB3B:  {0x05DB, 0x05BC},
+	0xFB3C:  {0x05DC, 0x05BC},
+	0xFB3E:  {0x05DE, 0x05BC},
+	0xFB40:  {0x05E0, 0x05BC},
+	0xFB41:  {0x05E1, 0x05BC},
+	0xFB43:  {0x05E3, 0x05BC},
+	0xFB44:  {0x05E4, 0x05BC},
+	0xFB46:  {0x05E6, 0x05BC},
+	0xFB47:  {0x05E7, 0x05BC},
+	0xFB48:  {0x05E8, 0x05BC},
+	0xFB49:  {0x05E9, 0x05BC},
+	0xFB4A:  {0x05EA, 0x05BC},
+	0xFB4B:  {0x05D5, 0x05B9},
+	0xFB4C:  {0x05D1, 0x05BF},
+	0xFB4D:  {0x05DB, 0x05BF},
+	0xFB4E:  {0x05E4, 0x05BF},
+	0x1109A: {0x11099, 0x110BA},
+	0x1109C: {0x1109B, 0x110BA},
+	0x110AB: {0x110A5, 0x110BA},
+	0x1112E: {0x11131, 0x11127},
+	0x1112F: {0x11132, 0x11127},
+	0x1134B: {0x11347, 0x1133E},
+	0x1134C: {0x11347, 0x11357},
+	0x114BB: {0x114B9, 0x114BA},
+	0x114BC: {0x114B9, 0x114B0},
+	0x114BE: {0x114B9, 0x114BD},
+	0x115BA: {0x115B8, 0x115AF},
+	0x115BB: {0x115B9, 0x115AF},
+	0x11938: {0x11935, 0x11930},
+	0x1D15E: {0x1D157, 0x1D165},
+	0x1D15F: {0x1D158, 0x1D165},
+	0x1D160: {0x1D15F, 0x1D16E},
+	0x1D161: {0x1D15F, 0x1D16F},
+	0x1D162: {0x1D15F, 0x1D170},
+	0x1D163: {0x1D15F, 0x1D171},
+	0x1D164: {0x1D15F, 0x1D172},
+	0x1D1BB: {0x1D1B9, 0x1D165},
+	0x1D1BC: {0x1D1BA, 0x1D165},
+	0x1D1BD: {0x1D1BB, 0x1D16E},
+	0x1D1BE: {0x1D1BC, 0x1D16E},
+	0x1D1BF: {0x1D1BB, 0x1D16F},
+	0x1D1C0: {0x1D1BC, 0x1D16F},
+	0x2F800: {0x4E3D, 0x0000},
+	0x2F801: {0x4E38, 0x0000},
+	0x2F802: {0x4E41, 0x0000},
+	0x2F803: {0x20122, 0x0000},
+	0x2F804: {0x4F60, 0x0000},
+	0x2F805: {0x4FAE, 0x0000},
+	0x2F806: {0x4FBB, 0x0000},
+	0x2F807: {0x5002, 0x0000},
+	0x2F808: {0x507A, 0x0000},
+	0x2F809: {0x5099, 0x0000},
+	0x2F80A: {0x50E7, 0x0000},
+	0x2F80B: {0x50CF, 0x0000},
+	0x2F80C: {0x349E, 0x0000},
+	0x2F80D: {0x2063A, 0x0000},
+	0x2F80E: {0x514D, 0x0000},
+	0x2F80F: {0x5154, 0x0000},
+	0x2F810: {0x5164, 0x0000},
+	0x2F811: {0x5177, 0x0000},
+	0x2F812: {0x2051C, 0x0000},
+	0x2F813: {0x34B9, 0x0000},
+	0x2F814: {0x5167, 0x0000},
+	0x2F815: {0x518D, 0x0000},
+	0x2F816: {0x2054B, 0x0000},
+	0x2F817: {0x5197, 0x0000},
+	0x2F818: {0x51A4, 0x0000},
+	0x2F819: {0x4ECC, 0x0000},
+	0x2F81A: {0x51AC, 0x0000},
+	0x2F81B: {0x51B5, 0x0000},
+	0x2F81C: {0x291DF, 0x0000},
+	0x2F81D: {0x51F5, 0x0000},
+	0x2F81E: {0x5203, 0x0000},
+	0x2F81F: {0x34DF, 0x0000},
+	0x2F820: {0x523B, 0x0000},
+	0x2F821: {0x5246, 0x0000},
+	0x2F822: {0x5272, 0x0000},
+	0x2F823: {0x5277, 0x0000},
+	0x2F824: {0x3515, 0x0000},
+	0x2F825: {0x52C7, 0x0000},
+	0x2F826: {0x52C9, 0x0000},
+	0x2F827: {0x52E4, 0x0000},
+	0x2F828: {0x52FA, 0x0000},
+	0x2F829: {0x5305, 0x0000},
+	0x2F82A: {0x5306, 0x0000},
+	0x2F82B: {0x5317, 0x0000},
+	0x2F82C: {0x5349, 0x0000},
+	0x2F82D: {0x5351, 0x0000},
+	0x2F82E: {0x535A, 0x0000},
+	0x2F82F: {0x5373, 0x0000},
+	0x2F830: {0x537D, 0x0000},
+	0x2F831: {0x537F, 0x0000},
+	0x2F832: {0x537F, 0x0000},
+	0x2F833: {0x537F, 0x0000},
+	0x2F834: {0x20A2C, 0x0000},
+	0x2F835: {0x7070, 0x0000},
+	0x2F836: {0x53CA, 0x0000},
+	0x2F837: {0x53DF, 0x0000},
+	0x2F838: {0x20B63, 0x0000},
+	0x2F839: {0x53EB, 0x0000},
+	0x2F83A: {0x53F1, 0x0000},
+	0x2F83B: {0x5406, 0x0000},
+	0x2F83C: {0x549E, 0x0000},
+	0x2F83D: {0x5438, 0x0000},
+	0x2F83E: {0x5448, 0x0000},
+	0x2F83F: {0x5468, 0x0000},
+	0x2F840: {0x54A2, 0x0000},
+	0x2F841: {0x54F6, 0x0000},
+	0x2F842: {0x5510, 0x0000},
+	0x2F843: {0x5553, 0x0000},
+	0x2F844: {0x5563, 0x0000},
+	0x2F845: {0x5584, 0x0000},
+	0x2F846: {0x5584, 0x0000},
+	0x2F847: {0x5599, 0x0000},
+	0x2F848: {0x55AB, 0x0000},
+	0x2F849: {0x55B3, 0x0000},
+	0x2F84A: {0x55C2, 0x0000},
+	0x2F84B: {0x5716, 0x0000},
+	0x2F84C: {0x5606, 0x0000},
+	0x2F84D: {0x5717, 0x0000},
+	0x2F84E: {0x5651, 0x0000},
+	0x2F84F: {0x5674, 0x0000},
+	0x2F850: {0x5207, 0x0000},
+	0x2F851: {0x58EE, 0x0000},
+	0x2F852: {0x57CE, 0x0000},
+	0x2F853: {0x57F4, 0x0000},
+	0x2F854: {0x580D, 0x0000},
+	0x2F855: {0x578B, 0x0000},
+	0x2F856: {0x5832, 0x0000},
+	0x2F857: {0x5831, 0x0000},
+	0x2F858: {0x58AC, 0x0000},
+	0x2F859: {0x214E4, 0x0000},
+	0x2F85A: {0x58F2, 0x0000},
+	0x2F85B: {0x58F7, 0x0000},
+	0x2F85C: {0x5906, 0x0000},
+	0x2F85D: {0x591A, 0x0000},
+	0x2F85E: {0x5922, 0x0000},
+	0x2F85F: {0x5962, 0x0000},
+	0x2F860: {0x216A8, 0x0000},
+	0x2F861: {0x216EA, 0x0000},
+	0x2F862: {0x59EC, 0x0000},
+	0x2F863: {0x5A1B, 0x0000},
+	0x2F864: {0x5A27, 0x0000},
+	0x2F865: {0x59D8, 0x0000},
+	0x2F866: {0x5A66, 0x0000},
+	0x2F867: {0x36EE, 0x0000},
+	0x2F868: {0x36FC, 0x0000},
+	0x2F869: {0x5B08, 0x0000},
+	0x2F86A: {0x5B3E, 0x0000},
+	0x2F86B: {0x5B3E, 0x0000},
+	0x2F86C: {0x219C8, 0x0000},
+	0x2F86D: {0x5BC3, 0x0000},
+	0x2F86E: {0x5BD8, 0x0000},
+	0x2F86F: {0x5BE7, 0x0000},
+	0x2F870: {0x5BF3, 0x0000},
+	0x2F871: {0x21B18, 0x0000},
+	0x2F872: {0x5BFF, 0x0000},
+	0x2F873: {0x5C06, 0x0000},
+	0x2F874: {0x5F53, 0x0000},
+	0x2F875: {0x5C22, 0x0000},
+	0x2F876: {0x3781, 0x0000},
+	0x2F877: {0x5C60, 0x0000},
+	0x2F878: {0x5C6E, 0x0000},
+	0x2F879: {0x5CC0, 0x0000},
+	0x2F87A: {0x5C8D, 0x0000},
+	0x2F87B: {0x21DE4, 0x0000},
+	0x2F87C: {0x5D43, 0x0000},
+	0x2F87D: {0x21DE6, 0x0000},
+	0x2F87E: {0x5D6E, 0x0000},
+	0x2F87F: {0x5D6B, 0x0000},
+	0x2F880: {0x5D7C, 0x0000},
+	0x2F881: {0x5DE1, 0x0000},
+	0x2F882: {0x5DE2, 0x0000},
+	0x2F883: {0x382F, 0x0000},
+	0x2F884: {0x5DFD, 0x0000},
+	0x2F885: {0x5E28, 0x0000},
+	0x2F886: {0x5E3D, 0x0000},
+	0x2F887: {0x5E69, 0x0000},
+	0x2F888: {0x3862, 0x0000},
+	0x2F889: {0x22183, 0x0000},
+	0x2F88A: {0x387C, 0x0000},
+	0x2F88B: {0x5EB0, 0x0000},
+	0x2F88C: {0x5EB3, 0x0000},
+	0x2F88D: {0x5EB6, 0x0000},
+	0x2F88E: {0x5ECA, 0x0000},
+	0x2F88F: {0x2A392, 0x0000},
+	0x2F890: {0x5EFE, 0x0000},
+	0x2F891: {0x22331, 0x0000},
+	0x2F892: {0x22331, 0x0000},
+	0x2F893: {0x8201, 0x0000},
+	0x2F894: {0x5F22, 0x0000},
+	0x2F895: {0x5F22, 0x0000},
+	0x2F896: {0x38C7, 0x0000},
+	0x2F897: {0x232B8, 0x0000},
+	0x2F898: {0x261DA, 0x0000},
+	0x2F899: {0x5F62, 0x0000},
+	0x2F89A: {0x5F6B, 0x0000},
+	0x2F89B: {0x38E3, 0x0000},
+	0x2F89C: {0x5F9A, 0x0000},
+	0x2F89D: {0x5FCD, 0x0000},
+	0x2F89E: {0x5FD7, 0x0000},
+	0x2F89F: {0x5FF9, 0x0000},
+	0x2F8A0: {0x6081, 0x0000},
+	0x2F8A1: {0x393A, 0x0000},
+	0x2F8A2: {0x391C, 0x0000},
+	0x2F8A3: {0x6094, 0x0000},
+	0x2F8A4: {0x226D4, 0x0000},
+	0x2F8A5: {0x60C7, 0x0000},
+	0x2F8A6: {0x6148, 0x0000},
+	0x2F8A7: {0x614C, 0x0000},
+	0x2F8A8: {0x614E, 0x0000},
+	0x2F8A9: {0x614C, 0x0000},
+	0x2F8AA: {0x617A, 0x0000},
+	0x2F8AB: {0x618E, 0x0000},
+	0x2F8AC: {0x61B2, 0x0000},
+	0x2F8AD: {0x61A4, 0x0000},
+	0x2F8AE: {0x61AF, 0x0000},
+	0x2F8AF: {0x61DE, 0x0000},
+	0x2F8B0: {0x61F2, 0x0000},
+	0x2F8B1: {0x61F6, 0x0000},
+	0x2F8B2: {0x6210, 0x0000},
+	0x2F8B3: {0x621B, 0x0000},
+	0x2F8B4: {0x625D, 0x0000},
+	0x2F8B5: {0x62B1, 0x0000},
+	0x2F8B6: {0x62D4, 0x0000},
+	0x2F8B7: {0x6350, 0x0000},
+	0x2F8B8: {0x22B0C, 0x0000},
+	0x2F8B9: {0x633D, 0x0000},
+	0x2F8BA: {0x62FC, 0x0000},
+	0x2F8BB: {0x6368, 0x0000},
+	0x2F8BC: {0x6383, 0x0000},
+	0x2F8BD: {0x63E4, 0x0000},
+	0x2F8BE: {0x22BF1, 0x0000},
+	0x2F8BF: {0x6422, 0x0000},
+	0x2F8C0: {0x63C5, 0x0000},
+	0x2F8C1: {0x63A9, 0x0000},
+	0x2F8C2: {0x3A2E, 0x0000},
+	0x2F8C3: {0x6469, 0x0000},
+	0x2F8C4: {0x647E, 0x0000},
+	0x2F8C5: {0x649D, 0x0000},
+	0x2F8C6: {0x6477, 0x0000},
+	0x2F8C7: {0x3A6C, 0x0000},
+	0x2F8C8: {0x654F, 0x0000},
+	0x2F8C9: {0x656C, 0x0000},
+	0x2F8CA: {0x2300A, 0x0000},
+	0x2F8CB: {0x65E3, 0x0000},
+	0x2F8CC: {0x66F8, 0x0000},
+	0x2F8CD: {0x6649, 0x0000},
+	0x2F8CE: {0x3B19, 0x0000},
+	0x2F8CF: {0x6691, 0x0000},
+	0x2F8D0: {0x3B08, 0x0000},
+	0x2F8D1: {0x3AE4, 0x0000},
+	0x2F8D2: {0x5192, 0x0000},
+	0x2F8D3: {0x5195, 0x0000},
+	0x2F8D4: {0x6700, 0x0000},
+	0x2F8D5: {0x669C, 0x0000},
+	0x2F8D6: {0x80AD, 0x0000},
+	0x2F8D7: {0x43D9, 0x0000},
+	0x2F8D8: {0x6717, 0x0000},
+	0x2F8D9: {0x671B, 0x0000},
+	0x2F8DA: {0x6721, 0x0000},
+	0x2F8DB: {0x675E, 0x0000},
+	0x2F8DC: {0x6753, 0x0000},
+	0x2F8DD: {0x233C3, 0x0000},
+	0x2F8DE: {0x3B49, 0x0000},
+	0x2F8DF: {0x67FA, 0x0000},
+	0x2F8E0: {0x6785, 0x0000},
+	0x2F8E1: {0x6852, 0x0000},
+	0x2F8E2: {0x6885, 0x0000},
+	0x2F8E3: {0x2346D, 0x0000},
+	0x2F8E4: {0x688E, 0x0000},
+	0x2F8E5: {0x681F, 0x0000},
+	0x2F8E6: {0x6914, 0x0000},
+	0x2F8E7: {0x3B9D, 0x0000},
+	0x2F8E8: {0x6942, 0x0000},
+	0x2F8E9: {0x69A3, 0x0000},
+	0x2F8EA: {0x69EA, 0x0000},
+	0x2F8EB: {0x6AA8, 0x0000},
+	0x2F8EC: {0x236A3, 0x0000},
+	0x2F8ED: {0x6ADB, 0x0000},
+	0x2F8EE: {0x3C18, 0x0000},
+	0x2F8EF: {0x6B21, 0x0000},
+	0x2F8F0: {0x238A7, 0x0000},
+	0x2F8F1: {0x6B54, 0x0000},
+	0x2F8F2: {0x3C4E, 0x0000},
+	0x2F8F3: {0x6B72, 0x0000},
+	0x2F8F4: {0x6B9F, 0x0000},
+	0x2F8F5: {0x6BBA, 0x0000},
+	0x2F8F6: {0x6BBB, 0x0000},
+	0x2F8F7: {0x23A8D, 0x0000},
+	0x2F8F8: {0x21D0B, 0x0000},
+	0x2F8F9: {0x23AFA, 0x0000},
+	0x2F8FA: {0x6C4E, 0x0000},
+	0x2F8FB: {0x23CBC, 0x0000},
+	0x2F8FC: {0x6CBF, 0x0000},
+	0x2F8FD: {0x6CCD, 0x0000},
+	0x2F8FE: {0x6C67, 0x0000},
+	0x2F8FF: {0x6D16, 0x0000},
+	0x2F900: {0x6D3E, 0x0000},
+	0x2F901: {0x6D77, 0x0000},
+	0x2F902: {0x6D41, 0x0000},
+	0x2F903: {0x6D69, 0x0000},
+	0x2F904: {0x6D78, 0x0000},
+	0x2F905: {0x6D85, 0x0000},
+	0x2F906: {0x23D1E, 0x0000},
+	0x2F907: {0x6D34, 0x0000},
+	0x2F908: {0x6E2F, 0x0000},
+	0x2F909: {0x6E6E, 0x0000},
+	0x2F90A: {0x3D33, 0x0000},
+	0x2F90B: {0x6ECB, 0x0000},
+	0x2F90C: {0x6EC7, 0x0000},
+	0x2F90D: {0x23ED1, 0x0000},
+	0x2F90E: {0x6DF9, 0x0000},
+	0x2F90F: {0x6F6E, 0x0000},
+	0x2F910: {0x23F5E, 0x0000},
+	0x2F911: {0x23F8E, 0x0000},
+	0x2F912: {0x6FC6, 0x0000},
+	0x2F913: {0x7039, 0x0000},
+	0x2F914: {0x701E, 0x0000},
+	0x2F915: {0x701B, 0x0000},
+	0x2F916: {0x3D96, 0x0000},
+	0x2F917: {0x704A, 0x0000},
+	0x2F918: {0x707D, 0x0000},
+	0x2F919: {0x7077, 0x0000},
+	0x2F91A: {0x70AD, 0x0000},
+	0x2F91B: {0x20525, 0x0000},
+	0x2F91C: {0x7145, 0x0000},
+	0x2F91D: {0x24263, 0x0000},
+	0x2F91E: {0x719C, 0x0000},
+	0x2F91F: {0x243AB, 0x0000},
+	0x2F920: {0x7228, 0x0000},
+	0x2F921: {0x7235, 0x0000},
+	0x2F922: {0x7250, 0x0000},
+	0x2F923: {0x24608, 0x0000},
+	0x2F924: {0x7280, 0x0000},
+	0x2F925: {0x7295, 0x0000},
+	0x2F926: {0x24735, 0x0000},
+	0x2F927: {0x24814, 0x0000},
+	0x2F928: {0x737A, 0x0000},
+	0x2F929: {0x738B, 0x0000},
+	0x2F92A: {0x3EAC, 0x0000},
+	0x2F92B: {0x73A5, 0x0000},
+	0x2F92C: {0x3EB8, 0x0000},
+	0x2F92D: {0x3EB8, 0x0000},
+	0x2F92E: {0x7447, 0x0000},
+	0x2F92F: {0x745C, 0x0000},
+	0x2F930: {0x7471, 0x0000},
+	0x2F931: {0x7485, 0x0000},
+	0x2F932: {0x74CA, 0x0000},
+	0x2F933: {0x3F1B, 0x0000},
+	0x2F934: {0x7524, 0x0000},
+	0x2F935: {0x24C36, 0x0000},
+	0x2F936: {0x753E, 0x0000},
+	0x2F937: {0x24C92, 0x0000},
+	0x2F938: {0x7570, 0x0000},
+	0x2F939: {0x2219F, 0x0000},
+	0x2F93A: {0x7610, 0x0000},
+	0x2F93B: {0x24FA1, 0x0000},
+	0x2F93C: {0x24FB8, 0x0000},
+	0x2F93D: {0x25044, 0x0000},
+	0x2F93E: {0x3FFC, 0x0000},
+	0x2F93F: {0x4008, 0x0000},
+	0x2F940: {0x76F4, 0x0000},
+	0x2F941: {0x250F3, 0x0000},
+	0x2F942: {0x250F2, 0x0000},
+	0x2F943: {0x25119, 0x0000},
+	0x2F944: {0x25133, 0x0000},
+	0x2F945: {0x771E, 0x0000},
+	0x2F946: {0x771F, 0x0000},
+	0x2F947: {0x771F, 0x0000},
+	0x2F948: {0x774A, 0x0000},
+	0x2F949: {0x4039, 0x0000},
+	0x2F94A: {0x778B, 0x0000},
+	0x2F94B: {0x4046, 0x0000},
+	0x2F94C: {0x4096, 0x0000},
+	0x2F94D: {0x2541D, 0x0000},
+	0x2F94E: {0x784E, 0x0000},
+	0x2F94F: {0x788C, 0x0000},
+	0x2F950: {0x78CC, 0x0000},
+	0x2F951: {0x40E3, 0x0000},
+	0x2F952: {0x25626, 0x0000},
+	0x2F953: {0x7956, 0x0000},
+	0x2F954: {0x2569A, 0x0000},
+	0x2F955: {0x256C5, 0x0000},
+	0x2F956: {0x798F, 0x0000},
+	0x2F957: {0x79EB, 0x0000},
+	0x2F958: {0x412F, 0x0000},
+	0x2F959: {0x7A40, 0x0000},
+	0x2F95A: {0x7A4A, 0x0000},
+	0x2F95B: {0x7A4F, 0x0000},
+	0x2F95C: {0x2597C, 0x0000},
+	0x2F95D: {0x25AA7, 0x0000},
+	0x2F95E: {0x25AA7, 0x0000},
+	0x2F95F: {0x7AEE, 0x0000},
+	0x2F960: {0x4202, 0x0000},
+	0x2F961: {0x25BAB, 0x0000},
+	0x2F962: {0x7BC6, 0x0000},
+	0x2F963: {0x7BC9, 0x0000},
+	0x2F964: {0x4227, 0x0000},
+	0x2F965: {0x25C80, 0x0000},
+	0x2F966: {0x7CD2, 0x0000},
+	0x2F967: {0x42A0, 0x0000},
+	0x2F968: {0x7CE8, 0x0000},
+	0x2F969: {0x7CE3, 0x0000},
+	0x2F96A: {0x7D00, 0x0000},
+	0x2F96B: {0x25F86, 0x0000},
+	0x2F96C: {0x7D63, 0x0000},
+	0x2F96D: {0x4301, 0x0000},
+	0x2F96E: {0x7DC7, 0x0000},
+	0x2F96F: {0x7E02, 0x0000},
+	0x2F970: {0x7E45, 0x0000},
+	0x2F971: {0x4334, 0x0000},
+	0x2F972: {0x26228, 0x0000},
+	0x2F973: {0x26247, 0x0000},
+	0x2F974: {0x4359, 0x0000},
+	0x2F975: {0x262D9, 0x0000},
+	0x2F976: {0x7F7A, 0x0000},
+	0x2F977: {0x2633E, 0x0000},
+	0x2F978: {0x7F95, 0x0000},
+	0x2F979: {0x7FFA, 0x0000},
+	0x2F97A: {0x8005, 0x0000},
+	0x2F97B: {0x264DA, 0x0000},
+	0x2F97C: {0x26523, 0x0000},
+	0x2F97D: {0x8060, 0x0000},
+	0x2F97E: {0x265A8, 0x0000},
+	0x2F97F: {0x8070, 0x0000},
+	0x2F980: {0x2335F, 0x0000},
+	0x2F981: {0x43D5, 0x0000},
+	0x2F982: {0x80B2, 0x0000},
+	0x2F983: {0x8103, 0x0000},
+	0x2F984: {0x440B, 0x0000},
+	0x2F985: {0x813E, 0x0000},
+	0x2F986: {0x5AB5, 0x0000},
+	0x2F987: {0x267A7, 0x0000},
+	0x2F988: {0x267B5, 0x0000},
+	0x2F989: {0x23393, 0x0000},
+	0x2F98A: {0x2339C, 0x0000},
+	0x2F98B: {0x8201, 0x0000},
+	0x2F98C: {0x8204, 0x0000},
+	0x2F98D: {0x8F9E, 0x0000},
+	0x2F98E: {0x446B, 0x0000},
+	0x2F98F: {0x8291, 0x0000},
+	0x2F990: {0x828B, 0x0000},
+	0x2F991: {0x829D, 0x0000},
+	0x2F992: {0x52B3, 0x0000},
+	0x2F993: {0x82B1, 0x0000},
+	0x2F994: {0x82B3, 0x0000},
+	0x2F995: {0x82BD, 0x0000},
+	0x2F996: {0x82E6, 0x0000},
+	0x2F997: {0x26B3C, 0x0000},
+	0x2F998: {0x82E5, 0x0000},
+	0x2F999: {0x831D, 0x0000},
+	0x2F99A: {0x8363, 0x0000},
+	0x2F99B: {0x83AD, 0x0000},
+	0x2F99C: {0x8323, 0x0000},
+	0x2F99D: {0x83BD, 0x0000},
+	0x2F99E: {0x83E7, 0x0000},
+	0x2F99F: {0x8457, 0x0000},
+	0x2F9A0: {0x8353, 0x0000},
+	0x2F9A1: {0x83CA, 0x0000},
+	0x2F9A2: {0x83CC, 0x0000},
+	0x2F9A3: {0x83DC, 0x0000},
+	0x2F9A4: {0x26C36, 0x0000},
+	0x2F9A5: {0x26D6B, 0x0000},
+	0x2F9A6: {0x26CD5, 0x0000},
+	0x2F9A7: {0x452B, 0x0000},
+	0x2F9A8: {0x84F1, 0x0000},
+	0x2F9A9: {0x84F3, 0x0000},
+	0x2F9AA: {0x8516, 0x0000},
+	0x2F9AB: {0x273CA, 0x0000},
+	0x2F9AC: {0x8564, 0x0000},
+	0x2F9AD: {0x26F2C, 0x0000},
+	0x2F9AE: {0x455D, 0x0000},
+	0x2F9AF: {0x4561, 0x0000},
+	0x2F9B0: {0x26FB1, 0x0000},
+	0x2F9B1: {0x270D2, 0x0000},
+	0x2F9B2: {0x456B, 0x0000},
+	0x2F9B3: {0x8650, 0x0000},
+	0x2F9B4: {0x865C, 0x0000},
+	0x2F9B5: {0x8667, 0x0000},
+	0x2F9B6: {0x8669, 0x0000},
+	0x2F9B7: {0x86A9, 0x0000},
+	0x2F9B8: {0x8688, 0x0000},
+	0x2F9B9: {0x870E, 0x0000},
+	0x2F9BA: {0x86E2, 0x0000},
+	0x2F9BB: {0x8779, 0x0000},
+	0x2F9BC: {0x8728, 0x0000},
+	0x2F9BD: {0x876B, 0x0000},
+	0x2F9BE: {0x8786, 0x0000},
+	0x2F9BF: {0x45D7, 0x0000},
+	0x2F9C0: {0x87E1, 0x0000},
+	0x2F9C1: {0x8801, 0x0000},
+	0x2F9C2: {0x45F9, 0x0000},
+	0x2F9C3: {0x8860, 0x0000},
+	0x2F9C4: {0x8863, 0x0000},
+	0x2F9C5: {0x27667, 0x0000},
+	0x2F9C6: {0x88D7, 0x0000},
+	0x2F9C7: {0x88DE, 0x0000},
+	0x2F9C8: {0x4635, 0x0000},
+	0x2F9C9: {0x88FA, 0x0000},
+	0x2F9CA: {0x34BB, 0x0000},
+	0x2F9CB: {0x278AE, 0x0000},
+	0x2F9CC: {0x27966, 0x0000},
+	0x2F9CD: {0x46BE, 0x0000},
+	0x2F9CE: {0x46C7, 0x0000},
+	0x2F9CF: {0x8AA0, 0x0000},
+	0x2F9D0: {0x8AED, 0x0000},
+	0x2F9D1: {0x8B8A, 0x0000},
+	0x2F9D2: {0x8C55, 0x0000},
+	0x2F9D3: {0x27CA8, 0x0000},
+	0x2F9D4: {0x8CAB, 0x0000},
+	0x2F9D5: {0x8CC1, 0x0000},
+	0x2F9D6: {0x8D1B, 0x0000},
+	0x2F9D7: {0x8D77, 0x0000},
+	0x2F9D8: {0x27F2F, 0x0000},
+	0x2F9D9: {0x20804, 0x0000},
+	0x2F9DA: {0x8DCB, 0x0000},
+	0x2F9DB: {0x8DBC, 0x0000},
+	0x2F9DC: {0x8DF0, 0x0000},
+	0x2F9DD: {0x208DE, 0x0000},
+	0x2F9DE: {0x8ED4, 0x0000},
+	0x2F9DF: {0x8F38, 0x0000},
+	0x2F9E0: {0x285D2, 0x0000},
+	0x2F9E1: {0x285ED, 0x0000},
+	0x2F9E2: {0x9094, 0x0000},
+	0x2F9E3: {0x90F1, 0x0000},
+	0x2F9E4: {0x9111, 0x0000},
+	0x2F9E5: {0x2872E, 0x0000},
+	0x2F9E6: {0x911B, 0x0000},
+	0x2F9E7: {0x9238, 0x0000},
+	0x2F9E8: {0x92D7, 0x0000},
+	0x2F9E9: {0x92D8, 0x0000},
+	0x2F9EA: {0x927C, 0x0000},
+	0x2F9EB: {0x93F9, 0x0000},
+	0x2F9EC: {0x9415, 0x0000},
+	0x2F9ED: {0x28BFA, 0x0000},
+	0x2F9EE: {0x958B, 0x0000},
+	0x2F9EF: {0x4995, 0x0000},
+	0x2F9F0: {0x95B7, 0x0000},
+	0x2F9F1: {0x28D77, 0x0000},
+	0x2F9F2: {0x49E6, 0x0000},
+	0x2F9F3: {0x96C3, 0x0000},
+	0x2F9F4: {0x5DB2, 0x0000},
+	0x2F9F5: {0x9723, 0x0000},
+	0x2F9F6: {0x29145, 0x0000},
+	0x2F9F7: {0x2921A, 0x0000},
+	0x2F9F8: {0x4A6E, 0x0000},
+	0x2F9F9: {0x4A76, 0x0000},
+	0x2F9FA: {0x97E0, 0x0000},
+	0x2F9FB: {0x2940A, 0x0000},
+	0x2F9FC: {0x4AB2, 0x0000},
+	0x2F9FD: {0x29496, 0x0000},
+	0x2F9FE: {0x980B, 0x0000},
+	0x2F9FF: {0x980B, 0x0000},
+	0x2FA00: {0x9829, 0x0000},
+	0x2FA01: {0x295B6, 0x0000},
+	0x2FA02: {0x98E2, 0x0000},
+	0x2FA03: {0x4B33, 0x0000},
+	0x2FA04: {0x9929, 0x0000},
+	0x2FA05: {0x99A7, 0x0000},
+	0x2FA06: {0x99C2, 0x0000},
+	0x2FA07: {0x99FE, 0x0000},
+	0x2FA08: {0x4BCE, 0x0000},
+	0x2FA09: {0x29B30, 0x0000},
+	0x2FA0A: {0x9B12, 0x0000},
+	0x2FA0B: {0x9C40, 0x0000},
+	0x2FA0C: {0x9CFD, 0x0000},
+	0x2FA0D: {0x4CCE, 0x0000},
+	0x2FA0E: {0x4CED, 0x0000},
+	0x2FA0F: {0x9D67, 0x0000},
+	0x2FA10: {0x2A0CE, 0x0000},
+	0x2FA11: {0x4CF8, 0x0000},
+	0x2FA12: {0x2A105, 0x0000},
+	0x2FA13: {0x2A20E, 0x0000},
+	0x2FA14: {0x2A291, 0x0000},
+	0x2FA15: {0x9EBB, 0x0000},
+	0x2FA16: {0x4D56, 0x0000},
+	0x2FA17: {0x9EF9, 0x0000},
+	0x2FA18: {0x9EFE, 0x0000},
+	0x2FA19: {0x9F05, 0x0000},
+	0x2FA1A: {0x9F0F, 0x0000},
+	0x2FA1B: {0x9F16, 0x0000},
+	0x2FA1C: {0x9F3B, 0x0000},
+	0x2FA1D: {0x2A600, 0x0000},
+}
+
+// nfcExclusions are the script specific and post composition version
+// exclusions, which are not composed by NFC.
+var nfcExclusions = []rune{
+	0x0958,
+	0x0959,
+	0x095A,
+	0x095B,
+	0x095C,
+	0x095D,
+	0x095E,
+	0x095F,
+	0x09DC,
+	0x09DD,
+	0x09DF,
+	0x0A33,
+	0x0A36,
+	0x0A59,
+	0x0A5A,
+	0x0A5B,
+	0x0A5E,
+	0x0B5C,
+	0x0B5D,
+	0x0F43,
+	0x0F4D,
+	0x0F52,
+	0x0F57,
+	0x0F5C,
+	0x0F69,
+	0x0F76,
+	0x0F78,
+	0x0F93,
+	0x0F9D,
+	0x0FA2,
+	0x0FA7,
+	0x0FAC,
+	0x0FB9,
+	0x2ADC,
+	0xFB1D,
+	0xFB1F,
+	0xFB2A,
+	0xFB2B,
+	0xFB2C,
+	0xFB2D,
+	0xFB2E,
+	0xFB2F,
+	0xFB30,
+	0xFB31,
+	0xFB32,
+	0xFB33,
+	0xFB34,
+	0xFB35,
+	0xFB36,
+	0xFB38,
+	0xFB39,
+	0xFB3A,
+	0xFB3B,
+	0xFB3C,
+	0xFB3E,
+	0xFB40,
+	0xFB41,
+	0xFB43,
+	0xFB44,
+	0xFB46,
+	0xFB47,
+	0xFB48,
+	0xFB49,
+	0xFB4A,
+	0xFB4B,
+	0xFB4C,
+	0xFB4D,
+	0xFB4E,
+	0x1D15E,
+	0x1D15F,
+	0x1D160,
+	0x1D161,
+	0x1D162,
+	0x1D163,
+	0x1D164,
+	0x1D1BB,
+	0x1D1BC,
+	0x1D1BD,
+	0x1D1BE,
+	0x1D1BF,
+	0x1D1C0,
+}
+
+// bidiTable lists the Bidi_Class of the code points which are not L.
+var bidiTable = []propertyRange{
+	{0x0000, 0x0008, bidiBN},
+	{0x0009, 0x000D, bidiOther},
+	{0x000E, 0x001B, bidiBN},
+	{0x001C, 0x0020, bidiOther},
+	{0x0021, 0x0022, bidiON},
+	{0x0023, 0x0025, bidiET},
+	{0x0026, 0x002A, bidiON},
+	{0x002B, 0x002B, bidiES},
+	{0x002C, 0x002C, bidiCS},
+	{0x002D, 0x002D, bidiES},
+	{0x002E, 0x002F, bidiCS},
+	{0x0030, 0x0039, bidiEN},
+	{0x003A, 0x003A, bidiCS},
+	{0x003B, 0x0040, bidiON},
+	{0x005B, 0x0060, bidiON},
+	{0x007B, 0x007E, bidiON},
+	{0x007F, 0x0084, bidiBN},
+	{0x0085, 0x0085, bidiOther},
+	{0x0086, 0x009F, bidiBN},
+	{0x00A0, 0x00A0, bidiCS},
+	{0x00A1, 0x00A1, bidiON},
+	{0x00A2, 0x00A5, bidiET},
+	{0x00A6, 0x00A9, bidiON},
+	{0x00AB, 0x00AC, bidiON},
+	{0x00AD, 0x00AD, bidiBN},
+	{0x00AE, 0x00AF, bidiON},
+	{0x00B0, 0x00B1, bidiET},
+	{0x00B2, 0x00B3, bidiEN},
+	{0x00B4, 0x00B4, bidiON},
+	{0x00B6, 0x00B8, bidiON},
+	{0x00B9, 0x00B9, bidiEN},
+	{0x00BB, 0x00BF, bidiON},
+	{0x00D7, 0x00D7, bidiON},
+	{0x00F7, 0x00F7, bidiON},
+	{0x02B9, 0x02BA, bidiON},
+	{0x02C2, 0x02CF, bidiON},
+	{0x02D2, 0x02DF, bidiON},
+	{0x02E5, 0x02ED, bidiON},
+	{0x02EF, 0x02FF, bidiON},
+	{0x0300, 0x036F, bidiNSM},
+	{0x0374, 0x0375, bidiON},
+	{0x037E, 0x037E, bidiON},
+	{0x0384, 0x0385, bidiON},
+	{0x0387, 0x0387, bidiON},
+	{0x03F6, 0x03F6, bidiON},
+	{0x0483, 0x0489, bidiNSM},
+	{0x058A, 0x058A, bidiON},
+	{0x058D, 0x058E, bidiON},
+	{0x058F, 0x058F, bidiET},
+	{0x0591, 0x05BD, bidiNSM},
+	{0x05BE, 0x05BE, bidiR},
+	{0x05BF, 0x05BF, bidiNSM},
+	{0x05C0, 0x05C0, bidiR},
+	{0x05C1, 0x05C2, bidiNSM},
+	{0x05C3, 0x05C3, bidiR},
+	{0x05C4, 0x05C5, bidiNSM},
+	{0x05C6, 0x05C6, bidiR},
+	{0x05C7, 0x05C7, bidiNSM},
+	{0x05D0, 0x05EA, bidiR},
+	{0x05EF, 0x05F4, bidiR},
+	{0x0600, 0x0605, bidiAN},
+	{0x0606, 0x0607, bidiON},
+	{0x0608, 0x0608, bidiAL},
+	{0x0609, 0x060A, bidiET},
+	{0x060B, 0x060B, bidiAL},
+	{0x060C, 0x060C, bidiCS},
+	{0x060D, 0x060D, bidiAL},
+	{0x060E, 0x060F, bidiON},
+	{0x0610, 0x061A, bidiNSM},
+	{0x061B, 0x064A, bidiAL},
+	{0x064B, 0x065F, bidiNSM},
+	{0x0660, 0x0669, bidiAN},
+	{0x066A, 0x066A, bidiET},
+	{0x066B, 0x066C, bidiAN},
+	{0x066D, 0x066F, bidiAL},
+	{0x0670, 0x0670, bidiNSM},
+	{0x0671, 0x06D5, bidiAL},
+	{0x06D6, 0x06DC, bidiNSM},
+	{0x06DD, 0x06DD, bidiAN},
+	{0x06DE, 0x06DE, bidiON},
+	{0x06DF, 0x06E4, bidiNSM},
+	{0x06E5, 0x06E6, bidiAL},
+	{0x06E7, 0x06E8, bidiNSM},
+	{0x06E9, 0x06E9, bidiON},
+	{0x06EA, 0x06ED, bidiNSM},
+	{0x06EE, 0x06EF, bidiAL},
+	{0x06F0, 0x06F9, bidiEN},
+	{0x06FA, 0x070D, bidiAL},
+	{0x070F, 0x0710, bidiAL},
+	{0x0711, 0x0711, bidiNSM},
+	{0x0712, 0x072F, bidiAL},
+	{0x0730, 0x074A, bidiNSM},
+	{0x074D, 0x07A5, bidiAL},
+	{0x07A6, 0x07B0, bidiNSM},
+	{0x07B1, 0x07B1, bidiAL},
+	{0x07C0, 0x07EA, bidiR},
+	{0x07EB, 0x07F3, bidiNSM},
+	{0x07F4, 0x07F5, bidiR},
+	{0x07F6, 0x07F9, bidiON},
+	{0x07FA, 0x07FA, bidiR},
+	{0x07FD, 0x07FD, bidiNSM},
+	{0x07FE, 0x0815, bidiR},
+	{0x0816, 0x0819, bidiNSM},
+	{0x081A, 0x081A, bidiR},
+	{0x081B, 0x0823, bidiNSM},
+	{0x0824, 0x0824, bidiR},
+	{0x0825, 0x0827, bidiNSM},
+	{0x0828, 0x0828, bidiR},
+	{0x0829, 0x082D, bidiNSM},
+	{0x0830, 0x083E, bidiR},
+	{0x0840, 0x0858, bidiR},
+	{0x0859, 0x085B, bidiNSM},
+	{0x085E, 0x085E, bidiR},
+	{0x0860, 0x086A, bidiAL},
+	{0x0870, 0x088E, bidiAL},
+	{0x0890, 0x0891, bidiAN},
+	{0x0898, 0x089F, bidiNSM},
+	{0x08A0, 0x08C9, bidiAL},
+	{0x08CA, 0x08E1, bidiNSM},
+	{0x08E2, 0x08E2, bidiAN},
+	{0x08E3, 0x0902, bidiNSM},
+	{0x093A, 0x093A, bidiNSM},
+	{0x093C, 0x093C, bidiNSM},
+	{0x0941, 0x0948, bidiNSM},
+	{0x094D, 0x094D, bidiNSM},
+	{0x0951, 0x0957, bidiNSM},
+	{0x0962, 0x0963, bidiNSM},
+	{0x0981, 0x0981, bidiNSM},
+	{0x09BC, 0x09BC, bidiNSM},
+	{0x09C1, 0x09C4, bidiNSM},
+	{0x09CD, 0x09CD, bidiNSM},
+	{0x09E2, 0x09E3, bidiNSM},
+	{0x09F2, 0x09F3, bidiET},
+	{0x09FB, 0x09FB, bidiET},
+	{0x09FE, 0x09FE, bidiNSM},
+	{0x0A01, 0x0A02, bidiNSM},
+	{0x0A3C, 0x0A3C, bidiNSM},
+	{0x0A41, 0x0A42, bidiNSM},
+	{0x0A47, 0x0A48, bidiNSM},
+	{0x0A4B, 0x0A4D, bidiNSM},
+	{0x0A51, 0x0A51, bidiNSM},
+	{0x0A70, 0x0A71, bidiNSM},
+	{0x0A75, 0x0A75, bidiNSM},
+	{0x0A81, 0x0A82, bidiNSM},
+	{0x0ABC, 0x0ABC, bidiNSM},
+	{0x0AC1, 0x0AC5, bidiNSM},
+	{0x0AC7, 0x0AC8, bidiNSM},
+	{0x0ACD, 0x0ACD, bidiNSM},
+	{0x0AE2, 0x0AE3, bidiNSM},
+	{0x0AF1, 0x0AF1, bidiET},
+	{0x0AFA, 0x0AFF, bidiNSM},
+	{0x0B01, 0x0B01, bidiNSM},
+	{0x0B3C, 0x0B3C, bidiNSM},
+	{0x0B3F, 0x0B3F, bidiNSM},
+	{0x0B41, 0x0B44, bidiNSM},
+	{0x0B4D, 0x0B4D, bidiNSM},
+	{0x0B55, 0x0B56, bidiNSM},
+	{0x0B62, 0x0B63, bidiNSM},
+	{0x0B82, 0x0B82, bidiNSM},
+	{0x0BC0, 0x0BC0, bidiNSM},
+	{0x0BCD, 0x0BCD, bidiNSM},
+	{0x0BF3, 0x0BF8, bidiON},
+	{0x0BF9, 0x0BF9, bidiET},
+	{0x0BFA, 0x0BFA, bidiON},
+	{0x0C00, 0x0C00, bidiNSM},
+	{0x0C04, 0x0C04, bidiNSM},
+	{0x0C3C, 0x0C3C, bidiNSM},
+	{0x0C3E, 0x0C40, bidiNSM},
+	{0x0C46, 0x0C48, bidiNSM},
+	{0x0C4A, 0x0C4D, bidiNSM},
+	{0x0C55, 0x0C56, bidiNSM},
+	{0x0C62, 0x0C63, bidiNSM},
+	{0x0C78, 0x0C7E, bidiON},
+	{0x0C81, 0x0C81, bidiNSM},
+	{0x0CBC, 0x0CBC, bidiNSM},
+	{0x0CCC, 0x0CCD, bidiNSM},
+	{0x0CE2, 0x0CE3, bidiNSM},
+	{0x0D00, 0x0D01, bidiNSM},
+	{0x0D3B, 0x0D3C, bidiNSM},
+	{0x0D41, 0x0D44, bidiNSM},
+	{0x0D4D, 0x0D4D, bidiNSM},
+	{0x0D62, 0x0D63, bidiNSM},
+	{0x0D81, 0x0D81, bidiNSM},
+	{0x0DCA, 0x0DCA, bidiNSM},
+	{0x0DD2, 0x0DD4, bidiNSM},
+	{0x0DD6, 0x0DD6, bidiNSM},
+	{0x0E31, 0x0E31, bidiNSM},
+	{0x0E34, 0x0E3A, bidiNSM},
+	{0x0E3F, 0x0E3F, bidiET},
+	{0x0E47, 0x0E4E, bidiNSM},
+	{0x0EB1, 0x0EB1, bidiNSM},
+	{0x0EB4, 0x0EBC, bidiNSM},
+	{0x0EC8, 0x0ECE, bidiNSM},
+	{0x0F18, 0x0F19, bidiNSM},
+	{0x0F35, 0x0F35, bidiNSM},
+	{0x0F37, 0x0F37, bidiNSM},
+	{0x0F39, 0x0F39, bidiNSM},
+	{0x0F3A, 0x0F3D, bidiON},
+	{0x0F71, 0x0F7E, bidiNSM},
+	{0x0F80, 0x0F84, bidiNSM},
+	{0x0F86, 0x0F87, bidiNSM},
+	{0x0F8D, 0x0F97, bidiNSM},
+	{0x0F99, 0x0FBC, bidiNSM},
+	{0x0FC6, 0x0FC6, bidiNSM},
+	{0x102D, 0x1030, bidiNSM},
+	{0x1032, 0x1037, bidiNSM},
+	{0x1039, 0x103A, bidiNSM},
+	{0x103D, 0x103E, bidiNSM},
+	{0x1058, 0x1059, bidiNSM},
+	{0x105E, 0x1060, bidiNSM},
+	{0x1071, 0x1074, bidiNSM},
+	{0x1082, 0x1082, bidiNSM},
+	{0x1085, 0x1086, bidiNSM},
+	{0x108D, 0x108D, bidiNSM},
+	{0x109D, 0x109D, bidiNSM},
+	{0x135D, 0x135F, bidiNSM},
+	{0x1390, 0x1399, bidiON},
+	{0x1400, 0x1400, bidiON},
+	{0x1680, 0x1680, bidiOther},
+	{0x169B, 0x169C, bidiON},
+	{0x1712, 0x1714, bidiNSM},
+	{0x1732, 0x1733, bidiNSM},
+	{0x1752, 0x1753, bidiNSM},
+	{0x1772, 0x1773, bidiNSM},
+	{0x17B4, 0x17B5, bidiNSM},
+	{0x17B7, 0x17BD, bidiNSM},
+	{0x17C6, 0x17C6, bidiNSM},
+	{0x17C9, 0x17D3, bidiNSM},
+	{0x17DB, 0x17DB, bidiET},
+	{0x17DD, 0x17DD, bidiNSM},
+	{0x17F0, 0x17F9, bidiON},
+	{0x1800, 0x180A, bidiON},
+	{0x180B, 0x180D, bidiNSM},
+	{0x180E, 0x180E, bidiBN},
+	{0x180F, 0x180F, bidiNSM},
+	{0x1885, 0x1886, bidiNSM},
+	{0x18A9, 0x18A9, bidiNSM},
+	{0x1920, 0x1922, bidiNSM},
+	{0x1927, 0x1928, bidiNSM},
+	{0x1932, 0x1932, bidiNSM},
+	{0x1939, 0x193B, bidiNSM},
+	{0x1940, 0x1940, bidiON},
+	{0x1944, 0x1945, bidiON},
+	{0x19DE, 0x19FF, bidiON},
+	{0x1A17, 0x1A18, bidiNSM},
+	{0x1A1B, 0x1A1B, bidiNSM},
+	{0x1A56, 0x1A56, bidiNSM},
+	{0x1A58, 0x1A5E, bidiNSM},
+	{0x1A60, 0x1A60, bidiNSM},
+	{0x1A62, 0x1A62, bidiNSM},
+	{0x1A65, 0x1A6C, bidiNSM},
+	{0x1A73, 0x1A7C, bidiNSM},
+	{0x1A7F, 0x1A7F, bidiNSM},
+	{0x1AB0, 0x1ACE, bidiNSM},
+	{0x1B00, 0x1B03, bidiNSM},
+	{0x1B34, 0x1B34, bidiNSM},
+	{0x1B36, 0x1B3A, bidiNSM},
+	{0x1B3C, 0x1B3C, bidiNSM},
+	{0x1B42, 0x1B42, bidiNSM},
+	{0x1B6B, 0x1B73, bidiNSM},
+	{0x1B80, 0x1B81, bidiNSM},
+	{0x1BA2, 0x1BA5, bidiNSM},
+	{0x1BA8, 0x1BA9, bidiNSM},
+	{0x1BAB, 0x1BAD, bidiNSM},
+	{0x1BE6, 0x1BE6, bidiNSM},
+	{0x1BE8, 0x1BE9, bidiNSM},
+	{0x1BED, 0x1BED, bidiNSM},
+	{0x1BEF, 0x1BF1, bidiNSM},
+	{0x1C2C, 0x1C33, bidiNSM},
+	{0x1C36, 0x1C37, bidiNSM},
+	{0x1CD0, 0x1CD2, bidiNSM},
+	{0x1CD4, 0x1CE0, bidiNSM},
+	{0x1CE2, 0x1CE8, bidiNSM},
+	{0x1CED, 0x1CED, bidiNSM},
+	{0x1CF4, 0x1CF4, bidiNSM},
+	{0x1CF8, 0x1CF9, bidiNSM},
+	{0x1DC0, 0x1DFF, bidiNSM},
+	{0x1FBD, 0x1FBD, bidiON},
+	{0x1FBF, 0x1FC1, bidiON},
+	{0x1FCD, 0x1FCF, bidiON},
+	{0x1FDD, 0x1FDF, bidiON},
+	{0x1FED, 0x1FEF, bidiON},
+	{0x1FFD, 0x1FFE, bidiON},
+	{0x2000, 0x200A, bidiOther},
+	{0x200B, 0x200D, bidiBN},
+	{0x200F, 0x200F, bidiR},
+	{0x2010, 0x2027, bidiON},
+	{0x2028, 0x202E, bidiOther},
+	{0x202F, 0x202F, bidiCS},
+	{0x2030, 0x2034, bidiET},
+	{0x2035, 0x2043, bidiON},
+	{0x2044, 0x2044, bidiCS},
+	{0x2045, 0x205E, bidiON},
+	{0x205F, 0x205F, bidiOther},
+	{0x2060, 0x2064, bidiBN},
+	{0x2066, 0x2069, bidiOther},
+	{0x206A, 0x206F, bidiBN},
+	{0x2070, 0x2070, bidiEN},
+	{0x2074, 0x2079, bidiEN},
+	{0x207A, 0x207B, bidiES},
+	{0x207C, 0x207E, bidiON},
+	{0x2080, 0x2089, bidiEN},
+	{0x208A, 0x208B, bidiES},
+	{0x208C, 0x208E, bidiON},
+	{0x20A0, 0x20C0, bidiET},
+	{0x20D0, 0x20F0, bidiNSM},
+	{0x2100, 0x2101, bidiON},
+	{0x2103, 0x2106, bidiON},
+	{0x2108, 0x2109, bidiON},
+	{0x2114, 0x2114, bidiON},
+	{0x2116, 0x2118, bidiON},
+	{0x211E, 0x2123, bidiON},
+	{0x2125, 0x2125, bidiON},
+	{0x2127, 0x2127, bidiON},
+	{0x2129, 0x2129, bidiON},
+	{0x212E, 0x212E, bidiET},
+	{0x213A, 0x213B, bidiON},
+	{0x2140, 0x2144, bidiON},
+	{0x214A, 0x214D, bidiON},
+	{0x2150, 0x215F, bidiON},
+	{0x2189, 0x218B, bidiON},
+	{0x2190, 0x2211, bidiON},
+	{0x2212, 0x2212, bidiES},
+	{0x2213, 0x2213, bidiET},
+	{0x2214, 0x2335, bidiON},
+	{0x237B, 0x2394, bidiON},
+	{0x2396, 0x2426, bidiON},
+	{0x2440, 0x244A, bidiON},
+	{0x2460, 0x2487, bidiON},
+	{0x2488, 0x249B, bidiEN},
+	{0x24EA, 0x26AB, bidiON},
+	{0x26AD, 0x27FF, bidiON},
+	{0x2900, 0x2B73, bidiON},
+	{0x2B76, 0x2B95, bidiON},
+	{0x2B97, 0x2BFF, bidiON},
+	{0x2CE5, 0x2CEA, bidiON},
+	{0x2CEF, 0x2CF1, bidiNSM},
+	{0x2CF9, 0x2CFF, bidiON},
+	{0x2D7F, 0x2D7F, bidiNSM},
+	{0x2DE0, 0x2DFF, bidiNSM},
+	{0x2E00, 0x2E5D, bidiON},
+	{0x2E80, 0x2E99, bidiON},
+	{0x2E9B, 0x2EF3, bidiON},
+	{0x2F00, 0x2FD5, bidiON},
+	{0x2FF0, 0x2FFF, bidiON},
+	{0x3000, 0x3000, bidiOther},
+	{0x3001, 0x3004, bidiON},
+	{0x3008, 0x3020, bidiON},
+	{0x302A, 0x302D, bidiNSM},
+	{0x3030, 0x3030, bidiON},
+	{0x3036, 0x3037, bidiON},
+	{0x303D, 0x303F, bidiON},
+	{0x3099, 0x309A, bidiNSM},
+	{0x309B, 0x309C, bidiON},
+	{0x30A0, 0x30A0, bidiON},
+	{0x30FB, 0x30FB, bidiON},
+	{0x31C0, 0x31E3, bidiON},
+	{0x31EF, 0x31EF, bidiON},
+	{0x321D, 0x321E, bidiON},
+	{0x3250, 0x325F, bidiON},
+	{0x327C, 0x327E, bidiON},
+	{0x32B1, 0x32BF, bidiON},
+	{0x32CC, 0x32CF, bidiON},
+	{0x3377, 0x337A, bidiON},
+	{0x33DE, 0x33DF, bidiON},
+	{0x33FF, 0x33FF, bidiON},
+	{0x4DC0, 0x4DFF, bidiON},
+	{0xA490, 0xA4C6, bidiON},
+	{0xA60D, 0xA60F, bidiON},
+	{0xA66F, 0xA672, bidiNSM},
+	{0xA673, 0xA673, bidiON},
+	{0xA674, 0xA67D, bidiNSM},
+	{0xA67E, 0xA67F, bidiON},
+	{0xA69E, 0xA69F, bidiNSM},
+	{0xA6F0, 0xA6F1, bidiNSM},
+	{0xA700, 0xA721, bidiON},
+	{0xA788, 0xA788, bidiON},
+	{0xA802, 0xA802, bidiNSM},
+	{0xA806, 0xA806, bidiNSM},
+	{0xA80B, 0xA80B, bidiNSM},
+	{0xA825, 0xA826, bidiNSM},
+	{0xA828, 0xA82B, bidiON},
+	{0xA82C, 0xA82C, bidiNSM},
+	{0xA838, 0xA839, bidiET},
+	{0xA874, 0xA877, bidiON},
+	{0xA8C4, 0xA8C5, bidiNSM},
+	{0xA8E0, 0xA8F1, bidiNSM},
+	{0xA8FF, 0xA8FF, bidiNSM},
+	{0xA926, 0xA92D, bidiNSM},
+	{0xA947, 0xA951, bidiNSM},
+	{0xA980, 0xA982, bidiNSM},
+	{0xA9B3, 0xA9B3, bidiNSM},
+	{0xA9B6, 0xA9B9, bidiNSM},
+	{0xA9BC, 0xA9BD, bidiNSM},
+	{0xA9E5, 0xA9E5, bidiNSM},
+	{0xAA29, 0xAA2E, bidiNSM},
+	{0xAA31, 0xAA32, bidiNSM},
+	{0xAA35, 0xAA36, bidiNSM},
+	{0xAA43, 0xAA43, bidiNSM},
+	{0xAA4C, 0xAA4C, bidiNSM},
+	{0xAA7C, 0xAA7C, bidiNSM},
+	{0xAAB0, 0xAAB0, bidiNSM},
+	{0xAAB2, 0xAAB4, bidiNSM},
+	{0xAAB7, 0xAAB8, bidiNSM},
+	{0xAABE, 0xAABF, bidiNSM},
+	{0xAAC1, 0xAAC1, bidiNSM},
+	{0xAAEC, 0xAAED, bidiNSM},
+	{0xAAF6, 0xAAF6, bidiNSM},
+	{0xAB6A, 0xAB6B, bidiON},
+	{0xABE5, 0xABE5, bidiNSM},
+	{0xABE8, 0xABE8, bidiNSM},
+	{0xABED, 0xABED, bidiNSM},
+	{0xFB1D, 0xFB1D, bidiR},
+	{0xFB1E, 0xFB1E, bidiNSM},
+	{0xFB1F, 0xFB28, bidiR},
+	{0xFB29, 0xFB29, bidiES},
+	{0xFB2A, 0xFB36, bidiR},
+	{0xFB38, 0xFB3C, bidiR},
+	{0xFB3E, 0xFB3E, bidiR},
+	{0xFB40, 0xFB41, bidiR},
+	{0xFB43, 0xFB44, bidiR},
+	{0xFB46, 0xFB4F, bidiR},
+	{0xFB50, 0xFBC2, bidiAL},
+	{0xFBD3, 0xFD3D, bidiAL},
+	{0xFD3E, 0xFD4F, bidiON},
+	{0xFD50, 0xFD8F, bidiAL},
+	{0xFD92, 0xFDC7, bidiAL},
+	{0xFDCF, 0xFDCF, bidiON},
+	{0xFDF0, 0xFDFC, bidiAL},
+	{0xFDFD, 0xFDFF, bidiON},
+	{0xFE00, 0xFE0F, bidiNSM},
+	{0xFE10, 0xFE19, bidiON},
+	{0xFE20, 0xFE2F, bidiNSM},
+	{0xFE30, 0xFE4F, bidiON},
+	{0xFE50, 0xFE50, bidiCS},
+	{0xFE51, 0xFE51, bidiON},
+	{0xFE52, 0xFE52, bidiCS},
+	{0xFE54, 0xFE54, bidiON},
+	{0xFE55, 0xFE55, bidiCS},
+	{0xFE56, 0xFE5E, bidiON},
+	{0xFE5F, 0xFE5F, bidiET},
+	{0xFE60, 0xFE61, bidiON},
+	{0xFE62, 0xFE63, bidiES},
+	{0xFE64, 0xFE66, bidiON},
+	{0xFE68, 0xFE68, bidiON},
+	{0xFE69, 0xFE6A, bidiET},
+	{0xFE6B, 0xFE6B, bidiON},
+	{0xFE70, 0xFE74, bidiAL},
+	{0xFE76, 0xFEFC, bidiAL},
+	{0xFEFF, 0xFEFF, bidiBN},
+	{0xFF01, 0xFF02, bidiON},
+	{0xFF03, 0xFF05, bidiET},
+	{0xFF06, 0xFF0A, bidiON},
+	{0xFF0B, 0xFF0B, bidiES},
+	{0xFF0C, 0xFF0C, bidiCS},
+	{0xFF0D, 0xFF0D, bidiES},
+	{0xFF0E, 0xFF0F, bidiCS},
+	{0xFF10, 0xFF19, bidiEN},
+	{0xFF1A, 0xFF1A, bidiCS},
+	{0xFF1B, 0xFF20, bidiON},
+	{0xFF3B, 0xFF40, bidiON},
+	{0xFF5B, 0xFF65, bidiON},
+	{0xFFE0, 0xFFE1, bidiET},
+	{0xFFE2, 0xFFE4, bidiON},
+	{0xFFE5, 0xFFE6, bidiET},
+	{0xFFE8, 0xFFEE, bidiON},
+	{0xFFF9, 0xFFFD, bidiON},
+	{0x10101, 0x10101, bidiON},
+	{0x10140, 0x1018C, bidiON},
+	{0x10190, 0x1019C, bidiON},
+	{0x101A0, 0x101A0, bidiON},
+	{0x101FD, 0x101FD, bidiNSM},
+	{0x102E0, 0x102E0, bidiNSM},
+	{0x102E1, 0x102FB, bidiEN},
+	{0x10376, 0x1037A, bidiNSM},
+	{0x10800, 0x10805, bidiR},
+	{0x10808, 0x10808, bidiR},
+	{0x1080A, 0x10835, bidiR},
+	{0x10837, 0x10838, bidiR},
+	{0x1083C, 0x1083C, bidiR},
+	{0x1083F, 0x10855, bidiR},
+	{0x10857, 0x1089E, bidiR},
+	{0x108A7, 0x108AF, bidiR},
+	{0x108E0, 0x108F2, bidiR},
+	{0x108F4, 0x108F5, bidiR},
+	{0x108FB, 0x1091B, bidiR},
+	{0x1091F, 0x1091F, bidiON},
+	{0x10920, 0x10939, bidiR},
+	{0x1093F, 0x1093F, bidiR},
+	{0x10980, 0x109B7, bidiR},
+	{0x109BC, 0x109CF, bidiR},
+	{0x109D2, 0x10A00, bidiR},
+	{0x10A01, 0x10A03, bidiNSM},
+	{0x10A05, 0x10A06, bidiNSM},
+	{0x10A0C, 0x10A0F, bidiNSM},
+	{0x10A10, 0x10A13, bidiR},
+	{0x10A15, 0x10A17, bidiR},
+	{0x10A19, 0x10A35, bidiR},
+	{0x10A38, 0x10A3A, bidiNSM},
+	{0x10A3F, 0x10A3F, bidiNSM},
+	{0x10A40, 0x10A48, bidiR},
+	{0x10A50, 0x10A58, bidiR},
+	{0x10A60, 0x10A9F, bidiR},
+	{0x10AC0, 0x10AE4, bidiR},
+	{0x10AE5, 0x10AE6, bidiNSM},
+	{0x10AEB, 0x10AF6, bidiR},
+	{0x10B00, 0x10B35, bidiR},
+	{0x10B39, 0x10B3F, bidiON},
+	{0x10B40, 0x10B55, bidiR},
+	{0x10B58, 0x10B72, bidiR},
+	{0x10B78, 0x10B91, bidiR},
+	{0x10B99, 0x10B9C, bidiR},
+	{0x10BA9, 0x10BAF, bidiR},
+	{0x10C00, 0x10C48, bidiR},
+	{0x10C80, 0x10CB2, bidiR},
+	{0x10CC0, 0x10CF2, bidiR},
+	{0x10CFA, 0x10CFF, bidiR},
+	{0x10D00, 0x10D23, bidiAL},
+	{0x10D24, 0x10D27, bidiNSM},
+	{0x10D30, 0x10D39, bidiAN},
+	{0x10E60, 0x10E7E, bidiAN},
+	{0x10E80, 0x10EA9, bidiR},
+	{0x10EAB, 0x10EAC, bidiNSM},
+	{0x10EAD, 0x10EAD, bidiR},
+	{0x10EB0, 0x10EB1, bidiR},
+	{0x10EFD, 0x10EFF, bidiNSM},
+	{0x10F00, 0x10F27, bidiR},
+	{0x10F30, 0x10F45, bidiAL},
+	{0x10F46, 0x10F50, bidiNSM},
+	{0x10F51, 0x10F59, bidiAL},
+	{0x10F70, 0x10F81, bidiR},
+	{0x10F82, 0x10F85, bidiNSM},
+	{0x10F86, 0x10F89, bidiR},
+	{0x10FB0, 0x10FCB, bidiR},
+	{0x10FE0, 0x10FF6, bidiR},
+	{0x11001, 0x11001, bidiNSM},
+	{0x11038, 0x11046, bidiNSM},
+	{0x11052, 0x11065, bidiON},
+	{0x11070, 0x11070, bidiNSM},
+	{0x11073, 0x11074, bidiNSM},
+	{0x1107F, 0x11081, bidiNSM},
+	{0x110B3, 0x110B6, bidiNSM},
+	{0x110B9, 0x110BA, bidiNSM},
+	{0x110C2, 0x110C2, bidiNSM},
+	{0x11100, 0x11102, bidiNSM},
+	{0x11127, 0x1112B, bidiNSM},
+	{0x1112D, 0x11134, bidiNSM},
+	{0x11173, 0x11173, bidiNSM},
+	{0x11180, 0x11181, bidiNSM},
+	{0x111B6, 0x111BE, bidiNSM},
+	{0x111C9, 0x111CC, bidiNSM},
+	{0x111CF, 0x111CF, bidiNSM},
+	{0x1122F, 0x11231, bidiNSM},
+	{0x11234, 0x11234, bidiNSM},
+	{0x11236, 0x11237, bidiNSM},
+	{0x1123E, 0x1123E, bidiNSM},
+	{0x11241, 0x11241, bidiNSM},
+	{0x112DF, 0x112DF, bidiNSM},
+	{0x112E3, 0x112EA, bidiNSM},
+	{0x11300, 0x11301, bidiNSM},
+	{0x1133B, 0x1133C, bidiNSM},
+	{0x11340, 0x11340, bidiNSM},
+	{0x11366, 0x1136C, bidiNSM},
+	{0x11370, 0x11374, bidiNSM},
+	{0x11438, 0x1143F, bidiNSM},
+	{0x11442, 0x11444, bidiNSM},
+	{0x11446, 0x11446, bidiNSM},
+	{0x1145E, 0x1145E, bidiNSM},
+	{0x114B3, 0x114B8, bidiNSM},
+	{0x114BA, 0x114BA, bidiNSM},
+	{0x114BF, 0x114C0, bidiNSM},
+	{0x114C2, 0x114C3, bidiNSM},
+	{0x115B2, 0x115B5, bidiNSM},
+	{0x115BC, 0x115BD, bidiNSM},
+	{0x115BF, 0x115C0, bidiNSM},
+	{0x115DC, 0x115DD, bidiNSM},
+	{0x11633, 0x1163A, bidiNSM},
+	{0x1163D, 0x1163D, bidiNSM},
+	{0x1163F, 0x11640, bidiNSM},
+	{0x11660, 0x1166C, bidiON},
+	{0x116AB, 0x116AB, bidiNSM},
+	{0x116AD, 0x116AD, bidiNSM},
+	{0x116B0, 0x116B5, bidiNSM},
+	{0x116B7, 0x116B7, bidiNSM},
+	{0x1171D, 0x1171F, bidiNSM},
+	{0x11722, 0x11725, bidiNSM},
+	{0x11727, 0x1172B, bidiNSM},
+	{0x1182F, 0x11837, bidiNSM},
+	{0x11839, 0x1183A, bidiNSM},
+	{0x1193B, 0x1193C, bidiNSM},
+	{0x1193E, 0x1193E, bidiNSM},
+	{0x11943, 0x11943, bidiNSM},
+	{0x119D4, 0x119D7, bidiNSM},
+	{0x119DA, 0x119DB, bidiNSM},
+	{0x119E0, 0x119E0, bidiNSM},
+	{0x11A01, 0x11A06, bidiNSM},
+	{0x11A09, 0x11A0A, bidiNSM},
+	{0x11A33, 0x11A38, bidiNSM},
+	{0x11A3B, 0x11A3E, bidiNSM},
+	{0x11A47, 0x11A47, bidiNSM},
+	{0x11A51, 0x11A56, bidiNSM},
+	{0x11A59, 0x11A5B, bidiNSM},
+	{0x11A8A, 0x11A96, bidiNSM},
+	{0x11A98, 0x11A99, bidiNSM},
+	{0x11C30, 0x11C36, bidiNSM},
+	{0x11C38, 0x11C3D, bidiNSM},
+	{0x11C92, 0x11CA7, bidiNSM},
+	{0x11CAA, 0x11CB0, bidiNSM},
+	{0x11CB2, 0x11CB3, bidiNSM},
+	{0x11CB5, 0x11CB6, bidiNSM},
+	{0x11D31, 0x11D36, bidiNSM},
+	{0x11D3A, 0x11D3A, bidiNSM},
+	{0x11D3C, 0x11D3D, bidiNSM},
+	{0x11D3F, 0x11D45, bidiNSM},
+	{0x11D47, 0x11D47, bidiNSM},
+	{0x11D90, 0x11D91, bidiNSM},
+	{0x11D95, 0x11D95, bidiNSM},
+	{0x11D97, 0x11D97, bidiNSM},
+	{0x11EF3, 0x11EF4, bidiNSM},
+	{0x11F00, 0x11F01, bidiNSM},
+	{0x11F36, 0x11F3A, bidiNSM},
+	{0x11F40, 0x11F40, bidiNSM},
+	{0x11F42, 0x11F42, bidiNSM},
+	{0x11FD5, 0x11FDC, bidiON},
+	{0x11FDD, 0x11FE0, bidiET},
+	{0x11FE1, 0x11FF1, bidiON},
+	{0x13440, 0x13440, bidiNSM},
+	{0x13447, 0x13455, bidiNSM},
+	{0x16AF0, 0x16AF4, bidiNSM},
+	{0x16B30, 0x16B36, bidiNSM},
+	{0x16F4F, 0x16F4F, bidiNSM},
+	{0x16F8F, 0x16F92, bidiNSM},
+	{0x16FE2, 0x16FE2, bidiON},
+	{0x16FE4, 0x16FE4, bidiNSM},
+	{0x1BC9D, 0x1BC9E, bidiNSM},
+	{0x1BCA0, 0x1BCA3, bidiBN},
+	{0x1CF00, 0x1CF2D, bidiNSM},
+	{0x1CF30, 0x1CF46, bidiNSM},
+	{0x1D167, 0x1D169, bidiNSM},
+	{0x1D173, 0x1D17A, bidiBN},
+	{0x1D17B, 0x1D182, bidiNSM},
+	{0x1D185, 0x1D18B, bidiNSM},
+	{0x1D1AA, 0x1D1AD, bidiNSM},
+	{0x1D1E9, 0x1D1EA, bidiON},
+	{0x1D200, 0x1D241, bidiON},
+	{0x1D242, 0x1D244, bidiNSM},
+	{0x1D245, 0x1D245, bidiON},
+	{0x1D300, 0x1D356, bidiON},
+	{0x1D6DB, 0x1D6DB, bidiON},
+	{0x1D715, 0x1D715, bidiON},
+	{0x1D74F, 0x1D74F, bidiON},
+	{0x1D789, 0x1D789, bidiON},
+	{0x1D7C3, 0x1D7C3, bidiON},
+	{0x1D7CE, 0x1D7FF, bidiEN},
+	{0x1DA00, 0x1DA36, bidiNSM},
+	{0x1DA3B, 0x1DA6C, bidiNSM},
+	{0x1DA75, 0x1DA75, bidiNSM},
+	{0x1DA84, 0x1DA84, bidiNSM},
+	{0x1DA9B, 0x1DA9F, bidiNSM},
+	{0x1DAA1, 0x1DAAF, bidiNSM},
+	{0x1E000, 0x1E006, bidiNSM},
+	{0x1E008, 0x1E018, bidiNSM},
+	{0x1E01B, 0x1E021, bidiNSM},
+	{0x1E023, 0x1E024, bidiNSM},
+	{0x1E026, 0x1E02A, bidiNSM},
+	{0x1E08F, 0x1E08F, bidiNSM},
+	{0x1E130, 0x1E136, bidiNSM},
+	{0x1E2AE, 0x1E2AE, bidiNSM},
+	{0x1E2EC, 0x1E2EF, bidiNSM},
+	{0x1E2FF, 0x1E2FF, bidiET},
+	{0x1E4EC, 0x1E4EF, bidiNSM},
+	{0x1E800, 0x1E8C4, bidiR},
+	{0x1E8C7, 0x1E8CF, bidiR},
+	{0x1E8D0, 0x1E8D6, bidiNSM},
+	{0x1E900, 0x1E943, bidiR},
+	{0x1E944, 0x1E94A, bidiNSM},
+	{0x1E94B, 0x1E94B, bidiR},
+	{0x1E950, 0x1E959, bidiR},
+	{0x1E95E, 0x1E95F, bidiR},
+	{0x1EC71, 0x1ECB4, bidiAL},
+	{0x1ED01, 0x1ED3D, bidiAL},
+	{0x1EE00, 0x1EE03, bidiAL},
+	{0x1EE05, 0x1EE1F, bidiAL},
+	{0x1EE21, 0x1EE22, bidiAL},
+	{0x1EE24, 0x1EE24, bidiAL},
+	{0x1EE27, 0x1EE27, bidiAL},
+	{0x1EE29, 0x1EE32, bidiAL},
+	{0x1EE34, 0x1EE37, bidiAL},
+	{0x1EE39, 0x1EE39, bidiAL},
+	{0x1EE3B, 0x1EE3B, bidiAL},
+	{0x1EE42, 0x1EE42, bidiAL},
+	{0x1EE47, 0x1EE47, bidiAL},
+	{0x1EE49, 0x1EE49, bidiAL},
+	{0x1EE4B, 0x1EE4B, bidiAL},
+	{0x1EE4D, 0x1EE4F, bidiAL},
+	{0x1EE51, 0x1EE52, bidiAL},
+	{0x1EE54, 0x1EE54, bidiAL},
+	{0x1EE57, 0x1EE57, bidiAL},
+	{0x1EE59, 0x1EE59, bidiAL},
+	{0x1EE5B, 0x1EE5B, bidiAL},
+	{0x1EE5D, 0x1EE5D, bidiAL},
+	{0x1EE5F, 0x1EE5F, bidiAL},
+	{0x1EE61, 0x1EE62, bidiAL},
+	{0x1EE64, 0x1EE64, bidiAL},
+	{0x1EE67, 0x1EE6A, bidiAL},
+	{0x1EE6C, 0x1EE72, bidiAL},
+	{0x1EE74, 0x1EE77, bidiAL},
+	{0x1EE79, 0x1EE7C, bidiAL},
+	{0x1EE7E, 0x1EE7E, bidiAL},
+	{0x1EE80, 0x1EE89, bidiAL},
+	{0x1EE8B, 0x1EE9B, bidiAL},
+	{0x1EEA1, 0x1EEA3, bidiAL},
+	{0x1EEA5, 0x1EEA9, bidiAL},
+	{0x1EEAB, 0x1EEBB, bidiAL},
+	{0x1EEF0, 0x1EEF1, bidiON},
+	{0x1F000, 0x1F02B, bidiON},
+	{0x1F030, 0x1F093, bidiON},
+	{0x1F0A0, 0x1F0AE, bidiON},
+	{0x1F0B1, 0x1F0BF, bidiON},
+	{0x1F0C1, 0x1F0CF, bidiON},
+	{0x1F0D1, 0x1F0F5, bidiON},
+	{0x1F100, 0x1F10A, bidiEN},
+	{0x1F10B, 0x1F10F, bidiON},
+	{0x1F12F, 0x1F12F, bidiON},
+	{0x1F16A, 0x1F16F, bidiON},
+	{0x1F1AD, 0x1F1AD, bidiON},
+	{0x1F260, 0x1F265, bidiON},
+	{0x1F300, 0x1F6D7, bidiON},
+	{0x1F6DC, 0x1F6EC, bidiON},
+	{0x1F6F0, 0x1F6FC, bidiON},
+	{0x1F700, 0x1F776, bidiON},
+	{0x1F77B, 0x1F7D9, bidiON},
+	{0x1F7E0, 0x1F7EB, bidiON},
+	{0x1F7F0, 0x1F7F0, bidiON},
+	{0x1F800, 0x1F80B, bidiON},
+	{0x1F810, 0x1F847, bidiON},
+	{0x1F850, 0x1F859, bidiON},
+	{0x1F860, 0x1F887, bidiON},
+	{0x1F890, 0x1F8AD, bidiON},
+	{0x1F8B0, 0x1F8B1, bidiON},
+	{0x1F900, 0x1FA53, bidiON},
+	{0x1FA60, 0x1FA6D, bidiON},
+	{0x1FA70, 0x1FA7C, bidiON},
+	{0x1FA80, 0x1FA88, bidiON},
+	{0x1FA90, 0x1FABD, bidiON},
+	{0x1FABF, 0x1FAC5, bidiON},
+	{0x1FACE, 0x1FADB, bidiON},
+	{0x1FAE0, 0x1FAE8, bidiON},
+	{0x1FAF0, 0x1FAF8, bidiON},
+	{0x1FB00, 0x1FB92, bidiON},
+	{0x1FB94, 0x1FBCA, bidiON},
+	{0x1FBF0, 0x1FBF9, bidiEN},
+	{0xE0001, 0xE0001, bidiBN},
+	{0xE0020, 0xE007F, bidiBN},
+	{0xE0100, 0xE01EF, bidiNSM},
+}
+
+// joiningTable lists the Joining_Type of the code points which are not U.
+var joiningTable = []propertyRange{
+	{0x00AD, 0x00AD, 'T'},
+	{0x0300, 0x036F, 'T'},
+	{0x0483, 0x0489, 'T'},
+	{0x0591, 0x05BD, 'T'},
+	{0x05BF, 0x05BF, 'T'},
+	{0x05C1, 0x05C2, 'T'},
+	{0x05C4, 0x05C5, 'T'},
+	{0x05C7, 0x05C7, 'T'},
+	{0x0610, 0x061A, 'T'},
+	{0x061C, 0x061C, 'T'},
+	{0x0620, 0x0620, 'D'},
+	{0x0622, 0x0625, 'R'},
+	{0x0626, 0x0626, 'D'},
+	{0x0627, 0x0627, 'R'},
+	{0x0628, 0x0628, 'D'},
+	{0x0629, 0x0629, 'R'},
+	{0x062A, 0x062E, 'D'},
+	{0x062F, 0x0632, 'R'},
+	{0x0633, 0x063F, 'D'},
+	{0x0640, 0x0640, 'C'},
+	{0x0641, 0x0647, 'D'},
+	{0x0648, 0x0648, 'R'},
+	{0x0649, 0x064A, 'D'},
+	{0x064B, 0x065F, 'T'},
+	{0x066E, 0x066F, 'D'},
+	{0x0670, 0x0670, 'T'},
+	{0x0671, 0x0673, 'R'},
+	{0x0675, 0x0677, 'R'},
+	{0x0678, 0x0687, 'D'},
+	{0x0688, 0x0699, 'R'},
+	{0x069A, 0x06BF, 'D'},
+	{0x06C0, 0x06C0, 'R'},
+	{0x06C1, 0x06C2, 'D'},
+	{0x06C3, 0x06CB, 'R'},
+	{0x06CC, 0x06CC, 'D'},
+	{0x06CD, 0x06CD, 'R'},
+	{0x06CE, 0x06CE, 'D'},
+	{0x06CF, 0x06CF, 'R'},
+	{0x06D0, 0x06D1, 'D'},
+	{0x06D2, 0x06D3, 'R'},
+	{0x06D5, 0x06D5, 'R'},
+	{0x06D6, 0x06DC, 'T'},
+	{0x06DF, 0x06E4, 'T'},
+	{0x06E7, 0x06E8, 'T'},
+	{0x06EA, 0x06ED, 'T'},
+	{0x06EE, 0x06EF, 'R'},
+	{0x06FA, 0x06FC, 'D'},
+	{0x06FF, 0x06FF, 'D'},
+	{0x070F, 0x070F, 'T'},
+	{0x0710, 0x0710, 'R'},
+	{0x0711, 0x0711, 'T'},
+	{0x0712, 0x0714, 'D'},
+	{0x0715, 0x0719, 'R'},
+	{0x071A, 0x071D, 'D'},
+	{0x071E, 0x071E, 'R'},
+	{0x071F, 0x0727, 'D'},
+	{0x0728, 0x0728, 'R'},
+	{0x0729, 0x0729, 'D'},
+	{0x072A, 0x072A, 'R'},
+	{0x072B, 0x072B, 'D'},
+	{0x072C, 0x072C, 'R'},
+	{0x072D, 0x072E, 'D'},
+	{0x072F, 0x072F, 'R'},
+	{0x0730, 0x074A, 'T'},
+	{0x074D, 0x074D, 'R'},
+	{0x074E, 0x0758, 'D'},
+	{0x0759, 0x075B, 'R'},
+	{0x075C, 0x076A, 'D'},
+	{0x076B, 0x076C, 'R'},
+	{0x076D, 0x0770, 'D'},
+	{0x0771, 0x0771, 'R'},
+	{0x0772, 0x0772, 'D'},
+	{0x0773, 0x0774, 'R'},
+	{0x0775, 0x0777, 'D'},
+	{0x0778, 0x0779, 'R'},
+	{0x077A, 0x077F, 'D'},
+	{0x07A6, 0x07B0, 'T'},
+	{0x07CA, 0x07EA, 'D'},
+	{0x07EB, 0x07F3, 'T'},
+	{0x07FA, 0x07FA, 'C'},
+	{0x07FD, 0x07FD, 'T'},
+	{0x0816, 0x0819, 'T'},
+	{0x081B, 0x0823, 'T'},
+	{0x0825, 0x0827, 'T'},
+	{0x0829, 0x082D, 'T'},
+	{0x0840, 0x0840, 'R'},
+	{0x0841, 0x0845, 'D'},
+	{0x0846, 0x0847, 'R'},
+	{0x0848, 0x0848, 'D'},
+	{0x0849, 0x0849, 'R'},
+	{0x084A, 0x0853, 'D'},
+	{0x0854, 0x0854, 'R'},
+	{0x0855, 0x0855, 'D'},
+	{0x0856, 0x0858, 'R'},
+	{0x0859, 0x085B, 'T'},
+	{0x0860, 0x0860, 'D'},
+	{0x0862, 0x0865, 'D'},
+	{0x0867, 0x0867, 'R'},
+	{0x0868, 0x0868, 'D'},
+	{0x0869, 0x086A, 'R'},
+	{0x0870, 0x0882, 'R'},
+	{0x0883, 0x0885, 'C'},
+	{0x0886, 0x0886, 'D'},
+	{0x0889, 0x088D, 'D'},
+	{0x088E, 0x088E, 'R'},
+	{0x0898, 0x089F, 'T'},
+	{0x08A0, 0x08A9, 'D'},
+	{0x08AA, 0x08AC, 'R'},
+	{0x08AE, 0x08AE, 'R'},
+	{0x08AF, 0x08B0, 'D'},
+	{0x08B1, 0x08B2, 'R'},
+	{0x08B3, 0x08B8, 'D'},
+	{0x08B9, 0x08B9, 'R'},
+	{0x08BA, 0x08C8, 'D'},
+	{0x08CA, 0x08E1, 'T'},
+	{0x08E3, 0x0902, 'T'},
+	{0x093A, 0x093A, 'T'},
+	{0x093C, 0x093C, 'T'},
+	{0x0941, 0x0948, 'T'},
+	{0x094D, 0x094D, 'T'},
+	{0x0951, 0x0957, 'T'},
+	{0x0962, 0x0963, 'T'},
+	{0x0981, 0x0981, 'T'},
+	{0x09BC, 0x09BC, 'T'},
+	{0x09C1, 0x09C4, 'T'},
+	{0x09CD, 0x09CD, 'T'},
+	{0x09E2, 0x09E3, 'T'},
+	{0x09FE, 0x09FE, 'T'},
+	{0x0A01, 0x0A02, 'T'},
+	{0x0A3C, 0x0A3C, 'T'},
+	{0x0A41, 0x0A42, 'T'},
+	{0x0A47, 0x0A48, 'T'},
+	{0x0A4B, 0x0A4D, 'T'},
+	{0x0A51, 0x0A51, 'T'},
+	{0x0A70, 0x0A71, 'T'},
+	{0x0A75, 0x0A75, 'T'},
+	{0x0A81, 0x0A82, 'T'},
+	{0x0ABC, 0x0ABC, 'T'},
+	{0x0AC1, 0x0AC5, 'T'},
+	{0x0AC7, 0x0AC8, 'T'},
+	{0x0ACD, 0x0ACD, 'T'},
+	{0x0AE2, 0x0AE3, 'T'},
+	{0x0AFA, 0x0AFF, 'T'},
+	{0x0B01, 0x0B01, 'T'},
+	{0x0B3C, 0x0B3C, 'T'},
+	{0x0B3F, 0x0B3F, 'T'},
+	{0x0B41, 0x0B44, 'T'},
+	{0x0B4D, 0x0B4D, 'T'},
+	{0x0B55, 0x0B56, 'T'},
+	{0x0B62, 0x0B63, 'T'},
+	{0x0B82, 0x0B82, 'T'},
+	{0x0BC0, 0x0BC0, 'T'},
+	{0x0BCD, 0x0BCD, 'T'},
+	{0x0C00, 0x0C00, 'T'},
+	{0x0C04, 0x0C04, 'T'},
+	{0x0C3C, 0x0C3C, 'T'},
+	{0x0C3E, 0x0C40, 'T'},
+	{0x0C46, 0x0C48, 'T'},
+	{0x0C4A, 0x0C4D, 'T'},
+	{0x0C55, 0x0C56, 'T'},
+	{0x0C62, 0x0C63, 'T'},
+	{0x0C81, 0x0C81, 'T'},
+	{0x0CBC, 0x0CBC, 'T'},
+	{0x0CBF, 0x0CBF, 'T'},
+	{0x0CC6, 0x0CC6, 'T'},
+	{0x0CCC, 0x0CCD, 'T'},
+	{0x0CE2, 0x0CE3, 'T'},
+	{0x0D00, 0x0D01, 'T'},
+	{0x0D3B, 0x0D3C, 'T'},
+	{0x0D41, 0x0D44, 'T'},
+	{0x0D4D, 0x0D4D, 'T'},
+	{0x0D62, 0x0D63, 'T'},
+	{0x0D81, 0x0D81, 'T'},
+	{0x0DCA, 0x0DCA, 'T'},
+	{0x0DD2, 0x0DD4, 'T'},
+	{0x0DD6, 0x0DD6, 'T'},
+	{0x0E31, 0x0E31, 'T'},
+	{0x0E34, 0x0E3A, 'T'},
+	{0x0E47, 0x0E4E, 'T'},
+	{0x0EB1, 0x0EB1, 'T'},
+	{0x0EB4, 0x0EBC, 'T'},
+	{0x0EC8, 0x0ECE, 'T'},
+	{0x0F18, 0x0F19, 'T'},
+	{0x0F35, 0x0F35, 'T'},
+	{0x0F37, 0x0F37, 'T'},
+	{0x0F39, 0x0F39, 'T'},
+	{0x0F71, 0x0F7E, 'T'},
+	{0x0F80, 0x0F84, 'T'},
+	{0x0F86, 0x0F87, 'T'},
+	{0x0F8D, 0x0F97, 'T'},
+	{0x0F99, 0x0FBC, 'T'},
+	{0x0FC6, 0x0FC6, 'T'},
+	{0x102D, 0x1030, 'T'},
+	{0x1032, 0x1037, 'T'},
+	{0x1039, 0x103A, 'T'},
+	{0x103D, 0x103E, 'T'},
+	{0x1058, 0x1059, 'T'},
+	{0x105E, 0x1060, 'T'},
+	{0x1071, 0x1074, 'T'},
+	{0x1082, 0x1082, 'T'},
+	{0x1085, 0x1086, 'T'},
+	{0x108D, 0x108D, 'T'},
+	{0x109D, 0x109D, 'T'},
+	{0x135D, 0x135F, 'T'},
+	{0x1712, 0x1714, 'T'},
+	{0x1732, 0x1733, 'T'},
+	{0x1752, 0x1753, 'T'},
+	{0x1772, 0x1773, 'T'},
+	{0x17B4, 0x17B5, 'T'},
+	{0x17B7, 0x17BD, 'T'},
+	{0x17C6, 0x17C6, 'T'},
+	{0x17C9, 0x17D3, 'T'},
+	{0x17DD, 0x17DD, 'T'},
+	{0x1807, 0x1807, 'D'},
+	{0x180A, 0x180A, 'C'},
+	{0x180B, 0x180D, 'T'},
+	{0x180F, 0x180F, 'T'},
+	{0x1820, 0x1878, 'D'},
+	{0x1885, 0x1886, 'T'},
+	{0x1887, 0x18A8, 'D'},
+	{0x18A9, 0x18A9, 'T'},
+	{0x18AA, 0x18AA, 'D'},
+	{0x1920, 0x1922, 'T'},
+	{0x1927, 0x1928, 'T'},
+	{0x1932, 0x1932, 'T'},
+	{0x1939, 0x193B, 'T'},
+	{0x1A17, 0x1A18, 'T'},
+	{0x1A1B, 0x1A1B, 'T'},
+	{0x1A56, 0x1A56, 'T'},
+	{0x1A58, 0x1A5E, 'T'},
+	{0x1A60, 0x1A60, 'T'},
+	{0x1A62, 0x1A62, 'T'},
+	{0x1A65, 0x1A6C, 'T'},
+	{0x1A73, 0x1A7C, 'T'},
+	{0x1A7F, 0x1A7F, 'T'},
+	{0x1AB0, 0x1ACE, 'T'},
+	{0x1B00, 0x1B03, 'T'},
+	{0x1B34, 0x1B34, 'T'},
+	{0x1B36, 0x1B3A, 'T'},
+	{0x1B3C, 0x1B3C, 'T'},
+	{0x1B42, 0x1B42, 'T'},
+	{0x1B6B, 0x1B73, 'T'},
+	{0x1B80, 0x1B81, 'T'},
+	{0x1BA2, 0x1BA5, 'T'},
+	{0x1BA8, 0x1BA9, 'T'},
+	{0x1BAB, 0x1BAD, 'T'},
+	{0x1BE6, 0x1BE6, 'T'},
+	{0x1BE8, 0x1BE9, 'T'},
+	{0x1BED, 0x1BED, 'T'},
+	{0x1BEF, 0x1BF1, 'T'},
+	{0x1C2C, 0x1C33, 'T'},
+	{0x1C36, 0x1C37, 'T'},
+	{0x1CD0, 0x1CD2, 'T'},
+	{0x1CD4, 0x1CE0, 'T'},
+	{0x1CE2, 0x1CE8, 'T'},
+	{0x1CED, 0x1CED, 'T'},
+	{0x1CF4, 0x1CF4, 'T'},
+	{0x1CF8, 0x1CF9, 'T'},
+	{0x1DC0, 0x1DFF, 'T'},
+	{0x200B, 0x200B, 'T'},
+	{0x200D, 0x200D, 'C'},
+	{0x200E, 0x200F, 'T'},
+	{0x202A, 0x202E, 'T'},
+	{0x2060, 0x2064, 'T'},
+	{0x206A, 0x206F, 'T'},
+	{0x20D0, 0x20F0, 'T'},
+	{0x2CEF, 0x2CF1, 'T'},
+	{0x2D7F, 0x2D7F, 'T'},
+	{0x2DE0, 0x2DFF, 'T'},
+	{0x302A, 0x302D, 'T'},
+	{0x3099, 0x309A, 'T'},
+	{0xA66F, 0xA672, 'T'},
+	{0xA674, 0xA67D, 'T'},
+	{0xA69E, 0xA69F, 'T'},
+	{0xA6F0, 0xA6F1, 'T'},
+	{0xA802, 0xA802, 'T'},
+	{0xA806, 0xA806, 'T'},
+	{0xA80B, 0xA80B, 'T'},
+	{0xA825, 0xA826, 'T'},
+	{0xA82C, 0xA82C, 'T'},
+	{0xA840, 0xA871, 'D'},
+	{0xA872, 0xA872, 'L'},
+	{0xA8C4, 0xA8C5, 'T'},
+	{0xA8E0, 0xA8F1, 'T'},
+	{0xA8FF, 0xA8FF, 'T'},
+	{0xA926, 0xA92D, 'T'},
+	{0xA947, 0xA951, 'T'},
+	{0xA980, 0xA982, 'T'},
+	{0xA9B3, 0xA9B3, 'T'},
+	{0xA9B6, 0xA9B9, 'T'},
+	{0xA9BC, 0xA9BD, 'T'},
+	{0xA9E5, 0xA9E5, 'T'},
+	{0xAA29, 0xAA2E, 'T'},
+	{0xAA31, 0xAA32, 'T'},
+	{0xAA35, 0xAA36, 'T'},
+	{0xAA43, 0xAA43, 'T'},
+	{0xAA4C, 0xAA4C, 'T'},
+	{0xAA7C, 0xAA7C, 'T'},
+	{0xAAB0, 0xAAB0, 'T'},
+	{0xAAB2, 0xAAB4, 'T'},
+	{0xAAB7, 0xAAB8, 'T'},
+	{0xAABE, 0xAABF, 'T'},
+	{0xAAC1, 0xAAC1, 'T'},
+	{0xAAEC, 0xAAED, 'T'},
+	{0xAAF6, 0xAAF6, 'T'},
+	{0xABE5, 0xABE5, 'T'},
+	{0xABE8, 0xABE8, 'T'},
+	{0xABED, 0xABED, 'T'},
+	{0xFB1E, 0xFB1E, 'T'},
+	{0xFE00, 0xFE0F, 'T'},
+	{0xFE20, 0xFE2F, 'T'},
+	{0xFEFF, 0xFEFF, 'T'},
+	{0xFFF9, 0xFFFB, 'T'},
+	{0x101FD, 0x101FD, 'T'},
+	{0x102E0, 0x102E0, 'T'},
+	{0x10376, 0x1037A, 'T'},
+	{0x10A01, 0x10A03, 'T'},
+	{0x10A05, 0x10A06, 'T'},
+	{0x10A0C, 0x10A0F, 'T'},
+	{0x10A38, 0x10A3A, 'T'},
+	{0x10A3F, 0x10A3F, 'T'},
+	{0x10AC0, 0x10AC4, 'D'},
+	{0x10AC5, 0x10AC5, 'R'},
+	{0x10AC7, 0x10AC7, 'R'},
+	{0x10AC9, 0x10ACA, 'R'},
+	{0x10ACD, 0x10ACD, 'L'},
+	{0x10ACE, 0x10AD2, 'R'},
+	{0x10AD3, 0x10AD6, 'D'},
+	{0x10AD7, 0x10AD7, 'L'},
+	{0x10AD8, 0x10ADC, 'D'},
+	{0x10ADD, 0x10ADD, 'R'},
+	{0x10ADE, 0x10AE0, 'D'},
+	{0x10AE1, 0x10AE1, 'R'},
+	{0x10AE4, 0x10AE4, 'R'},
+	{0x10AE5, 0x10AE6, 'T'},
+	{0x10AEB, 0x10AEE, 'D'},
+	{0x10AEF, 0x10AEF, 'R'},
+	{0x10B80, 0x10B80, 'D'},
+	{0x10B81, 0x10B81, 'R'},
+	{0x10B82, 0x10B82, 'D'},
+	{0x10B83, 0x10B85, 'R'},
+	{0x10B86, 0x10B88, 'D'},
+	{0x10B89, 0x10B89, 'R'},
+	{0x10B8A, 0x10B8B, 'D'},
+	{0x10B8C, 0x10B8C, 'R'},
+	{0x10B8D, 0x10B8D, 'D'},
+	{0x10B8E, 0x10B8F, 'R'},
+	{0x10B90, 0x10B90, 'D'},
+	{0x10B91, 0x10B91, 'R'},
+	{0x10BA9, 0x10BAC, 'R'},
+	{0x10BAD, 0x10BAE, 'D'},
+	{0x10D00, 0x10D00, 'L'},
+	{0x10D01, 0x10D21, 'D'},
+	{0x10D22, 0x10D22, 'R'},
+	{0x10D23, 0x10D23, 'D'},
+	{0x10D24, 0x10D27, 'T'},
+	{0x10EAB, 0x10EAC, 'T'},
+	{0x10EFD, 0x10EFF, 'T'},
+	{0x10F30, 0x10F32, 'D'},
+	{0x10F33, 0x10F33, 'R'},
+	{0x10F34, 0x10F44, 'D'},
+	{0x10F46, 0x10F50, 'T'},
+	{0x10F51, 0x10F53, 'D'},
+	{0x10F54, 0x10F54, 'R'},
+	{0x10F70, 0x10F73, 'D'},
+	{0x10F74, 0x10F75, 'R'},
+	{0x10F76, 0x10F81, 'D'},
+	{0x10F82, 0x10F85, 'T'},
+	{0x10FB0, 0x10FB0, 'D'},
+	{0x10FB2, 0x10FB3, 'D'},
+	{0x10FB4, 0x10FB6, 'R'},
+	{0x10FB8, 0x10FB8, 'D'},
+	{0x10FB9, 0x10FBA, 'R'},
+	{0x10FBB, 0x10FBC, 'D'},
+	{0x10FBD, 0x10FBD, 'R'},
+	{0x10FBE, 0x10FBF, 'D'},
+	{0x10FC1, 0x10FC1, 'D'},
+	{0x10FC2, 0x10FC3, 'R'},
+	{0x10FC4, 0x10FC4, 'D'},
+	{0x10FC9, 0x10FC9, 'R'},
+	{0x10FCA, 0x10FCA, 'D'},
+	{0x10FCB, 0x10FCB, 'L'},
+	{0x11001, 0x11001, 'T'},
+	{0x11038, 0x11046, 'T'},
+	{0x11070, 0x11070, 'T'},
+	{0x11073, 0x11074, 'T'},
+	{0x1107F, 0x11081, 'T'},
+	{0x110B3, 0x110B6, 'T'},
+	{0x110B9, 0x110BA, 'T'},
+	{0x110C2, 0x110C2, 'T'},
+	{0x11100, 0x11102, 'T'},
+	{0x11127, 0x1112B, 'T'},
+	{0x1112D, 0x11134, 'T'},
+	{0x11173, 0x11173, 'T'},
+	{0x11180, 0x11181, 'T'},
+	{0x111B6, 0x111BE, 'T'},
+	{0x111C9, 0x111CC, 'T'},
+	{0x111CF, 0x111CF, 'T'},
+	{0x1122F, 0x11231, 'T'},
+	{0x11234, 0x11234, 'T'},
+	{0x11236, 0x11237, 'T'},
+	{0x1123E, 0x1123E, 'T'},
+	{0x11241, 0x11241, 'T'},
+	{0x112DF, 0x112DF, 'T'},
+	{0x112E3, 0x112EA, 'T'},
+	{0x11300, 0x11301, 'T'},
+	{0x1133B, 0x1133C, 'T'},
+	{0x11340, 0x11340, 'T'},
+	{0x11366, 0x1136C, 'T'},
+	{0x11370, 0x11374, 'T'},
+	{0x11438, 0x1143F, 'T'},
+	{0x11442, 0x11444, 'T'},
+	{0x11446, 0x11446, 'T'},
+	{0x1145E, 0x1145E, 'T'},
+	{0x114B3, 0x114B8, 'T'},
+	{0x114BA, 0x114BA, 'T'},
+	{0x114BF, 0x114C0, 'T'},
+	{0x114C2, 0x114C3, 'T'},
+	{0x115B2, 0x115B5, 'T'},
+	{0x115BC, 0x115BD, 'T'},
+	{0x115BF, 0x115C0, 'T'},
+	{0x115DC, 0x115DD, 'T'},
+	{0x11633, 0x1163A, 'T'},
+	{0x1163D, 0x1163D, 'T'},
+	{0x1163F, 0x11640, 'T'},
+	{0x116AB, 0x116AB, 'T'},
+	{0x116AD, 0x116AD, 'T'},
+	{0x116B0, 0x116B5, 'T'},
+	{0x116B7, 0x116B7, 'T'},
+	{0x1171D, 0x1171F, 'T'},
+	{0x11722, 0x11725, 'T'},
+	{0x11727, 0x1172B, 'T'},
+	{0x1182F, 0x11837, 'T'},
+	{0x11839, 0x1183A, 'T'},
+	{0x1193B, 0x1193C, 'T'},
+	{0x1193E, 0x1193E, 'T'},
+	{0x11943, 0x11943, 'T'},
+	{0x119D4, 0x119D7, 'T'},
+	{0x119DA, 0x119DB, 'T'},
+	{0x119E0, 0x119E0, 'T'},
+	{0x11A01, 0x11A0A, 'T'},
+	{0x11A33, 0x11A38, 'T'},
+	{0x11A3B, 0x11A3E, 'T'},
+	{0x11A47, 0x11A47, 'T'},
+	{0x11A51, 0x11A56, 'T'},
+	{0x11A59, 0x11A5B, 'T'},
+	{0x11A8A, 0x11A96, 'T'},
+	{0x11A98, 0x11A99, 'T'},
+	{0x11C30, 0x11C36, 'T'},
+	{0x11C38, 0x11C3D, 'T'},
+	{0x11C3F, 0x11C3F, 'T'},
+	{0x11C92, 0x11CA7, 'T'},
+	{0x11CAA, 0x11CB0, 'T'},
+	{0x11CB2, 0x11CB3, 'T'},
+	{0x11CB5, 0x11CB6, 'T'},
+	{0x11D31, 0x11D36, 'T'},
+	{0x11D3A, 0x11D3A, 'T'},
+	{0x11D3C, 0x11D3D, 'T'},
+	{0x11D3F, 0x11D45, 'T'},
+	{0x11D47, 0x11D47, 'T'},
+	{0x11D90, 0x11D91, 'T'},
+	{0x11D95, 0x11D95, 'T'},
+	{0x11D97, 0x11D97, 'T'},
+	{0x11EF3, 0x11EF4, 'T'},
+	{0x11F00, 0x11F01, 'T'},
+	{0x11F36, 0x11F3A, 'T'},
+	{0x11F40, 0x11F40, 'T'},
+	{0x11F42, 0x11F42, 'T'},
+	{0x13430, 0x13440, 'T'},
+	{0x13447, 0x13455, 'T'},
+	{0x16AF0, 0x16AF4, 'T'},
+	{0x16B30, 0x16B36, 'T'},
+	{0x16F4F, 0x16F4F, 'T'},
+	{0x16F8F, 0x16F92, 'T'},
+	{0x16FE4, 0x16FE4, 'T'},
+	{0x1BC9D, 0x1BC9E, 'T'},
+	{0x1BCA0, 0x1BCA3, 'T'},
+	{0x1CF00, 0x1CF2D, 'T'},
+	{0x1CF30, 0x1CF46, 'T'},
+	{0x1D167, 0x1D169, 'T'},
+	{0x1D173, 0x1D182, 'T'},
+	{0x1D185, 0x1D18B, 'T'},
+	{0x1D1AA, 0x1D1AD, 'T'},
+	{0x1D242, 0x1D244, 'T'},
+	{0x1DA00, 0x1DA36, 'T'},
+	{0x1DA3B, 0x1DA6C, 'T'},
+	{0x1DA75, 0x1DA75, 'T'},
+	{0x1DA84, 0x1DA84, 'T'},
+	{0x1DA9B, 0x1DA9F, 'T'},
+	{0x1DAA1, 0x1DAAF, 'T'},
+	{0x1E000, 0x1E006, 'T'},
+	{0x1E008, 0x1E018, 'T'},
+	{0x1E01B, 0x1E021, 'T'},
+	{0x1E023, 0x1E024, 'T'},
+	{0x1E026, 0x1E02A, 'T'},
+	{0x1E08F, 0x1E08F, 'T'},
+	{0x1E130, 0x1E136, 'T'},
+	{0x1E2AE, 0x1E2AE, 'T'},
+	{0x1E2EC, 0x1E2EF, 'T'},
+	{0x1E4EC, 0x1E4EF, 'T'},
+	{0x1E8D0, 0x1E8D6, 'T'},
+	{0x1E900, 0x1E943, 'D'},
+	{0x1E944, 0x1E94B, 'T'},
+	{0xE0001, 0xE0001, 'T'},
+	{0xE0020, 0xE007F, 'T'},
+	{0xE0100, 0xE01EF, 'T'},
+}
@@ -0,0 +1,107 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPunycode(t *testing.T) {
+	vectors := map[string]string{
+		"bücher": "bcher-kva",
+		"한국":     "3e0b707e",
+		"세계의모든사람들이한국어를이해한다면얼마나좋을까": "989aomsvi5e83db1d2a355cv1e0vak1dwrv93d5xbh15a0dt30a5jpsd879ccm6fea98c",
+		"3年B組金八先生": "3B-ww4c5e180e575a65lsy2b",
+	}
+
+	for unicode, ascii := range vectors {
+		if encoded, err := punyEncode(unicode); err != nil || encoded != ascii {
+			t.Errorf("punyEncode(%q) = %q, %v, want %q", unicode, encoded, err, ascii)
+		}
+		if decoded, err := punyDecode(ascii); err != nil || decoded != unicode {
+			t.Errorf("punyDecode(%q) = %q, %v, want %q", ascii, decoded, err, unicode)
+		}
+	}
+
+	for _, invalid := range []string{"99999999999", "a-b!", "ü-a"} {
+		if _, err := punyDecode(invalid); err == nil {
+			t.Errorf("punyDecode(%q) succeeded", invalid)
+		}
+	}
+}
+
+func TestIDNAFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	domains := map[string]string{
+		"한국.kr":          "xn--3e0b707e.kr",
+		"도메인.한국":         "xn--hq1bm8jm9l.xn--3e0b707e",
+		"Bücher.Example": "xn--bcher-kva.example",
+		"münchen.de.":    "xn--mnchen-3ya.de.",
+		"日本語。ｊｐ":         "xn--wgv71a119e.jp",
+		"\u1112\u1161\u11ab\u1100\u116e\u11a8.kr": "xn--3e0b707e.kr",
+		"ex\u00adample.com":                       "example.com",
+		"xn--3e0b707e.kr":                         "xn--3e0b707e.kr",
+		"faß.de":                                  "xn--fa-hia.de",
+		"-bad.com":                                "",
+		"a..b":                                    "",
+		"under_score.com":                         "",
+		"ab--c.com":                               "",
+		"xn--zz-zzz.com":                          "",
+		"\u0301a.com":                             "",
+		"세계의모든사람들이한국어를이해한다면얼마나좋을까.kr": "",
+		"bu\u0308cher.de":             "xn--bcher-kva.de",
+		"\u212bngstr\u00f6m.se":       "xn--ngstrm-hua5l.se",
+		"\u0130stanbul.tr":            "xn--istanbul-o0e.tr",
+		"xn--00a.com":                 "",
+		"xn--bcher-kva-.de":           "",
+		"\u00bd.com":                  "",
+		"\u2603.net":                  "",
+		"\u0915\u094d\u200d\u0937.in": "xn--11b2ezcw70k.in",
+		"a\u200db.com":                "",
+		"\u0628\u200c\u0627.com":      "xn--mgbb899q.com",
+		"l\u00b7l.cat":                "xn--ll-0ea.cat",
+		"a\u00b7l.cat":                "",
+		"\u30fb\u30a2.jp":             "xn--cckyj.jp",
+		"\u30fba.jp":                  "",
+		"\u0661\u06f1.com":            "",
+		"\u05d0\u05d1.com":            "xn--4dbc.com",
+		"\u05d0a.com":                 "",
+		"\u05d0.1com":                 "",
+	}
+
+	for input, expected := range domains {
+		TextTemplateParseTest(&buffer, "{{ . | idnatoascii }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+
+	TextTemplateParseTest(&buffer, "{{ . | idnatounicode }}", "xn--hq1bm8jm9l.xn--3E0B707E")
+	AssertEqual(t, &buffer, "도메인.한국")
+
+	TextTemplateParseTest(&buffer, "{{ . | idnatounicode }}", "xn--zz-zzz.example.com")
+	AssertEqual(t, &buffer, "xn--zz-zzz.example.com")
+
+	// xn--00a decodes to an uppercase letter, which is not a valid label.
+	TextTemplateParseTest(&buffer, "{{ . | idnatounicode }}", "xn--00a.xn--x1a.com")
+	AssertEqual(t, &buffer, "xn--00a.ш.com")
+
+	ParseTest(&buffer, "{{ . | urlize }}", "방문: http://도메인.한국/경로?q=1 또는 www.한국.kr.")
+	AssertEqual(t, &buffer, `방문: <a href="http://xn--hq1bm8jm9l.xn--3e0b707e/%EA%B2%BD%EB%A1%9C?q=1" rel="nofollow">http://도메인.한국/경로?q=1</a> 또는 <a href="http://www.xn--3e0b707e.kr" rel="nofollow">www.한국.kr</a>.`)
+
+	ParseTest(&buffer, "{{ . | urlize }}", "https://xn--3e0b707e.kr")
+	AssertEqual(t, &buffer, `<a href="https://xn--3e0b707e.kr" rel="nofollow">https://한국.kr</a>`)
+
+	ParseTest(&buffer, "{{ . | urlize }}", "www.Example.COM/Path and HTTP://XN--3E0B707E.Kr/A")
+	AssertEqual(t, &buffer, `<a href="http://www.example.com/Path" rel="nofollow">www.Example.COM/Path</a> and <a href="HTTP://xn--3e0b707e.kr/A" rel="nofollow">HTTP://한국.Kr/A</a>`)
+
+	ParseTest(&buffer, "{{ . | urlize }}", "admin@도메인.kr")
+	AssertEqual(t, &buffer, `<a href="mailto:admin@xn--hq1bm8jm9l.kr">admin@도메인.kr</a>`)
+
+	TextTemplateParseTest(&buffer, "{{ . | iriencode }}", "https://user@한국.kr:8080/경로")
+	AssertEqual(t, &buffer, "https://user@xn--3e0b707e.kr:8080/%EA%B2%BD%EB%A1%9C")
+
+	TextTemplateParseTest(&buffer, "{{ . | urljoin \"/a\" }}", "https://한국.kr:8443/b")
+	AssertEqual(t, &buffer, "https://xn--3e0b707e.kr:8443/a")
+
+	TextTemplateParseTest(&buffer, "{{ . | urlsetquery \"q\" \"1\" }}", "https://한국.kr/")
+	AssertEqual(t, &buffer, "https://xn--3e0b707e.kr/?q=1")
+}
@@ -0,0 +1,379 @@
+package gtf
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// inflectionRule replaces the end of a lower case word matching pattern.
+type inflectionRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// inflectionRules holds the rules used by pluralword and singularize.
+// Rules are tried from the last one added to the first one, so rules
+// added later take precedence.
+type inflectionRules struct {
+	mu           sync.RWMutex
+	plurals      []inflectionRule
+	singulars    []inflectionRule
+	irregulars   map[string]string
+	singularOf   map[string]string
+	uncountables map[string]bool
+}
+
+func (r *inflectionRules) addRule(rules *[]inflectionRule, pattern, replacement string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	*rules = append(*rules, inflectionRule{re, replacement})
+
+	return nil
+}
+
+func (r *inflectionRules) addIrregular(singular, plural string) {
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.uncountables, singular)
+	delete(r.uncountables, plural)
+	r.irregulars[singular] = plural
+	r.singularOf[plural] = singular
+}
+
+func (r *inflectionRules) addUncountable(words ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, word := range words {
+		r.uncountables[strings.ToLower(word)] = true
+	}
+}
+
+// inflect converts a lower case word using the irregular table and the
+// rules. words maps words to their inflected form, and known lists the
+// words that are already inflected.
+func (r *inflectionRules) inflect(word string, rules []inflectionRule, words, known map[string]string) string {
+	if r.uncountables[word] {
+		return word
+	}
+	if inflected, ok := words[word]; ok {
+		return inflected
+	}
+	if _, ok := known[word]; ok {
+		return word
+	}
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].pattern.MatchString(word) {
+			return rules[i].pattern.ReplaceAllString(word, rules[i].replacement)
+		}
+	}
+
+	return word
+}
+
+func (r *inflectionRules) plural(word string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.inflect(word, r.plurals, r.irregulars, r.singularOf)
+}
+
+func (r *inflectionRules) singular(word string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.inflect(word, r.singulars, r.singularOf, r.irregulars)
+}
+
+var inflections = newInflectionRules()
+
+// newInflectionRules returns the default English rules, which follow the
+// inflections of Ruby on Rails.
+func newInflectionRules() *inflectionRules {
+	r := &inflectionRules{
+		irregulars:   map[string]string{},
+		singularOf:   map[string]string{},
+		uncountables: map[string]bool{},
+	}
+
+	for _, rule := range [][2]string{
+		{`$`, `s`},
+		{`s$`, `s`},
+		{`^(ax|test)is$`, `${1}es`},
+		{`(octop|vir)us$`, `${1}i`},
+		{`(octop|vir)i$`, `${1}i`},
+		{`(alias|status|campus)$`, `${1}es`},
+		{`(bu)s$`, `${1}ses`},
+		{`(buffal|tomat|potat|her|ech|vet)o$`, `${1}oes`},
+		{`([ti])um$`, `${1}a`},
+		{`([ti])a$`, `${1}a`},
+		{`sis$`, `ses`},
+		{`([^f])fe$`, `${1}ves`},
+		{`([lr])f$`, `${1}ves`},
+		{`(hive)$`, `${1}s`},
+		{`([^aeiouy]|qu)y$`, `${1}ies`},
+		{`(x|ch|ss|sh)$`, `${1}es`},
+		{`(matr|vert|ind)(ix|ex)$`, `${1}ices`},
+		{`^(m|l)ouse$`, `${1}ice`},
+		{`^(m|l)ice$`, `${1}ice`},
+		{`(quiz)$`, `${1}zes`},
+	} {
+		r.plurals = append(r.plurals, inflectionRule{regexp.MustCompile(rule[0]), rule[1]})
+	}
+
+	for _, rule := range [][2]string{
+		{`s$`, ``},
+		{`(ss)$`, `${1}`},
+		{`(n)ews$`, `${1}ews`},
+		{`([ti])a$`, `${1}um`},
+		{`(analy|ba|diagno|parenthe|progno|synop|the)(sis|ses)$`, `${1}sis`},
+		{`([^f])ves$`, `${1}fe`},
+		{`(hive)s$`, `${1}`},
+		{`(tive)s$`, `${1}`},
+		{`([lr])ves$`, `${1}f`},
+		{`([^aeiouy]|qu)ies$`, `${1}y`},
+		{`(s)eries$`, `${1}eries`},
+		{`(m)ovies$`, `${1}ovie`},
+		{`(x|ch|ss|sh)es$`, `${1}`},
+		{`^(m|l)ice$`, `${1}ouse`},
+		{`(bus)(es)?$`, `${1}`},
+		{`(o)es$`, `${1}`},
+		{`(shoe)s$`, `${1}`},
+		{`(cris|test)(is|es)$`, `${1}is`},
+		{`^(a)x[ie]s$`, `${1}xis`},
+		{`(octop|vir)(us|i)$`, `${1}us`},
+		{`(alias|status|campus)(es)?$`, `${1}`},
+		{`(vert|ind)ices$`, `${1}ex`},
+		{`(matr)ices$`, `${1}ix`},
+		{`(quiz)zes$`, `${1}`},
+		{`(database)s$`, `${1}`},
+	} {
+		r.singulars = append(r.singulars, inflectionRule{regexp.MustCompile(rule[0]), rule[1]})
+	}
+
+	for singular, plural := range map[string]string{
+		"person": "people", "man": "men", "woman": "women", "child": "children",
+		"foot": "feet", "tooth": "teeth", "goose": "geese", "ox": "oxen",
+		"criterion": "criteria", "phenomenon": "phenomena", "cactus": "cacti",
+		"move": "moves", "zombie": "zombies", "sex": "sexes", "die": "dice",
+		"leaf": "leaves", "thief": "thieves", "genus": "genera",
+	} {
+		r.addIrregular(singular, plural)
+	}
+
+	r.addUncountable("equipment", "information", "rice", "money", "species",
+		"series", "fish", "sheep", "deer", "news", "police", "metadata",
+		"software", "hardware", "feedback", "jeans", "moose", "aircraft",
+		"bison", "salmon", "trout", "offspring", "advice", "furniture")
+
+	return r
+}
+
+// AddPluralRule adds a rule used by pluralword. pattern is a regular
+// expression matched against the lower case word, and the match is
+// replaced by replacement, which may refer to submatches like ${1}.
+// Rules added later take precedence over earlier and built-in rules.
+func AddPluralRule(pattern, replacement string) error {
+	return inflections.addRule(&inflections.plurals, pattern, replacement)
+}
+
+// AddSingularRule adds a rule used by singularize, like AddPluralRule.
+func AddSingularRule(pattern, replacement string) error {
+	return inflections.addRule(&inflections.singulars, pattern, replacement)
+}
+
+// AddIrregular registers the plural form of a word which does not
+// follow the rules, like "person" and "people".
+func AddIrregular(singular, plural string) {
+	inflections.addIrregular(singular, plural)
+}
+
+// AddUncountable registers words which have no plural form, like
+// "information".
+func AddUncountable(words ...string) {
+	inflections.addUncountable(words...)
+}
+
+// isUpperWord reports whether s has letters and all of them are upper
+// case.
+func isUpperWord(s string) bool {
+	hasLetter := false
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return false
+		}
+		hasLetter = hasLetter || unicode.IsLetter(r)
+	}
+
+	return hasLetter
+}
+
+// splitLastWord splits s before the word which is inflected: the letters
+// at the end of s, starting at the last lower to upper case transition
+// so "UserAccount" is split into "User" and "Account".
+func splitLastWord(s string) (string, string) {
+	runes := []rune(s)
+	i := len(runes)
+	for i > 0 && unicode.IsLetter(runes[i-1]) {
+		i--
+		if i > 0 && unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1]) {
+			break
+		}
+	}
+
+	return string(runes[:i]), string(runes[i:])
+}
+
+// matchCase converts the lower case result of inflecting word back to the
+// case of word. The common beginning of both keeps the case of word, so
+// "iPhone" becomes "iPhones" and "Person" becomes "People".
+func matchCase(word, result string) string {
+	if isUpperWord(word) {
+		return strings.ToUpper(result)
+	}
+
+	original := []rune(word)
+	runes := []rune(result)
+	for i := 0; i < len(runes) && i < len(original); i++ {
+		if unicode.ToLower(original[i]) != runes[i] {
+			break
+		}
+		runes[i] = original[i]
+	}
+
+	return string(runes)
+}
+
+func pluralWord(s string) string {
+	head, word := splitLastWord(s)
+	if word == "" {
+		return s
+	}
+	if Initialisms[word] {
+		return s + "s"
+	}
+
+	return head + matchCase(word, inflections.plural(strings.ToLower(word)))
+}
+
+func singularize(s string) string {
+	head, word := splitLastWord(s)
+	if word == "" {
+		return s
+	}
+	if trimmed := strings.TrimSuffix(word, "s"); trimmed != word && Initialisms[trimmed] {
+		return head + trimmed
+	}
+
+	return head + matchCase(word, inflections.singular(strings.ToLower(word)))
+}
+
+// isOne reports whether value is the number 1.
+func isOne(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 1
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 1
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 1
+	}
+
+	return false
+}
+
+// countNoun returns the count followed by the singular or plural form of
+// word.
+func countNoun(word string, count interface{}) string {
+	if !isNumberKind(reflect.ValueOf(count).Kind()) {
+		return ""
+	}
+	if !isOne(count) {
+		word = pluralWord(word)
+	}
+
+	return fmt.Sprint(count) + " " + word
+}
+
+// silentH lists the beginnings of words starting with a silent h.
+var silentH = []string{"heir", "honest", "honor", "honour", "hour"}
+
+// consonantVowels lists the beginnings of words starting with a vowel
+// pronounced like a consonant, as in "a user" or "a one".
+var consonantVowels = []string{
+	"eu", "ewe", "once", "one", "ouija", "ubi", "uga", "uku", "ura", "ure",
+	"uri", "uro", "usa", "use", "usu", "ute", "uti", "uvu",
+}
+
+// anLetters lists the letters whose names start with a vowel sound.
+const anLetters = "aefhilmnorsx"
+
+// startsWithVowelSound guesses whether word is pronounced starting with a
+// vowel. Words in capitals of up to three letters and words without
+// vowels are treated as spelled-out abbreviations.
+func startsWithVowelSound(word string) bool {
+	lower := strings.ToLower(word)
+	first := []rune(lower)[0]
+
+	if unicode.IsDigit(first) {
+		digits := len(lower) - len(strings.TrimLeftFunc(lower, unicode.IsDigit))
+		// eight, eleven, eighteen, eleven thousand, eighteen million...
+		return first == '8' || (digits%3 == 2 && (strings.HasPrefix(lower, "11") || strings.HasPrefix(lower, "18")))
+	}
+
+	runes := []rune(word)
+	if len(runes) == 1 || (isUpperWord(word) && (len(runes) <= 3 || !strings.ContainsAny(lower, "aeiou"))) {
+		return strings.ContainsRune(anLetters, first)
+	}
+
+	for _, prefix := range silentH {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+	for _, prefix := range consonantVowels {
+		if strings.HasPrefix(lower, prefix) {
+			return false
+		}
+	}
+	// "unicorn" and "union", but "uninstalled", "unimportant" and
+	// "unidentified".
+	if strings.HasPrefix(lower, "uni") && !strings.HasPrefix(lower, "unin") &&
+		!strings.HasPrefix(lower, "unim") && !strings.HasPrefix(lower, "unid") {
+		return false
+	}
+
+	return strings.ContainsRune("aeiou", first)
+}
+
+// article prefixes s with "a" or "an" depending on the sound of its first
+// word.
+func article(s string) string {
+	word := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(word) == 0 {
+		return s
+	}
+
+	if startsWithVowelSound(word[0]) {
+		return "an " + s
+	}
+
+	return "a " + s
+}
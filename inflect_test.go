@@ -0,0 +1,145 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestInflectionFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	words := []struct {
+		singular, plural string
+	}{
+		{"user", "users"},
+		{"person", "people"},
+		{"Person", "People"},
+		{"index", "indices"},
+		{"matrix", "matrices"},
+		{"child", "children"},
+		{"mouse", "mice"},
+		{"box", "boxes"},
+		{"church", "churches"},
+		{"category", "categories"},
+		{"day", "days"},
+		{"knife", "knives"},
+		{"wolf", "wolves"},
+		{"leaf", "leaves"},
+		{"analysis", "analyses"},
+		{"status", "statuses"},
+		{"quiz", "quizzes"},
+		{"octopus", "octopi"},
+		{"potato", "potatoes"},
+		{"criterion", "criteria"},
+		{"medium", "media"},
+		{"sheep", "sheep"},
+		{"information", "information"},
+		{"user_account", "user_accounts"},
+		{"UserAccount", "UserAccounts"},
+		{"iPhone", "iPhones"},
+		{"PERSON", "PEOPLE"},
+		{"ID", "IDs"},
+		{"userID", "userIDs"},
+		{"database", "databases"},
+	}
+
+	for _, w := range words {
+		ParseTest(&buffer, "{{ . | pluralword }}", w.singular)
+		AssertEqual(t, &buffer, w.plural)
+
+		ParseTest(&buffer, "{{ . | singularize }}", w.plural)
+		AssertEqual(t, &buffer, w.singular)
+	}
+
+	ParseTest(&buffer, "{{ . | pluralword }}", "people")
+	AssertEqual(t, &buffer, "people")
+
+	ParseTest(&buffer, "{{ . | singularize }}", "person")
+	AssertEqual(t, &buffer, "person")
+
+	ParseTest(&buffer, "{{ . | pluralword 1 }}", "person")
+	AssertEqual(t, &buffer, "person")
+
+	ParseTest(&buffer, "{{ . | pluralword 2 }}", "person")
+	AssertEqual(t, &buffer, "people")
+
+	ParseTest(&buffer, "{{ . | pluralword }}", "v2")
+	AssertEqual(t, &buffer, "v2")
+
+	ParseTest(&buffer, "{{ . | countnoun \"person\" }}", 3)
+	AssertEqual(t, &buffer, "3 people")
+
+	ParseTest(&buffer, "{{ . | countnoun \"person\" }}", 1)
+	AssertEqual(t, &buffer, "1 person")
+
+	ParseTest(&buffer, "{{ . | countnoun \"file\" }}", 0)
+	AssertEqual(t, &buffer, "0 files")
+
+	ParseTest(&buffer, "{{ . | countnoun \"mile\" }}", 1.5)
+	AssertEqual(t, &buffer, "1.5 miles")
+
+	ParseTest(&buffer, "{{ . | countnoun \"file\" }}", "3")
+	AssertEqual(t, &buffer, "")
+
+	articles := map[string]string{
+		"user":          "a user",
+		"hour":          "an hour",
+		"honest man":    "an honest man",
+		"apple":         "an apple",
+		"banana":        "a banana",
+		"European trip": "a European trip",
+		"one-time code": "a one-time code",
+		"unicorn":       "a unicorn",
+		"uninstalled":   "an uninstalled",
+		"umbrella":      "an umbrella",
+		"FBI agent":     "an FBI agent",
+		"URL":           "a URL",
+		"SQL query":     "an SQL query",
+		"NASA mission":  "a NASA mission",
+		"8-bit value":   "an 8-bit value",
+		"11 item list":  "an 11 item list",
+		"110":           "a 110",
+		"18000":         "an 18000",
+		"":              "",
+	}
+
+	for input, expected := range articles {
+		TextTemplateParseTest(&buffer, "{{ . | article }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+}
+
+func TestInflectionRegistration(t *testing.T) {
+	var buffer bytes.Buffer
+
+	saved := inflections
+	inflections = newInflectionRules()
+	defer func() { inflections = saved }()
+
+	AddIrregular("cow", "kine")
+	AddUncountable("Pokemon")
+	if err := AddPluralRule(`(vert)ex$`, "${1}exes"); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddSingularRule(`(vert)exes$`, "${1}ex"); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddPluralRule(`(`, ""); err == nil {
+		t.Error("AddPluralRule accepted an invalid pattern")
+	}
+
+	ParseTest(&buffer, "{{ . | pluralword }}", "cow")
+	AssertEqual(t, &buffer, "kine")
+
+	ParseTest(&buffer, "{{ . | singularize }}", "kine")
+	AssertEqual(t, &buffer, "cow")
+
+	ParseTest(&buffer, "{{ . | pluralword }}", "pokemon")
+	AssertEqual(t, &buffer, "pokemon")
+
+	ParseTest(&buffer, "{{ . | pluralword }}", "vertex")
+	AssertEqual(t, &buffer, "vertexes")
+
+	ParseTest(&buffer, "{{ . | singularize }}", "vertexes")
+	AssertEqual(t, &buffer, "vertex")
+}
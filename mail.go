@@ -0,0 +1,83 @@
+package gtf
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+)
+
+// qpEncode encodes b with the quoted-printable encoding of RFC 2045.
+// Line breaks are written as CRLF and lines are wrapped at 76
+// characters with soft line breaks.
+func qpEncode(b []byte) string {
+	var buffer bytes.Buffer
+
+	w := quotedprintable.NewWriter(&buffer)
+	w.Write(b)
+	w.Close()
+
+	return buffer.String()
+}
+
+// qpDecode decodes quoted-printable encoded text.
+func qpDecode(s string) ([]byte, error) {
+	return ioutil.ReadAll(quotedprintable.NewReader(strings.NewReader(s)))
+}
+
+// mimeHeader encodes s as RFC 2047 encoded-words for use in a header
+// like Subject, if it contains non-ASCII or control characters. encoding
+// is "q" for the Q encoding, which keeps mostly ASCII text readable, or
+// "b" for base64.
+func mimeHeader(encoding string, s string) string {
+	if strings.ToLower(encoding) == "b" {
+		return mime.BEncoding.Encode("utf-8", s)
+	}
+
+	return mime.QEncoding.Encode("utf-8", s)
+}
+
+// mimeHeaderArgs parses the optional encoding argument of mimeheader,
+// which precedes the value.
+func mimeHeaderArgs(args []interface{}) string {
+	encoding := "q"
+	if len(args) > 1 {
+		encoding = args[0].(string)
+	}
+
+	return mimeHeader(encoding, args[len(args)-1].(string))
+}
+
+// mimeAddress formats a name and an email address as an RFC 5322
+// mailbox like "Name" <user@example.com>, for headers like From and To.
+// The name is quoted if needed and non-ASCII names are encoded as RFC
+// 2047 encoded-words. Invalid addresses result in ""(empty string), so
+// they can not inject headers.
+func mimeAddress(name, address string) string {
+	address = strings.TrimSpace(address)
+	if _, err := mail.ParseAddress("<" + address + ">"); err != nil {
+		return ""
+	}
+
+	return (&mail.Address{Name: name, Address: address}).String()
+}
+
+// emailObfuscate writes every character of an email address as an HTML
+// character reference, alternating between decimal and hexadecimal ones.
+// Browsers show the address, while simple address harvesters do not find
+// it in the page source.
+func emailObfuscate(s string) string {
+	var buffer bytes.Buffer
+	for i, r := range []rune(s) {
+		if i%2 == 0 {
+			fmt.Fprintf(&buffer, "&#%d;", r)
+		} else {
+			fmt.Fprintf(&buffer, "&#x%x;", r)
+		}
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,115 @@
+package gtf
+
+import (
+	"bytes"
+	"html"
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestQuotedPrintable(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | qpencode }}", "Grüße = 100%\n")
+	AssertEqual(t, &buffer, "Gr=C3=BC=C3=9Fe =3D 100%\r\n")
+
+	TextTemplateParseTest(&buffer, "{{ . | qpencode }}", strings.Repeat("a", 80))
+	AssertEqual(t, &buffer, strings.Repeat("a", 75)+"=\r\naaaaa")
+
+	TextTemplateParseTest(&buffer, "{{ . | qpdecode }}", "Gr=C3=BC=C3=9Fe =3D=\r\n 100%")
+	AssertEqual(t, &buffer, "Grüße = 100%")
+
+	// Like RFC 2045 recommends, invalid escapes are kept as they are.
+	TextTemplateParseTest(&buffer, "{{ . | qpdecode }}", "100 =ZZ")
+	AssertEqual(t, &buffer, "100 =ZZ")
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | qpencode | qpdecode }}", input)
+		expected := strings.Replace(input, "\r\n", "\n", -1)
+		if output := strings.Replace(buffer.String(), "\r\n", "\n", -1); output != expected {
+			t.Errorf("qpencode(%q) round trip = %q", input, output)
+		}
+		buffer.Reset()
+	}
+}
+
+func TestMIMEHeader(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "Subject: {{ . | mimeheader }}", "Hello")
+	AssertEqual(t, &buffer, "Subject: Hello")
+
+	TextTemplateParseTest(&buffer, "Subject: {{ . | mimeheader }}", "Grüße aus Köln")
+	AssertEqual(t, &buffer, "Subject: =?utf-8?q?Gr=C3=BC=C3=9Fe_aus_K=C3=B6ln?=")
+
+	TextTemplateParseTest(&buffer, "Subject: {{ . | mimeheader \"b\" }}", "안녕하세요")
+	AssertEqual(t, &buffer, "Subject: =?utf-8?b?7JWI64WV7ZWY7IS47JqU?=")
+
+	var decoder mime.WordDecoder
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | mimeheader }}", input)
+		output := buffer.String()
+		buffer.Reset()
+
+		if strings.ContainsAny(output, "\r\n") {
+			t.Errorf("mimeheader(%q) = %q contains a line break", input, output)
+		}
+		if decoded, err := decoder.DecodeHeader(output); err != nil || decoded != input {
+			t.Errorf("mimeheader(%q) round trip = %q, %v", input, decoded, err)
+		}
+	}
+}
+
+func TestMIMEAddress(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := []struct {
+		name, address, expected string
+	}{
+		{"Tom", "tom@example.com", `"Tom" <tom@example.com>`},
+		{"Smith, John", "john@example.com", `"Smith, John" <john@example.com>`},
+		{`Tom "T" Jerry`, "t@example.com", `"Tom \"T\" Jerry" <t@example.com>`},
+		{"Jörg Müller", "joerg@example.de", "=?utf-8?q?J=C3=B6rg_M=C3=BCller?= <joerg@example.de>"},
+		{"", " bare@example.com ", "<bare@example.com>"},
+		{"Evil\r\nBcc: victim@example.com", "a@example.com", "=?utf-8?b?RXZpbA0KQmNjOiB2aWN0aW1AZXhhbXBsZS5jb20=?= <a@example.com>"},
+		{"Evil", "a@example.com>\r\nBcc: victim@example.com", ""},
+		{"Nobody", "not an address", ""},
+	}
+
+	for _, c := range cases {
+		TextTemplateParseTest(&buffer, "{{ mimeaddress .Name .Address }}", map[string]string{"Name": c.name, "Address": c.address})
+		AssertEqual(t, &buffer, c.expected)
+
+		if c.expected == "" {
+			continue
+		}
+		address, err := mail.ParseAddress(c.expected)
+		if err != nil || address.Name != c.name || address.Address != strings.TrimSpace(c.address) {
+			t.Errorf("mimeaddress(%q, %q) does not parse back: %v, %v", c.name, c.address, address, err)
+		}
+	}
+}
+
+func TestEmailObfuscate(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "<p>{{ . | emailobfuscate }}</p>", "me@go.dev")
+	AssertEqual(t, &buffer, "<p>&#109;&#x65;&#64;&#x67;&#111;&#x2e;&#100;&#x65;&#118;</p>")
+
+	TextTemplateParseTest(&buffer, "{{ . | emailobfuscate }}", "<a>")
+	AssertEqual(t, &buffer, "&#60;&#x61;&#62;")
+
+	for _, input := range hostileInputs {
+		TextTemplateParseTest(&buffer, "{{ . | emailobfuscate }}", input)
+		output := buffer.String()
+		buffer.Reset()
+
+		// NUL can not be written as a character reference.
+		expected := strings.Replace(string([]rune(input)), "\x00", "\uFFFD", -1)
+		if strings.ContainsAny(output, "<>\"'") || html.UnescapeString(output) != expected {
+			t.Errorf("emailobfuscate(%q) = %q", input, output)
+		}
+	}
+}
@@ -0,0 +1,817 @@
+package gtf
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// mdExtensions holds the optional GitHub Flavored Markdown extensions.
+type mdExtensions struct {
+	tables        bool
+	strikethrough bool
+	autolinks     bool
+}
+
+// markdownArgs parses the extension names preceding the value of
+// markdown and markdowntext. "gfm" enables all extensions.
+func markdownArgs(args []interface{}) (mdExtensions, string) {
+	var ext mdExtensions
+	for _, arg := range args[:len(args)-1] {
+		switch arg.(string) {
+		case "tables":
+			ext.tables = true
+		case "strikethrough":
+			ext.strikethrough = true
+		case "autolinks":
+			ext.autolinks = true
+		case "gfm":
+			ext = mdExtensions{true, true, true}
+		}
+	}
+
+	return ext, args[len(args)-1].(string)
+}
+
+type mdBlockKind int
+
+const (
+	mdParagraph mdBlockKind = iota
+	mdHeading
+	mdThematicBreak
+	mdCodeBlock
+	mdHTMLBlock
+	mdBlockQuote
+	mdList
+	mdListItem
+	mdTable
+)
+
+// mdBlock is a block of a Markdown document.
+type mdBlock struct {
+	kind        mdBlockKind
+	text        string     // inline content, code or HTML
+	level       int        // heading level
+	info        string     // info string of fenced code blocks
+	ordered     bool       // whether a list is ordered
+	start       int        // start number of ordered lists
+	tight       bool       // whether list items are not separated by blank lines
+	align       []string   // alignment of table columns
+	rows        [][]string // table rows, the first one is the header
+	blankBefore bool       // whether a blank line precedes the block
+	children    []*mdBlock
+}
+
+// mdLinkRef is the target of a link reference definition.
+type mdLinkRef struct {
+	dest  string
+	title string
+}
+
+// mdParser parses Markdown following the CommonMark specification.
+type mdParser struct {
+	ext   mdExtensions
+	refs  map[string]mdLinkRef
+	depth int // nesting depth of block quotes and lists
+}
+
+// mdMaxNesting limits the nesting depth of block quotes and lists. Deeper
+// markers are parsed as text, so the time to parse a document stays
+// linear in its length.
+const mdMaxNesting = 100
+
+var (
+	mdThematicBreakPattern = regexp.MustCompile(`^(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	mdSetextPattern        = regexp.MustCompile(`^(?:=+|-+)[ \t]*$`)
+	mdFencePattern         = regexp.MustCompile("^(`{3,}|~{3,})(.*)$")
+	mdTableDelimPattern    = regexp.MustCompile(`^\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	mdLinkRefPattern       = regexp.MustCompile(`^ {0,3}\[((?:[^\[\]\\]|\\.){1,999})\]:[ \t]*\n?[ \t]*(<(?:[^<>\n\\]|\\.)*>|[^\s<>]\S*)(?:(?:[ \t]+|[ \t]*\n[ \t]*)("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|\((?:[^()\\]|\\.)*\)))?[ \t]*(?:\n|$)`)
+	mdHTMLBlockPatterns    = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)^<(?:script|pre|style|textarea)(?:[ \t>]|$)`),
+		regexp.MustCompile(`^<!--`),
+		regexp.MustCompile(`^<\?`),
+		regexp.MustCompile(`^<![A-Za-z]`),
+		regexp.MustCompile(`^<!\[CDATA\[`),
+		regexp.MustCompile(`(?i)^</?(?:address|article|aside|base|basefont|blockquote|body|caption|center|col|colgroup|dd|details|dialog|dir|div|dl|dt|fieldset|figcaption|figure|footer|form|frame|frameset|h[1-6]|head|header|hr|html|iframe|legend|li|link|main|menu|menuitem|nav|noframes|ol|optgroup|option|p|param|search|section|summary|table|tbody|td|tfoot|th|thead|title|tr|track|ul)(?:[ \t>]|/>|$)`),
+		regexp.MustCompile(`^(?:<[A-Za-z][A-Za-z0-9-]*(?:\s+[A-Za-z_:][A-Za-z0-9_.:-]*(?:\s*=\s*(?:[^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?)*\s*/?>|</[A-Za-z][A-Za-z0-9-]*\s*>)[ \t]*$`),
+	}
+	mdHTMLBlockEnds = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)</(?:script|pre|style|textarea)>`),
+		regexp.MustCompile(`-->`),
+		regexp.MustCompile(`\?>`),
+		regexp.MustCompile(`>`),
+		regexp.MustCompile(`\]\]>`),
+	}
+)
+
+// expandTabs replaces the tabs in the indentation of line with spaces,
+// using tab stops of 4 columns.
+func expandTabs(line string) string {
+	column := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			column++
+		case '\t':
+			column += 4 - column%4
+		default:
+			if column == i {
+				return line
+			}
+			return strings.Repeat(" ", column) + line[i:]
+		}
+	}
+
+	return strings.Repeat(" ", column)
+}
+
+func isBlankLine(line string) bool {
+	return strings.Trim(line, " \t") == ""
+}
+
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// removeIndent removes up to n spaces of indentation from line.
+func removeIndent(line string, n int) string {
+	if spaces := leadingSpaces(line); spaces < n {
+		n = spaces
+	}
+
+	return line[n:]
+}
+
+// normalizeLabel normalizes a link label for matching: case is folded and
+// whitespace is collapsed.
+func normalizeLabel(label string) string {
+	return strings.ToLower(strings.Join(strings.Fields(label), " "))
+}
+
+// mdListMarker describes the marker which starts a list item.
+type mdListMarker struct {
+	ordered bool
+	char    byte   // bullet character or delimiter of ordered lists
+	start   int    // number of ordered list items
+	indent  int    // column of the item content
+	content string // first line of the item content
+	empty   bool   // whether the first line has no content
+}
+
+// listMarker parses the list item marker starting line.
+func listMarker(line string) (mdListMarker, bool) {
+	var m mdListMarker
+
+	indent := leadingSpaces(line)
+	if indent >= 4 || mdThematicBreakPattern.MatchString(line[indent:]) {
+		return m, false
+	}
+	s := line[indent:]
+
+	end := 0
+	switch {
+	case s != "" && strings.IndexByte("-+*", s[0]) >= 0:
+		m.char = s[0]
+		end = 1
+	default:
+		for end < len(s) && end < 10 && '0' <= s[end] && s[end] <= '9' {
+			end++
+		}
+		if end == 0 || end > 9 || end >= len(s) || (s[end] != '.' && s[end] != ')') {
+			return m, false
+		}
+		m.ordered = true
+		m.start, _ = strconv.Atoi(s[:end])
+		m.char = s[end]
+		end++
+	}
+
+	rest := s[end:]
+	if rest != "" && rest[0] != ' ' {
+		return m, false
+	}
+
+	spaces := leadingSpaces(rest)
+	switch {
+	case isBlankLine(rest):
+		m.indent = indent + end + 1
+		m.empty = true
+	case spaces > 4:
+		m.indent = indent + end + 1
+		m.content = rest[1:]
+	default:
+		m.indent = indent + end + spaces
+		m.content = rest[spaces:]
+	}
+
+	return m, true
+}
+
+// fence returns the fence starting a fenced code block and its info
+// string.
+func fence(s string) (string, string, bool) {
+	m := mdFencePattern.FindStringSubmatch(s)
+	if m == nil || (m[1][0] == '`' && strings.Contains(m[2], "`")) {
+		return "", "", false
+	}
+
+	return m[1], strings.TrimSpace(m[2]), true
+}
+
+// atxHeading parses an ATX heading like "## Title ##".
+func atxHeading(s string) (int, string, bool) {
+	level := 0
+	for level < len(s) && s[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(s) && s[level] != ' ' && s[level] != '\t') {
+		return 0, "", false
+	}
+
+	text := strings.Trim(s[level:], " \t")
+	if trimmed := strings.TrimRight(text, "#"); trimmed == "" {
+		text = ""
+	} else if trimmed != text && (strings.HasSuffix(trimmed, " ") || strings.HasSuffix(trimmed, "\t")) {
+		text = strings.TrimRight(trimmed, " \t")
+	}
+
+	return level, text, true
+}
+
+// htmlBlockType returns the kind of HTML block started by s, numbered
+// from 1 like in the CommonMark specification, or 0.
+func htmlBlockType(s string, inParagraph bool) int {
+	for i, pattern := range mdHTMLBlockPatterns {
+		if pattern.MatchString(s) && !(i == 6 && inParagraph) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// interruptsParagraph reports whether line starts a block which ends a
+// paragraph.
+func (p *mdParser) interruptsParagraph(line string) bool {
+	indent := leadingSpaces(line)
+	if indent >= 4 {
+		return false
+	}
+	s := line[indent:]
+
+	if _, _, ok := fence(s); ok {
+		return true
+	}
+	if _, _, ok := atxHeading(s); ok {
+		return true
+	}
+	if m, ok := listMarker(line); ok && !m.empty && (!m.ordered || m.start == 1) {
+		return true
+	}
+
+	return mdThematicBreakPattern.MatchString(s) || strings.HasPrefix(s, ">") ||
+		htmlBlockType(s, true) != 0
+}
+
+// extractRefs parses the link reference definitions at the start of a
+// paragraph and returns the rest of it.
+func (p *mdParser) extractRefs(text string) string {
+	for {
+		m := mdLinkRefPattern.FindStringSubmatch(text)
+		if m == nil {
+			return text
+		}
+		label := normalizeLabel(m[1])
+		if label == "" {
+			return text
+		}
+
+		dest := m[2]
+		if strings.HasPrefix(dest, "<") {
+			dest = dest[1 : len(dest)-1]
+		}
+		title := m[3]
+		if title != "" {
+			title = title[1 : len(title)-1]
+		}
+
+		if _, ok := p.refs[label]; !ok {
+			p.refs[label] = mdLinkRef{unescapeMarkdown(dest), unescapeMarkdown(title)}
+		}
+		text = text[len(m[0]):]
+	}
+}
+
+// splitTableRow splits a table row into its cells. Escaped pipes are kept
+// in the cells without the backslash.
+func splitTableRow(s string) []string {
+	s = strings.Trim(s, " \t")
+	s = strings.TrimPrefix(s, "|")
+	if strings.HasSuffix(s, "|") && !strings.HasSuffix(s, `\|`) {
+		s = s[:len(s)-1]
+	}
+
+	var cells []string
+	var cell bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case s[i] == '|':
+			cells = append(cells, strings.Trim(cell.String(), " \t"))
+			cell.Reset()
+		default:
+			cell.WriteByte(s[i])
+		}
+	}
+
+	return append(cells, strings.Trim(cell.String(), " \t"))
+}
+
+// parseTable parses a table whose header is the line before lines[i],
+// returning the table and the number of lines after the header it spans.
+func (p *mdParser) parseTable(header string, lines []string, i int) (*mdBlock, int) {
+	delims := splitTableRow(lines[i])
+	cells := splitTableRow(header)
+	if len(cells) != len(delims) {
+		return nil, 0
+	}
+
+	table := &mdBlock{kind: mdTable, rows: [][]string{cells}}
+	for _, d := range delims {
+		switch {
+		case strings.HasPrefix(d, ":") && strings.HasSuffix(d, ":"):
+			table.align = append(table.align, "center")
+		case strings.HasPrefix(d, ":"):
+			table.align = append(table.align, "left")
+		case strings.HasSuffix(d, ":"):
+			table.align = append(table.align, "right")
+		default:
+			table.align = append(table.align, "")
+		}
+	}
+
+	j := i + 1
+	for ; j < len(lines) && !isBlankLine(lines[j]) && !p.interruptsParagraph(lines[j]); j++ {
+		row := splitTableRow(lines[j])
+		for len(row) < len(delims) {
+			row = append(row, "")
+		}
+		table.rows = append(table.rows, row[:len(delims)])
+	}
+
+	return table, j - i
+}
+
+// parseBlockQuote parses the block quote starting at lines[i], returning
+// it and the number of lines it spans.
+func (p *mdParser) parseBlockQuote(lines []string, i int) (*mdBlock, int) {
+	var inner []string
+
+	lazy := false
+	j := i
+	for ; j < len(lines); j++ {
+		line := lines[j]
+		indent := leadingSpaces(line)
+		if indent < 4 && strings.HasPrefix(line[indent:], ">") {
+			rest := line[indent+1:]
+			if strings.HasPrefix(rest, " ") {
+				rest = rest[1:]
+			}
+			inner = append(inner, rest)
+
+			_, _, isFence := fence(strings.TrimLeft(rest, " "))
+			lazy = !isBlankLine(rest) && leadingSpaces(rest) < 4 && !isFence
+			continue
+		}
+		if !lazy || isBlankLine(line) || p.interruptsParagraph(line) {
+			break
+		}
+		inner = append(inner, line)
+	}
+
+	p.depth++
+	defer func() { p.depth-- }()
+
+	return &mdBlock{kind: mdBlockQuote, children: p.parseBlocks(inner)}, j - i
+}
+
+// parseList parses the list starting at lines[i], returning it and the
+// number of lines it spans.
+func (p *mdParser) parseList(lines []string, i int, first mdListMarker) (*mdBlock, int) {
+	list := &mdBlock{kind: mdList, ordered: first.ordered, start: first.start, tight: true}
+
+	p.depth++
+	defer func() { p.depth-- }()
+
+	j, blank := i, 0
+	for j < len(lines) {
+		m, ok := listMarker(lines[j])
+		if !ok || m.ordered != first.ordered || m.char != first.char {
+			break
+		}
+		// Items separated by blank lines make the list loose.
+		if blank > 0 {
+			list.tight = false
+		}
+
+		item := []string{m.content}
+		lazy := !m.empty
+		k := j + 1
+		for ; k < len(lines); k++ {
+			line := lines[k]
+			if isBlankLine(line) {
+				if m.empty && k == j+1 {
+					break
+				}
+				item = append(item, "")
+				lazy = false
+				continue
+			}
+			if leadingSpaces(line) >= m.indent {
+				item = append(item, line[m.indent:])
+				lazy = leadingSpaces(line)-m.indent < 4
+				continue
+			}
+			if _, ok := listMarker(line); ok || !lazy || p.interruptsParagraph(line) {
+				break
+			}
+			item = append(item, line)
+		}
+
+		blank = 0
+		for len(item) > 1 && isBlankLine(item[len(item)-1]) {
+			item = item[:len(item)-1]
+			blank++
+		}
+
+		block := &mdBlock{kind: mdListItem, children: p.parseBlocks(item)}
+		for n, child := range block.children {
+			if n > 0 && child.blankBefore {
+				list.tight = false
+			}
+		}
+		list.children = append(list.children, block)
+		for j = k; j < len(lines) && isBlankLine(lines[j]); j++ {
+			blank++
+		}
+	}
+
+	// Blank lines after the last item are not part of the list.
+	return list, j - blank - i
+}
+
+// parseBlocks parses lines into blocks.
+func (p *mdParser) parseBlocks(lines []string) []*mdBlock {
+	var blocks []*mdBlock
+	var paragraph []string
+	blank := false
+
+	add := func(block *mdBlock) {
+		block.blankBefore = blank && len(blocks) > 0
+		blank = false
+		blocks = append(blocks, block)
+	}
+	flush := func() {
+		if paragraph == nil {
+			return
+		}
+		text := p.extractRefs(strings.Join(paragraph, "\n"))
+		paragraph = nil
+		if text != "" {
+			add(&mdBlock{kind: mdParagraph, text: strings.TrimRight(text, " \t")})
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlankLine(line) {
+			flush()
+			blank = true
+			i++
+			continue
+		}
+
+		indent := leadingSpaces(line)
+		if indent >= 4 {
+			if paragraph != nil {
+				paragraph = append(paragraph, strings.TrimLeft(line, " "))
+				i++
+				continue
+			}
+
+			var code []string
+			for j := i; j < len(lines) && (isBlankLine(lines[j]) || leadingSpaces(lines[j]) >= 4); j++ {
+				code = append(code, removeIndent(lines[j], 4))
+			}
+			for isBlankLine(code[len(code)-1]) {
+				code = code[:len(code)-1]
+			}
+			add(&mdBlock{kind: mdCodeBlock, text: strings.Join(code, "\n") + "\n"})
+			i += len(code)
+			continue
+		}
+		s := line[indent:]
+
+		if paragraph != nil && mdSetextPattern.MatchString(s) {
+			level := 1
+			if s[0] == '-' {
+				level = 2
+			}
+			text := p.extractRefs(strings.Join(paragraph, "\n"))
+			if text != "" {
+				paragraph = nil
+				add(&mdBlock{kind: mdHeading, level: level, text: strings.TrimRight(text, " \t")})
+				i++
+				continue
+			}
+			paragraph = nil
+		}
+
+		if p.ext.tables && paragraph != nil && strings.Contains(s, "|") && mdTableDelimPattern.MatchString(s) {
+			header := paragraph[len(paragraph)-1]
+			if table, n := p.parseTable(header, lines, i); table != nil {
+				paragraph = paragraph[:len(paragraph)-1]
+				if len(paragraph) == 0 {
+					paragraph = nil
+				}
+				flush()
+				add(table)
+				i += n
+				continue
+			}
+		}
+
+		if open, info, ok := fence(s); ok {
+			flush()
+			var code []string
+			j := i + 1
+			for ; j < len(lines); j++ {
+				l := lines[j]
+				if leadingSpaces(l) < 4 {
+					closing := strings.TrimRight(strings.TrimLeft(l, " "), " \t")
+					if len(closing) >= len(open) && strings.Trim(closing, open[:1]) == "" {
+						j++
+						break
+					}
+				}
+				code = append(code, removeIndent(l, indent))
+			}
+			text := strings.Join(code, "\n")
+			if len(code) > 0 {
+				text += "\n"
+			}
+			add(&mdBlock{kind: mdCodeBlock, text: text, info: unescapeMarkdown(info)})
+			i = j
+			continue
+		}
+
+		if level, text, ok := atxHeading(s); ok {
+			flush()
+			add(&mdBlock{kind: mdHeading, level: level, text: text})
+			i++
+			continue
+		}
+
+		if mdThematicBreakPattern.MatchString(s) {
+			flush()
+			add(&mdBlock{kind: mdThematicBreak})
+			i++
+			continue
+		}
+
+		if strings.HasPrefix(s, ">") && p.depth < mdMaxNesting {
+			flush()
+			quote, n := p.parseBlockQuote(lines, i)
+			add(quote)
+			i += n
+			continue
+		}
+
+		if m, ok := listMarker(line); ok && p.depth < mdMaxNesting && (paragraph == nil || (!m.empty && (!m.ordered || m.start == 1))) {
+			flush()
+			list, n := p.parseList(lines, i, m)
+			add(list)
+			i += n
+			continue
+		}
+
+		if kind := htmlBlockType(s, paragraph != nil); kind != 0 {
+			flush()
+			j := i
+			if kind <= len(mdHTMLBlockEnds) {
+				for j < len(lines) && !mdHTMLBlockEnds[kind-1].MatchString(lines[j]) {
+					j++
+				}
+				if j < len(lines) {
+					j++
+				}
+			} else {
+				for j < len(lines) && !isBlankLine(lines[j]) {
+					j++
+				}
+			}
+			add(&mdBlock{kind: mdHTMLBlock, text: strings.Join(lines[i:j], "\n")})
+			i = j
+			continue
+		}
+
+		paragraph = append(paragraph, strings.TrimLeft(line, " "))
+		i++
+	}
+	flush()
+
+	return blocks
+}
+
+// parseMarkdown parses a Markdown document into blocks.
+func parseMarkdown(s string, ext mdExtensions) (*mdParser, []*mdBlock) {
+	s = strings.Replace(normalizeNewlines(s), "\x00", "\uFFFD", -1)
+
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = expandTabs(line)
+	}
+
+	p := &mdParser{ext: ext, refs: map[string]mdLinkRef{}}
+
+	return p, p.parseBlocks(lines)
+}
+
+// renderHTML writes blocks as HTML. Paragraphs of tight lists are written
+// without <p> elements.
+func (p *mdParser) renderHTML(buffer *bytes.Buffer, blocks []*mdBlock, tight bool) {
+	for _, block := range blocks {
+		switch block.kind {
+		case mdParagraph:
+			if tight {
+				p.renderInlinesHTML(buffer, p.parseInlines(block.text), false)
+			} else {
+				buffer.WriteString("<p>")
+				p.renderInlinesHTML(buffer, p.parseInlines(block.text), false)
+				buffer.WriteString("</p>\n")
+			}
+		case mdHeading:
+			tag := "h" + strconv.Itoa(block.level)
+			buffer.WriteString("<" + tag + ">")
+			p.renderInlinesHTML(buffer, p.parseInlines(block.text), false)
+			buffer.WriteString("</" + tag + ">\n")
+		case mdThematicBreak:
+			buffer.WriteString("<hr />\n")
+		case mdCodeBlock:
+			buffer.WriteString("<pre><code")
+			if fields := strings.Fields(block.info); len(fields) > 0 {
+				buffer.WriteString(` class="language-` + html.EscapeString(fields[0]) + `"`)
+			}
+			buffer.WriteString(">" + html.EscapeString(block.text) + "</code></pre>\n")
+		case mdHTMLBlock:
+			buffer.WriteString(block.text + "\n")
+		case mdBlockQuote:
+			buffer.WriteString("<blockquote>\n")
+			p.renderHTML(buffer, block.children, false)
+			buffer.WriteString("</blockquote>\n")
+		case mdList:
+			tag := "ul"
+			if block.ordered {
+				tag = "ol"
+			}
+			buffer.WriteString("<" + tag)
+			if block.ordered && block.start != 1 {
+				buffer.WriteString(` start="` + strconv.Itoa(block.start) + `"`)
+			}
+			buffer.WriteString(">\n")
+			for _, item := range block.children {
+				buffer.WriteString("<li>")
+				if len(item.children) > 0 && (!block.tight || item.children[0].kind != mdParagraph) {
+					buffer.WriteString("\n")
+				}
+				p.renderHTML(buffer, item.children, block.tight)
+				if block.tight && len(item.children) > 0 && item.children[len(item.children)-1].kind == mdParagraph {
+					buffer.Truncate(len(bytes.TrimSuffix(buffer.Bytes(), []byte("\n"))))
+				}
+				buffer.WriteString("</li>\n")
+			}
+			buffer.WriteString("</" + tag + ">\n")
+		case mdTable:
+			buffer.WriteString("<table>\n<thead>\n")
+			for i, row := range block.rows {
+				if i == 1 {
+					buffer.WriteString("<tbody>\n")
+				}
+				tag := "td"
+				if i == 0 {
+					tag = "th"
+				}
+				buffer.WriteString("<tr>\n")
+				for j, cell := range row {
+					buffer.WriteString("<" + tag)
+					if block.align[j] != "" {
+						buffer.WriteString(` align="` + block.align[j] + `"`)
+					}
+					buffer.WriteString(">")
+					p.renderInlinesHTML(buffer, p.parseInlines(cell), false)
+					buffer.WriteString("</" + tag + ">\n")
+				}
+				buffer.WriteString("</tr>\n")
+				if i == 0 {
+					buffer.WriteString("</thead>\n")
+				}
+			}
+			if len(block.rows) > 1 {
+				buffer.WriteString("</tbody>\n")
+			}
+			buffer.WriteString("</table>\n")
+		}
+
+		// Blocks following a paragraph of a tight list item start on a
+		// new line.
+		if tight && block.kind == mdParagraph {
+			buffer.WriteString("\n")
+		}
+	}
+}
+
+// renderText writes blocks as plain text, separated by blank lines.
+func (p *mdParser) renderText(buffer *bytes.Buffer, blocks []*mdBlock, prefix string, tight bool) {
+	for i, block := range blocks {
+		if i > 0 {
+			if !tight {
+				buffer.WriteString(strings.TrimRight(prefix, " "))
+				buffer.WriteString("\n")
+			}
+			buffer.WriteString(prefix)
+		}
+
+		switch block.kind {
+		case mdParagraph, mdHeading:
+			p.renderInlinesText(buffer, p.parseInlines(block.text), prefix)
+		case mdThematicBreak:
+			buffer.WriteString("---")
+		case mdCodeBlock:
+			text := strings.TrimSuffix(block.text, "\n")
+			buffer.WriteString(strings.Replace(text, "\n", "\n"+prefix, -1))
+		case mdHTMLBlock:
+			text := strings.TrimSpace(html.UnescapeString((&HTMLPolicy{}).Sanitize(block.text)))
+			buffer.WriteString(strings.Replace(text, "\n", "\n"+prefix, -1))
+		case mdBlockQuote:
+			buffer.WriteString("> ")
+			p.renderText(buffer, block.children, prefix+"> ", false)
+		case mdList:
+			for j, item := range block.children {
+				marker := "- "
+				if block.ordered {
+					marker = strconv.Itoa(block.start+j) + ". "
+				}
+				if j > 0 {
+					if !block.tight {
+						buffer.WriteString(strings.TrimRight(prefix, " ") + "\n")
+					}
+					buffer.WriteString("\n" + prefix)
+				}
+				buffer.WriteString(marker)
+				p.renderText(buffer, item.children, prefix+strings.Repeat(" ", len(marker)), block.tight)
+			}
+		case mdTable:
+			for j, row := range block.rows {
+				if j > 0 {
+					buffer.WriteString("\n" + prefix)
+				}
+				for k, cell := range row {
+					if k > 0 {
+						buffer.WriteString(" | ")
+					}
+					p.renderInlinesText(buffer, p.parseInlines(cell), prefix)
+				}
+			}
+		}
+
+		if i < len(blocks)-1 {
+			buffer.WriteString("\n")
+		}
+	}
+}
+
+// markdown converts the Markdown text s into HTML sanitized with
+// MarkdownPolicy.
+func markdown(s string, ext mdExtensions) string {
+	p, blocks := parseMarkdown(s, ext)
+
+	var buffer bytes.Buffer
+	p.renderHTML(&buffer, blocks, false)
+
+	return MarkdownPolicy.Sanitize(buffer.String())
+}
+
+// markdownText converts the Markdown text s into plain text, removing the
+// formatting. Links are followed by their URL in parentheses.
+func markdownText(s string, ext mdExtensions) string {
+	p, blocks := parseMarkdown(s, ext)
+
+	var buffer bytes.Buffer
+	p.renderText(&buffer, blocks, "", false)
+
+	return buffer.String()
+}
@@ -0,0 +1,772 @@
+package gtf
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type mdInlineKind int
+
+const (
+	mdText mdInlineKind = iota
+	mdCode
+	mdEmphasis
+	mdStrong
+	mdStrikethrough
+	mdLink
+	mdImage
+	mdRawHTML
+	mdSoftBreak
+	mdHardBreak
+)
+
+// mdInline is an inline element of a Markdown paragraph.
+type mdInline struct {
+	kind     mdInlineKind
+	text     string // text, code or HTML
+	dest     string // link destination
+	title    string // link title
+	children []*mdInline
+
+	prev, next *mdInline // siblings while the inlines are parsed
+}
+
+// mdDelimiter is a run of emphasis characters which may open or close
+// emphasis.
+type mdDelimiter struct {
+	node       *mdInline
+	char       byte
+	count      int
+	origCount  int
+	canOpen    bool
+	canClose   bool
+	index      int // position in the order of the delimiter runs
+	prev, next *mdDelimiter
+}
+
+// mdBracket is an opening bracket of a link or image.
+type mdBracket struct {
+	node   *mdInline
+	image  bool
+	active bool
+	pos    int          // position of the link text in the source
+	delims *mdDelimiter // the last delimiter before the bracket
+}
+
+// mdInlineParser parses the inline content of a block. The inlines and
+// the delimiter stack are linked lists, so that emphasis and links are
+// built in time linear in the length of the text.
+type mdInlineParser struct {
+	*mdParser
+	src         string
+	pos         int
+	first, last *mdInline
+	delims      *mdDelimiter // the top of the delimiter stack
+	delimCount  int
+	brackets    []*mdBracket
+
+	// unclosedTitles maps the closing character of a link title to the
+	// first position from which no closing character follows, so the
+	// rest of the text is scanned for it at most once.
+	unclosedTitles map[byte]int
+}
+
+// mdMaxLinkParens limits the nesting of parentheses in link
+// destinations, like cmark does, so the destinations of unclosed links
+// are not scanned to the end of the text again for every bracket.
+const mdMaxLinkParens = 32
+
+var (
+	mdEntityPattern    = regexp.MustCompile(`^&(?:#[xX][0-9a-fA-F]{1,6}|#[0-9]{1,7}|[A-Za-z][A-Za-z0-9]{1,31});`)
+	mdAutolinkPattern  = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^<>\x00-\x20]*)>`)
+	mdEmailLinkPattern = regexp.MustCompile(`^<([a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*)>`)
+	mdRawHTMLPattern   = regexp.MustCompile(`^(?:<[A-Za-z][A-Za-z0-9-]*(?:\s+[A-Za-z_:][A-Za-z0-9_.:-]*(?:\s*=\s*(?:[^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?)*\s*/?>|</[A-Za-z][A-Za-z0-9-]*\s*>|<!--[\s\S]*?-->|<\?[\s\S]*?\?>|<![A-Za-z][^>]*>|<!\[CDATA\[[\s\S]*?\]\]>)`)
+)
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isMarkdownPunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// unescapeMarkdown resolves the backslash escapes and entities of s.
+func unescapeMarkdown(s string) string {
+	if !strings.ContainsAny(s, `\&`) {
+		return s
+	}
+
+	var buffer bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]):
+			buffer.WriteByte(s[i+1])
+			i++
+		case s[i] == '&':
+			if m := mdEntityPattern.FindString(s[i:]); m != "" {
+				buffer.WriteString(html.UnescapeString(m))
+				i += len(m) - 1
+			} else {
+				buffer.WriteByte('&')
+			}
+		default:
+			buffer.WriteByte(s[i])
+		}
+	}
+
+	return buffer.String()
+}
+
+func (p *mdInlineParser) addNode(node *mdInline) *mdInline {
+	node.prev = p.last
+	if p.last != nil {
+		p.last.next = node
+	} else {
+		p.first = node
+	}
+	p.last = node
+
+	return node
+}
+
+func (p *mdInlineParser) addText(s string) *mdInline {
+	return p.addNode(&mdInline{kind: mdText, text: s})
+}
+
+func (p *mdInlineParser) removeNode(node *mdInline) {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		p.first = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		p.last = node.prev
+	}
+	node.prev, node.next = nil, nil
+}
+
+// wrapNodes moves the inlines between after and before, which are not
+// included, into the children of node, which takes their place.
+func (p *mdInlineParser) wrapNodes(node, after, before *mdInline) {
+	for n := after.next; n != before; {
+		next := n.next
+		n.prev, n.next = nil, nil
+		node.children = append(node.children, n)
+		n = next
+	}
+
+	node.prev, node.next = after, before
+	after.next = node
+	if before != nil {
+		before.prev = node
+	} else {
+		p.last = node
+	}
+}
+
+func (p *mdInlineParser) removeDelimiter(d *mdDelimiter) {
+	if d.prev != nil {
+		d.prev.next = d.next
+	}
+	if d.next != nil {
+		d.next.prev = d.prev
+	} else {
+		p.delims = d.prev
+	}
+}
+
+// parseBackticks parses a code span or a literal run of backticks.
+func (p *mdInlineParser) parseBackticks() {
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] == '`' {
+		p.pos++
+	}
+	ticks := p.src[start:p.pos]
+
+	for i := p.pos; i < len(p.src); {
+		j := strings.Index(p.src[i:], ticks)
+		if j < 0 {
+			break
+		}
+		j += i
+		end := j + len(ticks)
+		if end < len(p.src) && p.src[end] == '`' {
+			for end < len(p.src) && p.src[end] == '`' {
+				end++
+			}
+			i = end
+			continue
+		}
+
+		code := strings.Replace(p.src[p.pos:j], "\n", " ", -1)
+		if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+			code = code[1 : len(code)-1]
+		}
+		p.addNode(&mdInline{kind: mdCode, text: code})
+		p.pos = end
+		return
+	}
+
+	p.addText(ticks)
+}
+
+// parseDelimiters parses a run of emphasis or strikethrough characters.
+func (p *mdInlineParser) parseDelimiters() {
+	c := p.src[p.pos]
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+	}
+	count := p.pos - start
+
+	before, after := ' ', ' '
+	if start > 0 {
+		before, _ = utf8.DecodeLastRuneInString(p.src[:start])
+	}
+	if p.pos < len(p.src) {
+		after, _ = utf8.DecodeRuneInString(p.src[p.pos:])
+	}
+
+	left := !unicode.IsSpace(after) &&
+		(!isMarkdownPunct(after) || unicode.IsSpace(before) || isMarkdownPunct(before))
+	right := !unicode.IsSpace(before) &&
+		(!isMarkdownPunct(before) || unicode.IsSpace(after) || isMarkdownPunct(after))
+
+	canOpen, canClose := left, right
+	switch {
+	case c == '_':
+		canOpen = left && (!right || isMarkdownPunct(before))
+		canClose = right && (!left || isMarkdownPunct(after))
+	case c == '~' && count > 2:
+		canOpen, canClose = false, false
+	}
+
+	node := p.addText(p.src[start:p.pos])
+	if canOpen || canClose {
+		d := &mdDelimiter{node: node, char: c, count: count, origCount: count, canOpen: canOpen, canClose: canClose}
+		d.index = p.delimCount
+		p.delimCount++
+		d.prev = p.delims
+		if p.delims != nil {
+			p.delims.next = d
+		}
+		p.delims = d
+	}
+}
+
+// parseLinkTail parses the destination and title of an inline link
+// following the link text.
+func (p *mdInlineParser) parseLinkTail(pos int) (dest, title string, end int, ok bool) {
+	s := p.src
+	if pos >= len(s) || s[pos] != '(' {
+		return "", "", 0, false
+	}
+	skipSpace := func(i int) int {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n') {
+			i++
+		}
+		return i
+	}
+
+	i := skipSpace(pos + 1)
+	if i < len(s) && s[i] == '<' {
+		j := i + 1
+		for ; j < len(s) && s[j] != '>'; j++ {
+			if s[j] == '\n' || s[j] == '<' {
+				return "", "", 0, false
+			}
+			if s[j] == '\\' && j+1 < len(s) {
+				j++
+			}
+		}
+		if j >= len(s) {
+			return "", "", 0, false
+		}
+		dest = s[i+1 : j]
+		i = j + 1
+	} else {
+		start, depth := i, 0
+		for ; i < len(s) && s[i] > ' '; i++ {
+			if s[i] == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]) {
+				i++
+			} else if s[i] == '(' {
+				depth++
+				if depth > mdMaxLinkParens {
+					return "", "", 0, false
+				}
+			} else if s[i] == ')' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			}
+		}
+		if depth != 0 {
+			return "", "", 0, false
+		}
+		dest = s[start:i]
+	}
+
+	j := skipSpace(i)
+	if j > i && j < len(s) && strings.IndexByte(`"'(`, s[j]) >= 0 {
+		closing := s[j]
+		if closing == '(' {
+			closing = ')'
+		}
+		k := j + 1
+		if start, ok := p.unclosedTitles[closing]; ok && k >= start {
+			return "", "", 0, false
+		}
+		for ; k < len(s) && s[k] != closing; k++ {
+			if s[k] == '\\' && k+1 < len(s) {
+				k++
+			}
+		}
+		if k >= len(s) {
+			if p.unclosedTitles == nil {
+				p.unclosedTitles = map[byte]int{}
+			}
+			p.unclosedTitles[closing] = j + 1
+			return "", "", 0, false
+		}
+		title = s[j+1 : k]
+		j = skipSpace(k + 1)
+	}
+	if j >= len(s) || s[j] != ')' {
+		return "", "", 0, false
+	}
+
+	return unescapeMarkdown(dest), unescapeMarkdown(title), j + 1, true
+}
+
+// parseLinkLabel parses a link label like "[foo]" at pos.
+func (p *mdInlineParser) parseLinkLabel(pos int) (string, int, bool) {
+	s := p.src
+	if pos >= len(s) || s[pos] != '[' {
+		return "", 0, false
+	}
+	for i := pos + 1; i < len(s) && i-pos <= 1000; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			return "", 0, false
+		case ']':
+			return s[pos+1 : i], i + 1, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// parseCloseBracket handles "]", which may end a link or an image.
+func (p *mdInlineParser) parseCloseBracket() {
+	closing := p.pos
+	p.pos++
+
+	if len(p.brackets) == 0 {
+		p.addText("]")
+		return
+	}
+	opener := p.brackets[len(p.brackets)-1]
+	p.brackets = p.brackets[:len(p.brackets)-1]
+	if !opener.active {
+		p.addText("]")
+		return
+	}
+
+	dest, title, end, ok := p.parseLinkTail(p.pos)
+	if !ok {
+		label, labelEnd, full := p.parseLinkLabel(p.pos)
+		if !full || strings.TrimSpace(label) == "" {
+			label = p.src[opener.pos:closing]
+		}
+		if !full {
+			labelEnd = p.pos
+		}
+		// Labels are at most 999 characters long, which also keeps the
+		// text of nested brackets from being normalized again and again.
+		var key string
+		if len(label) <= 999 {
+			key = normalizeLabel(label)
+		}
+		var ref mdLinkRef
+		if ref, ok = p.refs[key]; ok && key != "" {
+			dest, title, end = ref.dest, ref.title, labelEnd
+		} else {
+			ok = false
+		}
+	}
+	if !ok {
+		p.addText("]")
+		return
+	}
+
+	p.processEmphasis(opener.delims)
+
+	node := &mdInline{kind: mdLink, dest: dest, title: title}
+	if opener.image {
+		node.kind = mdImage
+	}
+	p.wrapNodes(node, opener.node, nil)
+	p.removeNode(opener.node)
+	p.pos = end
+
+	// Links may not contain other links.
+	if !opener.image {
+		for _, b := range p.brackets {
+			if !b.image {
+				b.active = false
+			}
+		}
+	}
+}
+
+// parseAngle parses an autolink or raw HTML starting with "<".
+func (p *mdInlineParser) parseAngle() {
+	s := p.src[p.pos:]
+	if m := mdAutolinkPattern.FindStringSubmatch(s); m != nil {
+		p.addNode(&mdInline{kind: mdLink, dest: m[1], children: []*mdInline{{kind: mdText, text: m[1]}}})
+		p.pos += len(m[0])
+		return
+	}
+	if m := mdEmailLinkPattern.FindStringSubmatch(s); m != nil {
+		p.addNode(&mdInline{kind: mdLink, dest: "mailto:" + m[1], children: []*mdInline{{kind: mdText, text: m[1]}}})
+		p.pos += len(m[0])
+		return
+	}
+	if m := mdRawHTMLPattern.FindString(s); m != "" {
+		p.addNode(&mdInline{kind: mdRawHTML, text: m})
+		p.pos += len(m)
+		return
+	}
+
+	p.addText("<")
+	p.pos++
+}
+
+// parseNewline adds a soft or hard line break.
+func (p *mdInlineParser) parseNewline() {
+	kind := mdSoftBreak
+	if last := p.last; last != nil && last.kind == mdText {
+		if strings.HasSuffix(last.text, "  ") {
+			kind = mdHardBreak
+		}
+		last.text = strings.TrimRight(last.text, " ")
+	}
+	p.addNode(&mdInline{kind: kind})
+
+	p.pos++
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// processEmphasis matches the delimiters above bottom on the delimiter
+// stack, following the CommonMark algorithm. The openers below which no
+// opener was found are remembered for every kind of closer, so each
+// delimiter is looked at a bounded number of times.
+func (p *mdInlineParser) processEmphasis(bottom *mdDelimiter) {
+	bottomIndex := -1
+	if bottom != nil {
+		bottomIndex = bottom.index
+	}
+	openersBottom := map[[3]int]int{}
+
+	var closer *mdDelimiter
+	for d := p.delims; d != bottom; d = d.prev {
+		closer = d
+	}
+
+	for closer != nil {
+		if !closer.canClose {
+			closer = closer.next
+			continue
+		}
+
+		key := [3]int{int(closer.char), closer.origCount % 3, 0}
+		if closer.canOpen {
+			key[2] = 1
+		}
+		lower, ok := openersBottom[key]
+		if !ok {
+			lower = bottomIndex
+		}
+
+		var opener *mdDelimiter
+		for d := closer.prev; d != nil && d.index > lower; d = d.prev {
+			if d.char != closer.char || !d.canOpen {
+				continue
+			}
+			if closer.char == '~' {
+				if d.count != closer.count {
+					continue
+				}
+			} else if (d.canClose || closer.canOpen) && (d.origCount+closer.origCount)%3 == 0 &&
+				!(d.origCount%3 == 0 && closer.origCount%3 == 0) {
+				continue
+			}
+			opener = d
+			break
+		}
+
+		if opener == nil {
+			if closer.prev != nil {
+				openersBottom[key] = closer.prev.index
+			} else {
+				openersBottom[key] = bottomIndex
+			}
+			next := closer.next
+			if !closer.canOpen {
+				p.removeDelimiter(closer)
+			}
+			closer = next
+			continue
+		}
+
+		n, kind := 1, mdEmphasis
+		switch {
+		case closer.char == '~':
+			n, kind = closer.count, mdStrikethrough
+		case opener.count >= 2 && closer.count >= 2:
+			n, kind = 2, mdStrong
+		}
+
+		opener.count -= n
+		closer.count -= n
+		opener.node.text = opener.node.text[n:]
+		closer.node.text = closer.node.text[n:]
+
+		p.wrapNodes(&mdInline{kind: kind}, opener.node, closer.node)
+
+		// The delimiters between the opener and the closer can no longer
+		// match.
+		opener.next, closer.prev = closer, opener
+
+		if opener.count == 0 {
+			p.removeNode(opener.node)
+			p.removeDelimiter(opener)
+		}
+		if closer.count == 0 {
+			next := closer.next
+			p.removeNode(closer.node)
+			p.removeDelimiter(closer)
+			closer = next
+		}
+	}
+
+	// Remove the delimiters above bottom.
+	p.delims = bottom
+	if bottom != nil {
+		bottom.next = nil
+	}
+}
+
+// mergeText joins adjacent text nodes.
+func mergeText(nodes []*mdInline) []*mdInline {
+	var result []*mdInline
+	var text []string
+	flush := func() {
+		if len(text) > 0 {
+			result = append(result, &mdInline{kind: mdText, text: strings.Join(text, "")})
+			text = nil
+		}
+	}
+
+	for _, node := range nodes {
+		node.children = mergeText(node.children)
+		if node.kind == mdText {
+			if node.text != "" {
+				text = append(text, node.text)
+			}
+			continue
+		}
+		flush()
+		result = append(result, node)
+	}
+	flush()
+
+	return result
+}
+
+// parseInlines parses the inline content of a block.
+func (p *mdParser) parseInlines(s string) []*mdInline {
+	ip := &mdInlineParser{mdParser: p, src: strings.Trim(s, " \t")}
+
+	for ip.pos < len(ip.src) {
+		switch c := ip.src[ip.pos]; {
+		case c == '\\':
+			switch {
+			case ip.pos+1 < len(ip.src) && ip.src[ip.pos+1] == '\n':
+				ip.addNode(&mdInline{kind: mdHardBreak})
+				ip.pos += 2
+				for ip.pos < len(ip.src) && ip.src[ip.pos] == ' ' {
+					ip.pos++
+				}
+			case ip.pos+1 < len(ip.src) && isASCIIPunct(ip.src[ip.pos+1]):
+				ip.addText(ip.src[ip.pos+1 : ip.pos+2])
+				ip.pos += 2
+			default:
+				ip.addText(`\`)
+				ip.pos++
+			}
+		case c == '`':
+			ip.parseBackticks()
+		case c == '*' || c == '_' || (c == '~' && p.ext.strikethrough):
+			ip.parseDelimiters()
+		case c == '[' || (c == '!' && strings.HasPrefix(ip.src[ip.pos:], "![")):
+			image := c == '!'
+			width := 1
+			if image {
+				width = 2
+			}
+			node := ip.addText(ip.src[ip.pos : ip.pos+width])
+			ip.pos += width
+			ip.brackets = append(ip.brackets, &mdBracket{node, image, true, ip.pos, ip.delims})
+		case c == ']':
+			ip.parseCloseBracket()
+		case c == '<':
+			ip.parseAngle()
+		case c == '&':
+			m := mdEntityPattern.FindString(ip.src[ip.pos:])
+			if m == "" {
+				m = "&"
+			}
+			ip.addText(html.UnescapeString(m))
+			ip.pos += len(m)
+		case c == '\n':
+			ip.parseNewline()
+		default:
+			end := ip.pos + 1
+			for end < len(ip.src) && strings.IndexByte("\\`*_~[]!<&\n", ip.src[end]) < 0 {
+				end++
+			}
+			ip.addText(ip.src[ip.pos:end])
+			ip.pos = end
+		}
+	}
+	ip.processEmphasis(nil)
+
+	var nodes []*mdInline
+	for n := ip.first; n != nil; n = n.next {
+		nodes = append(nodes, n)
+	}
+
+	return mergeText(nodes)
+}
+
+// plainText returns the text of inline elements without formatting, as
+// used for the alt text of images.
+func plainText(nodes []*mdInline) string {
+	var buffer bytes.Buffer
+	writePlainText(&buffer, nodes)
+
+	return buffer.String()
+}
+
+func writePlainText(buffer *bytes.Buffer, nodes []*mdInline) {
+	for _, node := range nodes {
+		switch node.kind {
+		case mdText, mdCode:
+			buffer.WriteString(node.text)
+		case mdSoftBreak, mdHardBreak:
+			buffer.WriteString(" ")
+		default:
+			writePlainText(buffer, node.children)
+		}
+	}
+}
+
+// renderInlinesHTML writes inline elements as HTML. Text in links is not
+// autolinked.
+func (p *mdParser) renderInlinesHTML(buffer *bytes.Buffer, nodes []*mdInline, inLink bool) {
+	for _, node := range nodes {
+		switch node.kind {
+		case mdText:
+			if p.ext.autolinks && !inLink {
+				buffer.WriteString(urlize(node.text, urlizeOptions{escape: true}))
+			} else {
+				buffer.WriteString(html.EscapeString(node.text))
+			}
+		case mdCode:
+			buffer.WriteString("<code>" + html.EscapeString(node.text) + "</code>")
+		case mdEmphasis, mdStrong, mdStrikethrough:
+			tag := map[mdInlineKind]string{mdEmphasis: "em", mdStrong: "strong", mdStrikethrough: "del"}[node.kind]
+			buffer.WriteString("<" + tag + ">")
+			p.renderInlinesHTML(buffer, node.children, inLink)
+			buffer.WriteString("</" + tag + ">")
+		case mdLink:
+			buffer.WriteString(`<a href="` + html.EscapeString(quoteURL(node.dest)) + `"`)
+			if node.title != "" {
+				buffer.WriteString(` title="` + html.EscapeString(node.title) + `"`)
+			}
+			buffer.WriteString(">")
+			p.renderInlinesHTML(buffer, node.children, true)
+			buffer.WriteString("</a>")
+		case mdImage:
+			buffer.WriteString(`<img src="` + html.EscapeString(quoteURL(node.dest)) + `" alt="` + html.EscapeString(plainText(node.children)) + `"`)
+			if node.title != "" {
+				buffer.WriteString(` title="` + html.EscapeString(node.title) + `"`)
+			}
+			buffer.WriteString(" />")
+		case mdRawHTML:
+			buffer.WriteString(node.text)
+		case mdSoftBreak:
+			buffer.WriteString("\n")
+		case mdHardBreak:
+			buffer.WriteString("<br />\n")
+		}
+	}
+}
+
+// rawTagName returns the lower case name of an HTML start tag, or "" if
+// s is not a start tag.
+func rawTagName(s string) string {
+	m := htmlTagPattern.FindStringSubmatch(s)
+	if m == nil || m[1] == "/" {
+		return ""
+	}
+
+	return strings.ToLower(m[2])
+}
+
+// renderInlinesText writes inline elements as plain text. Links are
+// followed by their URL unless the link text is the URL. Raw HTML is
+// removed, along with the content of elements like script.
+func (p *mdParser) renderInlinesText(buffer *bytes.Buffer, nodes []*mdInline, prefix string) {
+	for i := 0; i < len(nodes); i++ {
+		node := nodes[i]
+		switch node.kind {
+		case mdText, mdCode:
+			buffer.WriteString(node.text)
+		case mdLink:
+			text := plainText(node.children)
+			p.renderInlinesText(buffer, node.children, prefix)
+			dest := strings.TrimPrefix(node.dest, "mailto:")
+			if dest != "" && dest != text && !strings.HasPrefix(dest, "#") && MarkdownPolicy.allowsURL(node.dest) {
+				buffer.WriteString(" (" + node.dest + ")")
+			}
+		case mdImage:
+			buffer.WriteString(plainText(node.children))
+		case mdSoftBreak, mdHardBreak:
+			buffer.WriteString("\n" + prefix)
+		case mdRawHTML:
+			if name := rawTagName(node.text); droppedElements[name] {
+				for i++; i < len(nodes); i++ {
+					if nodes[i].kind == mdRawHTML && strings.HasPrefix(strings.ToLower(nodes[i].text), "</"+name) {
+						break
+					}
+				}
+			}
+		default:
+			p.renderInlinesText(buffer, node.children, prefix)
+		}
+	}
+}
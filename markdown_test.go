@@ -0,0 +1,153 @@
+package gtf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarkdown(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := map[string]string{
+		"# Heading\n\nSome *emphasis* and **strong** text.": "<h1>Heading</h1>\n<p>Some <em>emphasis</em> and <strong>strong</strong> text.</p>\n",
+		"Setext\n======\n\nTwo\n---":                        "<h1>Setext</h1>\n<h2>Two</h2>\n",
+		"## Closing ##\n### \\# not closed #":               "<h2>Closing</h2>\n<h3># not closed</h3>\n",
+		"* * *\n___":                                        "<hr />\n<hr />\n",
+		"    code block\n\n    after blank":                 "<pre><code>code block\n\nafter blank\n</code></pre>\n",
+		"```go\nfunc main() {}\n```":                        "<pre><code class=\"language-go\">func main() {}\n</code></pre>\n",
+		"> quote\n> > nested\n\n> lazy\ncontinuation":       "<blockquote>\n<p>quote</p>\n<blockquote>\n<p>nested</p>\n</blockquote>\n</blockquote>\n<blockquote>\n<p>lazy\ncontinuation</p>\n</blockquote>\n",
+		"- a\n- b":                                        "<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n",
+		"- a\n\n- b":                                      "<ul>\n<li>\n<p>a</p>\n</li>\n<li>\n<p>b</p>\n</li>\n</ul>\n",
+		"1. one\n2. two\n3) other":                        "<ol>\n<li>one</li>\n<li>two</li>\n</ol>\n<ol start=\"3\">\n<li>other</li>\n</ol>\n",
+		"- item\n  - nested\n- back":                      "<ul>\n<li>item\n<ul>\n<li>nested</li>\n</ul>\n</li>\n<li>back</li>\n</ul>\n",
+		"text\n2. does not interrupt":                     "<p>text\n2. does not interrupt</p>\n",
+		"foo*bar*baz foo_bar_baz":                         "<p>foo<em>bar</em>baz foo_bar_baz</p>\n",
+		"*foo**bar**baz* ***e***":                         "<p><em>foo<strong>bar</strong>baz</em> <em><strong>e</strong></em></p>\n",
+		"`code` and ``co`de`` and `unclosed":              "<p><code>code</code> and <code>co`de</code> and `unclosed</p>\n",
+		"[link](/url \"Title\") ![alt *text*](/img.png)":  "<p><a href=\"/url\" title=\"Title\" rel=\"nofollow\">link</a> <img src=\"/img.png\" alt=\"alt text\" /></p>\n",
+		"[ref], [text][REF] and [ref][]\n\n[ref]: /url":   "<p><a href=\"/url\" rel=\"nofollow\">ref</a>, <a href=\"/url\" rel=\"nofollow\">text</a> and <a href=\"/url\" rel=\"nofollow\">ref</a></p>\n",
+		"[outer [inner](/i)](/o) [not a link]":            "<p>[outer <a href=\"/i\" rel=\"nofollow\">inner</a>](/o) [not a link]</p>\n",
+		"<http://example.com/?a&b> <foo@bar.example.com>": "<p><a href=\"http://example.com/?a&amp;b\" rel=\"nofollow\">http://example.com/?a&amp;b</a> <a href=\"mailto:foo@bar.example.com\" rel=\"nofollow\">foo@bar.example.com</a></p>\n",
+		"line one  \nline two\\\nline three":              "<p>line one<br />\nline two<br />\nline three</p>\n",
+		"&amp; &copy; &#35; &nosuchentity; \\*not\\*":     "<p>&amp; © # &amp;nosuchentity; *not*</p>\n",
+		"[x](/ü url) [y](</a b>)":                         "<p>[x](/ü url) <a href=\"/a%20b\" rel=\"nofollow\">y</a></p>\n",
+		"~~no strikethrough~~ | no | table |\n|--|--|--|": "<p>~~no strikethrough~~ | no | table |\n|--|--|--|</p>\n",
+		"http://example.com is not an autolink":           "<p>http://example.com is not an autolink</p>\n",
+		"":                                                "",
+	}
+
+	for input, expected := range cases {
+		ParseTest(&buffer, "{{ . | markdown }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+
+	TextTemplateParseTest(&buffer, "{{ . | markdown }}", "*a* & <b>")
+	AssertEqual(t, &buffer, "<p><em>a</em> &amp; <b></b></p>\n")
+}
+
+func TestMarkdownExtensions(t *testing.T) {
+	var buffer bytes.Buffer
+
+	table := "| a | b |\n|:--|--:|\n| *1* | `c\\|d` |\n| 3 |"
+	ParseTest(&buffer, "{{ . | markdown \"tables\" }}", table)
+	AssertEqual(t, &buffer, "<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"right\">b</th>\n</tr>\n</thead>\n"+
+		"<tbody>\n<tr>\n<td align=\"left\"><em>1</em></td>\n<td align=\"right\"><code>c|d</code></td>\n</tr>\n"+
+		"<tr>\n<td align=\"left\">3</td>\n<td align=\"right\"></td>\n</tr>\n</tbody>\n</table>\n")
+
+	ParseTest(&buffer, "{{ . | markdown \"strikethrough\" }}", "~~old~~ ~new~ ~~~no~~~")
+	AssertEqual(t, &buffer, "<p><del>old</del> <del>new</del> ~~~no~~~</p>\n")
+
+	ParseTest(&buffer, "{{ . | markdown \"autolinks\" }}", "see www.example.com, [golang.org](/go) and `go.dev`")
+	AssertEqual(t, &buffer, "<p>see <a href=\"http://www.example.com\" rel=\"nofollow\">www.example.com</a>, <a href=\"/go\" rel=\"nofollow\">golang.org</a> and <code>go.dev</code></p>\n")
+
+	ParseTest(&buffer, "{{ . | markdown \"gfm\" }}", "Intro\n| x |\n| - |\n| ~~y~~ https://go.dev |")
+	AssertEqual(t, &buffer, "<p>Intro</p>\n<table>\n<thead>\n<tr>\n<th>x</th>\n</tr>\n</thead>\n"+
+		"<tbody>\n<tr>\n<td><del>y</del> <a href=\"https://go.dev\" rel=\"nofollow\">https://go.dev</a></td>\n</tr>\n</tbody>\n</table>\n")
+}
+
+func TestMarkdownSanitize(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := map[string]string{
+		"<script>alert(1)</script>\n\nok":                   "\n<p>ok</p>\n",
+		"[x](javascript:alert(1)) <img src=x onerror=y>":    "<p><a rel=\"nofollow\">x</a> <img src=\"x\" /></p>\n",
+		"[x](JaVaScRiPt:alert(1)) ![y](data:text/html,z)":   "<p><a rel=\"nofollow\">x</a> <img alt=\"y\" /></p>\n",
+		"<a href=\"jav&#x61;script:x\" onclick=\"y\">a</a>": "<p><a rel=\"nofollow\">a</a></p>\n",
+		"<div style=\"color:red\">\n<b>bold</b>\n</div>":    "\n<b>bold</b>\n\n",
+		"<em>unclosed *emphasis*":                           "<p><em>unclosed <em>emphasis</em></em></p>\n",
+		"<!-- comment -->\n\n<iframe src=\"x\">y</iframe>":  "\n\n",
+	}
+
+	for input, expected := range cases {
+		ParseTest(&buffer, "{{ . | markdown }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+
+	for _, input := range hostileInputs {
+		ParseTest(&buffer, "{{ . | markdown \"gfm\" }}", input)
+		output := strings.ToLower(buffer.String())
+		buffer.Reset()
+
+		if strings.Contains(output, "<script") || strings.Contains(output, "javascript:") {
+			t.Errorf("markdown(%q) = %q", input, output)
+		}
+	}
+}
+
+func TestMarkdownNesting(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | markdown }}", "*a _b **c** d_ e*\n\n**_x_ *y***")
+	AssertEqual(t, &buffer, "<p><em>a <em>b <strong>c</strong> d</em> e</em></p>\n<p><strong><em>x</em> <em>y</em></strong></p>\n")
+
+	TextTemplateParseTest(&buffer, "{{ . | markdown }}", strings.Repeat("> ", 150)+"x")
+	AssertEqual(t, &buffer, strings.Repeat("<blockquote>\n", 100)+"<p>"+strings.Repeat("&gt; ", 50)+"x</p>\n"+strings.Repeat("</blockquote>\n", 100))
+
+	TextTemplateParseTest(&buffer, "{{ . | markdown }}", "[a](x(y)) [b](x (t)) [c](x (t")
+	AssertEqual(t, &buffer, "<p><a href=\"x(y)\" rel=\"nofollow\">a</a> <a href=\"x\" title=\"t\" rel=\"nofollow\">b</a> [c](x (t</p>\n")
+
+	// Pathological inputs are parsed in linear time.
+	inputs := []string{
+		strings.Repeat("*_", 20000),
+		strings.Repeat("*a ", 20000),
+		strings.Repeat("_*a", 10000) + strings.Repeat("*_", 10000),
+		strings.Repeat("[*", 10000) + strings.Repeat("*](/u)", 10000),
+		strings.Repeat("![[", 10000) + strings.Repeat("](/u)", 10000),
+		strings.Repeat("- ", 20000) + "x",
+		strings.Repeat("> - ", 10000) + "x",
+		strings.Repeat("[a](", 20000),
+		strings.Repeat("[a](x (", 20000),
+		strings.Repeat("[", 20000) + strings.Repeat("]", 20000),
+	}
+	for _, input := range inputs {
+		start := time.Now()
+		markdown(input, mdExtensions{strikethrough: true})
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("markdown(%q...) took %v", input[:10], elapsed)
+		}
+	}
+}
+
+func TestMarkdownText(t *testing.T) {
+	var buffer bytes.Buffer
+
+	input := "# Title\n\nSome *text* with a [link](http://example.com), <http://go.dev> and [bad](javascript:x).\n\n" +
+		"- one\n- two\n  1. nested\n  2. more\n\n> quoted **bold**\n> line two\n\n```\nx := 1\n```\n\n" +
+		"![pic](/p.png) <script>alert(1)</script>\n\n---\n\nend  \nbreak"
+	expected := "Title\n\nSome text with a link (http://example.com), http://go.dev and bad.\n\n" +
+		"- one\n- two\n  1. nested\n  2. more\n\n> quoted bold\n> line two\n\nx := 1\n\npic \n\n---\n\nend\nbreak"
+
+	TextTemplateParseTest(&buffer, "{{ . | markdowntext }}", input)
+	AssertEqual(t, &buffer, expected)
+
+	ParseTest(&buffer, "{{ . | markdowntext }}", "**Tom & Jerry** <b>")
+	AssertEqual(t, &buffer, "Tom &amp; Jerry ")
+
+	TextTemplateParseTest(&buffer, "{{ . | markdowntext \"tables\" }}", "| a | b |\n|---|---|\n| 1 | 2 |")
+	AssertEqual(t, &buffer, "a | b\n1 | 2")
+
+	TextTemplateParseTest(&buffer, "{{ . | markdowntext }}", "1. a\n\n   b\n2. c")
+	AssertEqual(t, &buffer, "1. a\n\n   b\n\n2. c")
+}
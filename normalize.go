@@ -0,0 +1,151 @@
+package gtf
+
+import (
+	"sort"
+)
+
+// propertyRange assigns a value of a Unicode property to the code points
+// from lo to hi.
+type propertyRange struct {
+	lo, hi rune
+	value  uint8
+}
+
+// lookupProperty returns the value of r in a sorted table of ranges, or 0
+// if r is not in the table.
+func lookupProperty(table []propertyRange, r rune) uint8 {
+	i := sort.Search(len(table), func(i int) bool {
+		return table[i].hi >= r
+	})
+	if i < len(table) && table[i].lo <= r {
+		return table[i].value
+	}
+
+	return 0
+}
+
+// Hangul syllables are composed and decomposed algorithmically.
+const (
+	hangulBase   = 0xac00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11a7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// nfcCompositions maps pairs of code points to their primary composite.
+var nfcCompositions = newNFCCompositions()
+
+// newNFCCompositions builds the composition pairs from the canonical
+// decompositions, leaving out singletons, decompositions starting with a
+// non-starter and the composition exclusions.
+func newNFCCompositions() map[[2]rune]rune {
+	excluded := map[rune]bool{}
+	for _, r := range nfcExclusions {
+		excluded[r] = true
+	}
+
+	compositions := map[[2]rune]rune{}
+	for r, d := range nfcDecompositions {
+		if d[1] == 0 || excluded[r] || lookupProperty(cccTable, r) != 0 || lookupProperty(cccTable, d[0]) != 0 {
+			continue
+		}
+		compositions[d] = r
+	}
+
+	return compositions
+}
+
+// decompose appends the full canonical decomposition of r to runes.
+func decompose(runes []rune, r rune) []rune {
+	if s := r - hangulBase; s >= 0 && s < hangulSCount {
+		runes = append(runes, hangulLBase+s/hangulNCount, hangulVBase+s%hangulNCount/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			runes = append(runes, hangulTBase+t)
+		}
+		return runes
+	}
+
+	d, ok := nfcDecompositions[r]
+	if !ok {
+		return append(runes, r)
+	}
+	runes = decompose(runes, d[0])
+	if d[1] != 0 {
+		runes = decompose(runes, d[1])
+	}
+
+	return runes
+}
+
+// compose returns the primary composite of a and b.
+func compose(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulBase, b-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && t > 0 && t < hangulTCount {
+		return a + t, true
+	}
+
+	r, ok := nfcCompositions[[2]rune{a, b}]
+
+	return r, ok
+}
+
+// combiningMarks sorts combining marks by their combining class.
+type combiningMarks []rune
+
+func (m combiningMarks) Len() int      { return len(m) }
+func (m combiningMarks) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m combiningMarks) Less(i, j int) bool {
+	return lookupProperty(cccTable, m[i]) < lookupProperty(cccTable, m[j])
+}
+
+// nfc converts s into Unicode Normalization Form C: it is decomposed,
+// combining marks are put into canonical order and the result is
+// composed again.
+func nfc(s string) string {
+	var runes []rune
+	for _, r := range s {
+		runes = decompose(runes, r)
+	}
+
+	// Sort runs of combining marks by their combining class. The sort is
+	// stable, so marks of the same class keep their order.
+	for i := 0; i < len(runes); i++ {
+		j := i
+		for j < len(runes) && lookupProperty(cccTable, runes[j]) != 0 {
+			j++
+		}
+		if j-i > 1 {
+			sort.Stable(combiningMarks(runes[i:j]))
+		}
+		i = j
+	}
+
+	// Compose every code point which is not blocked from the last
+	// starter with it.
+	result := runes[:0]
+	starter := -1
+	var lastClass uint8
+	for _, r := range runes {
+		class := lookupProperty(cccTable, r)
+		if starter >= 0 && (starter == len(result)-1 || lastClass < class) {
+			if composite, ok := compose(result[starter], r); ok {
+				result[starter] = composite
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(result)
+		}
+		lastClass = class
+		result = append(result, r)
+	}
+
+	return string(result)
+}
@@ -0,0 +1,37 @@
+package gtf
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNFC(t *testing.T) {
+	cases := map[string]string{
+		"bu\u0308cher":       "b\u00fccher",
+		"A\u030a":            "\u00c5",
+		"\u212b":             "\u00c5",
+		"a\u0323\u0302":      "\u1ead",
+		"a\u0302\u0323":      "\u1ead",
+		"\u1112\u1161\u11ab": "\ud55c",
+		"\u0958":             "\u0915\u093c",
+		"\u0344":             "\u0308\u0301",
+		"\u0301a":            "\u0301a",
+		"\u0b47\u0b3e":       "\u0b4b",
+		"e\u0334\u0301":      "\u00e9\u0334",
+		"\u05d0\u05bc\u05b7": "\u05d0\u05b7\u05bc",
+		"plain text":         "plain text",
+	}
+
+	for input, expected := range cases {
+		if output := nfc(input); output != expected {
+			t.Errorf("nfc(%+q) = %+q, want %+q", input, output, expected)
+		}
+	}
+
+	start := time.Now()
+	nfc("a" + strings.Repeat("\u0323\u0301", 50000))
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("nfc of 100000 combining marks took %v", elapsed)
+	}
+}
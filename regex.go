@@ -0,0 +1,87 @@
+package gtf
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize is the number of compiled patterns kept by regexpCache.
+const regexpCacheSize = 256
+
+type regexpCacheEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// regexpLRU is a concurrency-safe, bounded cache of compiled regular
+// expressions. The least recently used pattern is evicted first.
+type regexpLRU struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newRegexpLRU(size int) *regexpLRU {
+	return &regexpLRU{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// compile returns the compiled pattern, compiling and caching it if it
+// is not cached yet. Invalid patterns are not cached.
+func (c *regexpLRU) compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*regexpCacheEntry).re, nil
+	}
+	c.mu.Unlock()
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[pattern]; ok {
+		// Another goroutine compiled the same pattern in the meantime.
+		c.order.MoveToFront(e)
+		return e.Value.(*regexpCacheEntry).re, nil
+	}
+
+	c.entries[pattern] = c.order.PushFront(&regexpCacheEntry{pattern, re})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*regexpCacheEntry).pattern)
+	}
+
+	return re, nil
+}
+
+func (c *regexpLRU) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+var regexpCache = newRegexpLRU(regexpCacheSize)
+
+// limitArgs splits the arguments of regexfindall and regexsplit into the
+// optional limit and the value.
+func limitArgs(args []interface{}) (int, string) {
+	n := -1
+	if len(args) > 1 {
+		n, _ = toInt(args[0])
+	}
+
+	return n, args[len(args)-1].(string)
+}
@@ -0,0 +1,92 @@
+package gtf
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRegexFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ . | regexmatch \"^[a-z]+@[a-z]+\\\\.com$\" }}", "gopher@example.com")
+	AssertEqual(t, &buffer, "true")
+
+	ParseTest(&buffer, "{{ . | regexmatch \"^[0-9]+$\" }}", "gopher")
+	AssertEqual(t, &buffer, "false")
+
+	ParseTest(&buffer, "{{ . | regexmatch \"[\" }}", "gopher")
+	AssertEqual(t, &buffer, "false")
+
+	ParseTest(&buffer, "{{ . | regexfind \"[0-9]+\" }}", "go 1.4 and 1.5")
+	AssertEqual(t, &buffer, "1")
+
+	ParseTest(&buffer, "{{ . | regexfindall \"[0-9]\\\\.[0-9]\" }}", "go 1.4 and 1.5")
+	AssertEqual(t, &buffer, "[1.4 1.5]")
+
+	ParseTest(&buffer, "{{ . | regexfindall \"[0-9]\" 2 }}", "12345")
+	AssertEqual(t, &buffer, "[1 2]")
+
+	ParseTest(&buffer, "{{ . | regexreplace \"(\\\\w+)@(\\\\w+)\" \"$2 at ${1}\" }}", "gopher@golang")
+	AssertEqual(t, &buffer, "golang at gopher")
+
+	ParseTest(&buffer, "{{ . | regexreplace \"(\" \"x\" }}", "go")
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | regexsplit \"\\\\s*,\\\\s*\" }}", "go , python,ruby")
+	AssertEqual(t, &buffer, "[go python ruby]")
+
+	ParseTest(&buffer, "{{ . | regexsplit \",\" 2 }}", "go,python,ruby")
+	AssertEqual(t, &buffer, "[go python,ruby]")
+
+	ParseTest(&buffer, "{{ . | regexquote }}", "1.5 [beta]")
+	AssertEqual(t, &buffer, "1\\.5 \\[beta\\]")
+}
+
+func TestRegexpLRU(t *testing.T) {
+	cache := newRegexpLRU(2)
+
+	a, _ := cache.compile("a")
+	cache.compile("b")
+	if again, _ := cache.compile("a"); again != a {
+		t.Error("expected the cached pattern to be reused")
+	}
+
+	cache.compile("c") // evicts "b", the least recently used pattern
+	if cache.len() != 2 {
+		t.Errorf("expected 2 cached patterns, got %d", cache.len())
+	}
+	if _, ok := cache.entries["b"]; ok {
+		t.Error("expected \"b\" to be evicted")
+	}
+	if again, _ := cache.compile("a"); again != a {
+		t.Error("expected \"a\" to stay cached")
+	}
+
+	if _, err := cache.compile("("); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+	if cache.len() != 2 {
+		t.Errorf("invalid patterns should not be cached")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				re, err := cache.compile(fmt.Sprintf("x%d", (i+j)%5))
+				if err != nil || re == nil {
+					t.Error("unexpected compile failure")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.len() > 2 {
+		t.Errorf("expected at most 2 cached patterns, got %d", cache.len())
+	}
+}
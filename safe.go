@@ -0,0 +1,70 @@
+package gtf
+
+import (
+	htmlTemplate "html/template"
+	"reflect"
+)
+
+// toText converts value into a string for the safe functions. Values
+// which already are html/template typed strings keep their content.
+func toText(value interface{}) string {
+	return stringify(indirect(reflect.ValueOf(value)))
+}
+
+// safeSeq marks every element of a slice or array as safe HTML, like
+// Django's safeseq.
+func safeSeq(value interface{}) interface{} {
+	items, _, ok := listItems(value)
+	if !ok {
+		return ""
+	}
+
+	result := make([]htmlTemplate.HTML, len(items))
+	for i, item := range items {
+		result[i] = htmlTemplate.HTML(stringify(indirect(item)))
+	}
+
+	return result
+}
+
+// GtfSafeFuncMap contains the functions which mark content as trusted by
+// html/template, bypassing its escaping. Only use them for content which
+// does not come from users. They are registered in GtfFuncMap and
+// GtfTextFuncMap unless gtf is built with the gtf_nosafe build tag.
+var GtfSafeFuncMap = htmlTemplate.FuncMap{
+	"safehtml": func(value interface{}) htmlTemplate.HTML {
+		defer recovery()
+
+		return htmlTemplate.HTML(toText(value))
+	},
+	"safeurl": func(value interface{}) htmlTemplate.URL {
+		defer recovery()
+
+		return htmlTemplate.URL(toText(value))
+	},
+	"safejs": func(value interface{}) htmlTemplate.JS {
+		defer recovery()
+
+		return htmlTemplate.JS(toText(value))
+	},
+	"safecss": func(value interface{}) htmlTemplate.CSS {
+		defer recovery()
+
+		return htmlTemplate.CSS(toText(value))
+	},
+	"safeattr": func(value interface{}) htmlTemplate.HTMLAttr {
+		defer recovery()
+
+		return htmlTemplate.HTMLAttr(toText(value))
+	},
+	"safesrcset": func(value interface{}) htmlTemplate.Srcset {
+		defer recovery()
+
+		return htmlTemplate.Srcset(toText(value))
+	},
+	"safeseq": func(value interface{}) interface{} {
+		defer recovery()
+
+		return safeSeq(value)
+	},
+}
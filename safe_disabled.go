@@ -0,0 +1,6 @@
+//go:build gtf_nosafe
+// +build gtf_nosafe
+
+package gtf
+
+const safeFuncsEnabled = false
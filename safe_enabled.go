@@ -0,0 +1,10 @@
+//go:build !gtf_nosafe
+// +build !gtf_nosafe
+
+package gtf
+
+const safeFuncsEnabled = true
+
+func init() {
+	registerFuncs(GtfSafeFuncMap)
+}
@@ -0,0 +1,51 @@
+package gtf
+
+import (
+	"bytes"
+	htmlTemplate "html/template"
+	"testing"
+)
+
+type safeTestIcon struct{}
+
+func (safeTestIcon) String() string {
+	return "<svg/>"
+}
+
+func TestSafeFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "{{ . }} {{ . | safehtml }}", "<b>go</b>")
+	AssertEqual(t, &buffer, "&lt;b&gt;go&lt;/b&gt; <b>go</b>")
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "{{ . | safehtml }}", safeTestIcon{})
+	AssertEqual(t, &buffer, "<svg/>")
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "{{ . | safehtml }}", htmlTemplate.HTML("<i>"))
+	AssertEqual(t, &buffer, "<i>")
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, `<a href="{{ . }}"></a><a href="{{ . | safeurl }}"></a>`, "tel:+1-555-0100")
+	AssertEqual(t, &buffer, `<a href="#ZgotmplZ"></a><a href="tel:&#43;1-555-0100"></a>`)
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "<script>var f = {{ . | safejs }};</script>", "function() { return 1 }")
+	AssertEqual(t, &buffer, "<script>var f = function() { return 1 };</script>")
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, `<p style="{{ . }}"></p><p style="{{ . | safecss }}"></p>`, "color: expression(1)")
+	AssertEqual(t, &buffer, `<p style="ZgotmplZ"></p><p style="color: expression(1)"></p>`)
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "<input {{ . | safeattr }}>", `type="checkbox" checked`)
+	AssertEqual(t, &buffer, `<input type="checkbox" checked>`)
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, `<img srcset="{{ . | safesrcset }}">`, "a.png 1x, b.png 2x")
+	AssertEqual(t, &buffer, `<img srcset="a.png 1x, b.png 2x">`)
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "{{ range . | safeseq }}{{ . }}{{ end }}", []string{"<b>", "<i>"})
+	AssertEqual(t, &buffer, "<b><i>")
+
+	CustomParseTest(GtfSafeFuncMap, &buffer, "{{ . | safeseq }}", "<b>")
+	AssertEqual(t, &buffer, "")
+}
+
+func TestSafeFuncsRegistered(t *testing.T) {
+	AssertRegistered(t, GtfSafeFuncMap, safeFuncsEnabled)
+}
@@ -0,0 +1,277 @@
+package gtf
+
+import (
+	"bytes"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// HTMLPolicy is an allowlist of HTML elements and attributes. Sanitize
+// removes everything else.
+type HTMLPolicy struct {
+	// Elements maps the allowed element names to their allowed
+	// attributes.
+	Elements map[string][]string
+
+	// URLSchemes lists the schemes allowed in URL attributes like href
+	// and src. Relative URLs are always allowed.
+	URLSchemes []string
+
+	// LinkRel is set as the rel attribute of all links if it is not
+	// empty.
+	LinkRel string
+}
+
+// MarkdownPolicy is the policy used to sanitize the output of the
+// markdown function. Modify it before executing templates; it is not
+// safe to change it concurrently with template execution.
+var MarkdownPolicy = &HTMLPolicy{
+	Elements: map[string][]string{
+		"a":          {"href", "title"},
+		"blockquote": {},
+		"br":         {},
+		"code":       {"class"},
+		"del":        {},
+		"em":         {},
+		"h1":         {},
+		"h2":         {},
+		"h3":         {},
+		"h4":         {},
+		"h5":         {},
+		"h6":         {},
+		"hr":         {},
+		"img":        {"src", "alt", "title"},
+		"li":         {},
+		"ol":         {"start"},
+		"p":          {},
+		"pre":        {},
+		"strong":     {},
+		"table":      {},
+		"tbody":      {},
+		"td":         {"align"},
+		"th":         {"align"},
+		"thead":      {},
+		"tr":         {},
+		"ul":         {},
+		"b":          {},
+		"i":          {},
+		"s":          {},
+		"sub":        {},
+		"sup":        {},
+		"kbd":        {},
+		"abbr":       {"title"},
+		"dl":         {},
+		"dt":         {},
+		"dd":         {},
+	},
+	URLSchemes: []string{"http", "https", "mailto"},
+	LinkRel:    "nofollow",
+}
+
+// voidElements have no content and no end tag.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// droppedElements are removed together with their content, which is not
+// meant to be displayed as text.
+var droppedElements = map[string]bool{
+	"script": true, "style": true, "iframe": true, "noscript": true,
+	"noembed": true, "noframes": true, "template": true, "textarea": true,
+	"title": true, "xmp": true, "object": true,
+}
+
+var (
+	htmlTagPattern  = regexp.MustCompile(`^<(/?)([A-Za-z][A-Za-z0-9-]*)((?:\s+[^\s"'>/=]+(?:\s*=\s*(?:"[^"]*"|'[^']*'|[^\s"'=<>` + "`" + `]+))?)*)\s*/?>`)
+	htmlAttrPattern = regexp.MustCompile(`([^\s"'>/=]+)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+)))?`)
+)
+
+// allowsURL reports whether the URL s is relative or uses one of the
+// allowed schemes.
+func (p *HTMLPolicy) allowsURL(s string) bool {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return true
+	}
+
+	for _, scheme := range p.URLSchemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeStartTag writes an allowed start tag with its allowed attributes.
+func (p *HTMLPolicy) writeStartTag(buffer *bytes.Buffer, name string, attrs string, allowed []string) {
+	buffer.WriteString("<" + name)
+
+	seen := map[string]bool{}
+	for _, m := range htmlAttrPattern.FindAllStringSubmatch(attrs, -1) {
+		key := strings.ToLower(m[1])
+		value := html.UnescapeString(m[2] + m[3] + m[4])
+		if seen[key] || (name == "a" && key == "rel" && p.LinkRel != "") {
+			continue
+		}
+		seen[key] = true
+
+		ok := false
+		for _, a := range allowed {
+			ok = ok || key == a
+		}
+		if !ok || (urlAttrs[key] && !p.allowsURL(value)) {
+			continue
+		}
+
+		buffer.WriteString(" " + key + `="` + html.EscapeString(value) + `"`)
+	}
+
+	if name == "a" && p.LinkRel != "" {
+		buffer.WriteString(` rel="` + html.EscapeString(p.LinkRel) + `"`)
+	}
+
+	if voidElements[name] {
+		buffer.WriteString(" />")
+	} else {
+		buffer.WriteString(">")
+	}
+}
+
+// htmlTokenKind is the kind of an htmlToken.
+type htmlTokenKind int
+
+const (
+	htmlText htmlTokenKind = iota
+	htmlStartTag
+	htmlEndTag
+)
+
+// htmlToken is a piece of text, a start tag or an end tag of an HTML
+// document. The text is not decoded.
+type htmlToken struct {
+	kind  htmlTokenKind
+	name  string // lower case element name
+	attrs string // attributes of start tags
+	text  string
+}
+
+// tokenizeHTML splits s into text, start tags and end tags. Comments,
+// declarations and processing instructions are skipped, and so are the
+// elements in droppedElements together with their content. A "<" which
+// does not start a tag is text.
+func tokenizeHTML(s string) []htmlToken {
+	var tokens []htmlToken
+
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			tokens = append(tokens, htmlToken{kind: htmlText, text: s})
+			break
+		}
+		if i > 0 {
+			tokens = append(tokens, htmlToken{kind: htmlText, text: s[:i]})
+		}
+		s = s[i:]
+
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			if end := strings.Index(s[4:], "-->"); end >= 0 {
+				s = s[4+end+3:]
+			} else {
+				s = ""
+			}
+			continue
+		case strings.HasPrefix(s, "<!"), strings.HasPrefix(s, "<?"):
+			if end := strings.IndexByte(s, '>'); end >= 0 {
+				s = s[end+1:]
+			} else {
+				s = ""
+			}
+			continue
+		}
+
+		m := htmlTagPattern.FindStringSubmatch(s)
+		if m == nil {
+			tokens = append(tokens, htmlToken{kind: htmlText, text: "<"})
+			s = s[1:]
+			continue
+		}
+		s = s[len(m[0]):]
+		name := strings.ToLower(m[2])
+
+		switch {
+		case m[1] == "/":
+			tokens = append(tokens, htmlToken{kind: htmlEndTag, name: name})
+		case droppedElements[name]:
+			end := strings.Index(strings.ToLower(s), "</"+name)
+			if end < 0 {
+				s = ""
+			} else if gt := strings.IndexByte(s[end:], '>'); gt >= 0 {
+				s = s[end+gt+1:]
+			} else {
+				s = ""
+			}
+		default:
+			tokens = append(tokens, htmlToken{kind: htmlStartTag, name: name, attrs: m[3]})
+		}
+	}
+
+	return tokens
+}
+
+// Sanitize removes the elements and attributes which are not allowed by
+// the policy from s. The content of removed elements is kept, except for
+// elements like script and style. Comments are removed, text is escaped
+// and all elements are properly closed.
+func (p *HTMLPolicy) Sanitize(s string) string {
+	var buffer bytes.Buffer
+	var open []string
+
+	// openCount counts the open elements by name, so end tags of elements
+	// which are not open are skipped without searching the stack.
+	openCount := map[string]int{}
+
+	for _, token := range tokenizeHTML(s) {
+		switch token.kind {
+		case htmlText:
+			buffer.WriteString(html.EscapeString(html.UnescapeString(token.text)))
+		case htmlEndTag:
+			if openCount[token.name] == 0 {
+				continue
+			}
+			for {
+				name := open[len(open)-1]
+				open = open[:len(open)-1]
+				openCount[name]--
+				buffer.WriteString("</" + name + ">")
+				if name == token.name {
+					break
+				}
+			}
+		case htmlStartTag:
+			allowed, ok := p.Elements[token.name]
+			if !ok {
+				continue
+			}
+			p.writeStartTag(&buffer, token.name, token.attrs, allowed)
+			if !voidElements[token.name] {
+				open = append(open, token.name)
+				openCount[token.name]++
+			}
+		}
+	}
+
+	for j := len(open) - 1; j >= 0; j-- {
+		buffer.WriteString("</" + open[j] + ">")
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,66 @@
+package gtf
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSanitize(t *testing.T) {
+	policy := &HTMLPolicy{
+		Elements: map[string][]string{
+			"a": {"href"},
+			"p": {"class"},
+			"b": {},
+		},
+		URLSchemes: []string{"https"},
+	}
+
+	cases := map[string]string{
+		`<p class="x" id="y">text</p>`:                   `<p class="x">text</p>`,
+		`<P CLASS=x>upper</P>`:                           `<p class="x">upper</p>`,
+		`<a href="https://go.dev" rel="me">go</a>`:       `<a href="https://go.dev">go</a>`,
+		`<a href="http://go.dev">go</a>`:                 `<a>go</a>`,
+		`<a href="/relative?a=1&amp;b=2">r</a>`:          `<a href="/relative?a=1&amp;b=2">r</a>`,
+		`<a href="  javascript:alert(1)">x</a>`:          `<a>x</a>`,
+		`<a href="java&#09;script:alert(1)">x</a>`:       `<a>x</a>`,
+		`<i>dropped</i> <b>kept`:                         `dropped <b>kept</b>`,
+		`<b><p>misnested</b></p>`:                        `<b><p>misnested</p></b>`,
+		`</p>stray end`:                                  `stray end`,
+		`<script>alert("<b>")</script>after`:             `after`,
+		`<STYLE>p {}</STYLE><style>`:                     ``,
+		`a < b && c > d`:                                 `a &lt; b &amp;&amp; c &gt; d`,
+		`<!-- <b>comment</b> --><!DOCTYPE html><?x ?>ok`: `ok`,
+		`<p title='"><script>x</script>'>t</p>`:          `<p>t</p>`,
+		`<scr<script>ipt>alert(1)</script>`:              `&lt;scr`,
+		`&lt;b&gt; &amp;amp;`:                            `&lt;b&gt; &amp;amp;`,
+	}
+
+	for input, expected := range cases {
+		if output := policy.Sanitize(input); output != expected {
+			t.Errorf("Sanitize(%q) = %q, want %q", input, output, expected)
+		}
+	}
+
+	policy.LinkRel = "nofollow noopener"
+	if output := policy.Sanitize(`<a href="/x" rel="me">x</a>`); output != `<a href="/x" rel="nofollow noopener">x</a>` {
+		t.Errorf("Sanitize with LinkRel = %q", output)
+	}
+
+	if output := MarkdownPolicy.Sanitize(`<img src="/a.png" alt="a" onerror="x"><br>`); output != `<img src="/a.png" alt="a" /><br />` {
+		t.Errorf("MarkdownPolicy.Sanitize = %q", output)
+	}
+}
+
+func TestSanitizeUnmatchedEndTags(t *testing.T) {
+	input := strings.Repeat("<b>", 20000) + strings.Repeat("</i>", 20000) + "</b>"
+	expected := strings.Repeat("<b>", 20000) + strings.Repeat("</b>", 20000)
+
+	start := time.Now()
+	if output := MarkdownPolicy.Sanitize(input); output != expected {
+		t.Errorf("Sanitize of unmatched end tags = %.40q...", output)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Sanitize of 20000 unmatched end tags took %v", elapsed)
+	}
+}
@@ -0,0 +1,287 @@
+package gtf
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"html"
+	"reflect"
+	"strings"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// structField describes an exported struct field as seen by the
+// serialization functions.
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structFields lists the serializable fields of t. Names are taken from
+// the given struct tags in order, then from nameFunc. Embedded structs
+// without a name tag are inlined.
+func structFields(t reflect.Type, tags []string, nameFunc func(string) string) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+
+		name := ""
+		omitEmpty := false
+		for _, tag := range tags {
+			value, ok := f.Tag.Lookup(tag)
+			if !ok {
+				continue
+			}
+			parts := strings.Split(value, ",")
+			name = parts[0]
+			for _, option := range parts[1:] {
+				if option == "omitempty" {
+					omitEmpty = true
+				}
+			}
+			break
+		}
+
+		if name == "-" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for _, inner := range structFields(ft, tags, nameFunc) {
+				inner.index = append([]int{i}, inner.index...)
+				fields = append(fields, inner)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = nameFunc(f.Name)
+		}
+		fields = append(fields, structField{name, f.Index, omitEmpty})
+	}
+
+	return fields
+}
+
+// keyValue is an entry of a serialized map or struct.
+type keyValue struct {
+	key   string
+	value reflect.Value
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but returns the
+// invalid Value instead of panicking on nil embedded pointers.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 {
+			v = indirect(v)
+			if !v.IsValid() {
+				return v
+			}
+		}
+		v = v.Field(x)
+	}
+
+	return v
+}
+
+// textValue returns the text form of values implementing
+// encoding.TextMarshaler.
+func textValue(v reflect.Value) (string, bool) {
+	if !v.IsValid() || !v.CanInterface() || !v.Type().Implements(textMarshalerType) {
+		return "", false
+	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return "", false
+	}
+
+	text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return "", false
+	}
+
+	return string(text), true
+}
+
+// toJSON encodes value as JSON. If escapeHTML is true, <, > and & are
+// escaped so the result can be embedded in a script element.
+// U+2028 and U+2029 are always escaped.
+func toJSON(value interface{}, indent string, escapeHTML bool) (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(escapeHTML)
+	encoder.SetIndent("", indent)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// jsonScript wraps the script safe JSON of value in a script element of
+// type application/json, like Django's json_script.
+func jsonScript(id string, value interface{}) (string, error) {
+	data, err := toJSON(value, "", true)
+	if err != nil {
+		return "", err
+	}
+
+	attrs := ""
+	if id != "" {
+		attrs = ` id="` + html.EscapeString(id) + `"`
+	}
+
+	return `<script` + attrs + ` type="application/json">` + data + `</script>`, nil
+}
+
+func fromJSON(s string) interface{} {
+	var result interface{}
+	if err := json.Unmarshal([]byte(s), &result); err != nil {
+		return ""
+	}
+
+	return result
+}
+
+// csvRecord converts a slice, array, map or struct into CSV fields.
+func csvRecord(v reflect.Value) ([]string, bool) {
+	v = indirect(v)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		record := make([]string, v.Len())
+		for i := range record {
+			record[i] = stringify(indirect(v.Index(i)))
+		}
+		return record, true
+	case reflect.Map:
+		keys := sortedKeys(v)
+		record := make([]string, len(keys))
+		for i, key := range keys {
+			record[i] = stringify(indirect(v.MapIndex(key)))
+		}
+		return record, true
+	case reflect.Struct:
+		fields := structFields(v.Type(), []string{"csv", "json"}, identity)
+		record := make([]string, len(fields))
+		for i, f := range fields {
+			record[i] = stringify(indirect(fieldByIndex(v, f.index)))
+		}
+		return record, true
+	}
+
+	return nil, false
+}
+
+// csvHeader returns the column names for rows of maps or structs. It
+// returns nil for rows of slices.
+func csvHeader(v reflect.Value) []string {
+	v = indirect(v)
+	switch v.Kind() {
+	case reflect.Map:
+		keys := sortedKeys(v)
+		header := make([]string, len(keys))
+		for i, key := range keys {
+			header[i] = stringify(key)
+		}
+		return header
+	case reflect.Struct:
+		fields := structFields(v.Type(), []string{"csv", "json"}, identity)
+		header := make([]string, len(fields))
+		for i, f := range fields {
+			header[i] = f.name
+		}
+		return header
+	}
+
+	return nil
+}
+
+func writeCSV(records [][]string) (string, error) {
+	var buffer bytes.Buffer
+
+	w := csv.NewWriter(&buffer)
+	if err := w.WriteAll(records); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// toCSV renders a slice of rows as CSV. Rows of maps and structs get a
+// header line.
+func toCSV(value interface{}) string {
+	items, _, ok := listItems(value)
+	if !ok {
+		return ""
+	}
+
+	var header []string
+	var records [][]string
+	if len(items) > 0 {
+		if header = csvHeader(items[0]); header != nil {
+			records = append(records, header)
+		}
+	}
+
+	for _, item := range items {
+		if header != nil && indirect(item).Kind() == reflect.Map {
+			// Maps may have different keys, so follow the header's columns.
+			record := make([]string, len(header))
+			for i, name := range header {
+				column, _ := attribute(item, name)
+				record[i] = stringify(indirect(column))
+			}
+			records = append(records, record)
+			continue
+		}
+
+		record, ok := csvRecord(item)
+		if !ok {
+			return ""
+		}
+		records = append(records, record)
+	}
+
+	result, err := writeCSV(records)
+	if err != nil {
+		return ""
+	}
+
+	return result
+}
+
+func csvRow(value interface{}) string {
+	record, ok := csvRecord(reflect.ValueOf(value))
+	if !ok {
+		return ""
+	}
+
+	result, err := writeCSV([][]string{record})
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(result, "\n")
+}
+
+func identity(s string) string {
+	return s
+}
+
+func lowerFieldName(s string) string {
+	return strings.ToLower(s)
+}
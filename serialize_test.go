@@ -0,0 +1,62 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+type serializeTestUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty" csv:"mail"`
+	Age   int
+}
+
+func TestSerializeFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "<script>var user = {{ . | tojson }};</script>", serializeTestUser{Name: "</script><b>&", Age: 10})
+	AssertEqual(t, &buffer, `<script>var user = {"name":"\u003c/script\u003e\u003cb\u003e\u0026","Age":10};</script>`)
+
+	ParseTest(&buffer, "<script>var s = {{ . | tojson }};</script>", "\u2028\u2029")
+	AssertEqual(t, &buffer, `<script>var s = "\u2028\u2029";</script>`)
+
+	ParseTest(&buffer, "<script>var s = {{ . | toprettyjson }};</script>", map[string]int{"a": 1})
+	AssertEqual(t, &buffer, "<script>var s = {\n  \"a\": 1\n};</script>")
+
+	ParseTest(&buffer, "{{ $v := . | fromjson }}{{ $v.name }} {{ index $v.tags 1 }}", `{"name": "go", "tags": ["fast", "simple"]}`)
+	AssertEqual(t, &buffer, "go simple")
+
+	ParseTest(&buffer, "{{ . | fromjson }}", `{"name": `)
+	AssertEqual(t, &buffer, "")
+
+	ParseTest(&buffer, "{{ . | tocsv }}", [][]string{{"go", "2009"}, {"hello, world", "say \"hi\""}})
+	AssertEqual(t, &buffer, "go,2009\n&#34;hello, world&#34;,&#34;say &#34;&#34;hi&#34;&#34;&#34;\n")
+
+	TextTemplateParseTest(&buffer, "{{ . | tocsv }}", []serializeTestUser{{"go", "go@example.com", 10}, {"ruby", "", 20}})
+	AssertEqual(t, &buffer, "name,mail,Age\ngo,go@example.com,10\nruby,,20\n")
+
+	TextTemplateParseTest(&buffer, "{{ . | tocsv }}", []map[string]interface{}{{"b": 2, "a": 1}, {"a": "x"}})
+	AssertEqual(t, &buffer, "a,b\n1,2\nx,\n")
+
+	TextTemplateParseTest(&buffer, "{{ . | tocsv }}", "go")
+	AssertEqual(t, &buffer, "")
+
+	TextTemplateParseTest(&buffer, "{{ . | csvrow }}", []interface{}{"a,b", 1, true})
+	AssertEqual(t, &buffer, "\"a,b\",1,true")
+}
+
+func TestJSONScript(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ . | json_script \"initial-data\" }}", map[string]string{"hello": "</script><script>alert(1)</script>"})
+	AssertEqual(t, &buffer, `<script id="initial-data" type="application/json">{"hello":"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e"}</script>`)
+
+	ParseTest(&buffer, "{{ . | json_script \"a\\\"b\" }}", 1)
+	AssertEqual(t, &buffer, `<script id="a&#34;b" type="application/json">1</script>`)
+
+	ParseTest(&buffer, "{{ . | json_script \"\" }}", []int{1, 2})
+	AssertEqual(t, &buffer, `<script type="application/json">[1,2]</script>`)
+
+	TextTemplateParseTest(&buffer, "{{ . | json_script \"data\" }}", "&")
+	AssertEqual(t, &buffer, `<script id="data" type="application/json">"\u0026"</script>`)
+}
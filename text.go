@@ -0,0 +1,314 @@
+package gtf
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isWide reports whether r is an East Asian wide or fullwidth character
+// or an emoji, which take two columns in a terminal.
+func isWide(r rune) bool {
+	switch {
+	case r >= 0x1100 && r <= 0x115f, // Hangul Jamo initial consonants
+		r >= 0x2e80 && r <= 0x303e, // CJK radicals, punctuation
+		r >= 0x3041 && r <= 0x33ff, // kana, CJK compatibility
+		r >= 0x3400 && r <= 0x4dbf, // CJK extension A
+		r >= 0x4e00 && r <= 0x9fff, // CJK unified ideographs
+		r >= 0xa000 && r <= 0xa4cf, // Yi
+		r >= 0xa960 && r <= 0xa97f, // Hangul Jamo extended A
+		r >= 0xac00 && r <= 0xd7a3, // Hangul syllables
+		r >= 0xf900 && r <= 0xfaff, // CJK compatibility ideographs
+		r >= 0xfe30 && r <= 0xfe4f, // CJK compatibility forms
+		r >= 0xff00 && r <= 0xff60, // fullwidth forms
+		r >= 0xffe0 && r <= 0xffe6,
+		r >= 0x1f300 && r <= 0x1f64f, // pictographs, emoticons
+		r >= 0x1f900 && r <= 0x1f9ff, // supplemental pictographs
+		r >= 0x20000 && r <= 0x3fffd: // CJK extensions B and later
+		return true
+	}
+
+	return false
+}
+
+// graphemeWidth returns the number of columns taken by a grapheme
+// cluster.
+func graphemeWidth(g string) int {
+	r, _ := utf8.DecodeRuneInString(g)
+	switch {
+	case r < ' ' || r == 0x7f || isGraphemeExtend(r) || unicode.Is(unicode.Cf, r):
+		return 0
+	case isWide(r) || isRegionalIndicator(r) || strings.ContainsRune(g, '\ufe0f'):
+		return 2
+	}
+
+	return 1
+}
+
+// displayWidth returns the number of columns s takes in a monospaced
+// font.
+func displayWidth(s string) int {
+	width := 0
+	for _, g := range graphemes(s) {
+		width += graphemeWidth(g)
+	}
+
+	return width
+}
+
+// indentArg returns the indentation given as a number of spaces or as a
+// string.
+func indentArg(arg interface{}) string {
+	if s, ok := arg.(string); ok {
+		return s
+	}
+	if n, ok := toInt(arg); ok && n > 0 {
+		return strings.Repeat(" ", n)
+	}
+
+	return ""
+}
+
+// indent indents the lines of s like Jinja's indent. The first line and
+// blank lines are only indented if first and blank are true.
+func indent(s string, prefix string, first, blank bool) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if i == 0 && !first {
+			continue
+		}
+		if strings.TrimSpace(line) == "" && !blank {
+			continue
+		}
+		lines[i] = prefix + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// indentArgs parses the arguments of indent: the optional width or
+// prefix, then the optional first and blank flags.
+func indentArgs(args []interface{}) (string, bool, bool) {
+	prefix := "    "
+	var flags []bool
+	for i, arg := range args {
+		if b, ok := arg.(bool); ok {
+			flags = append(flags, b)
+		} else if i == 0 {
+			prefix = indentArg(arg)
+		}
+	}
+
+	first := len(flags) > 0 && flags[0]
+	blank := len(flags) > 1 && flags[1]
+
+	return prefix, first, blank
+}
+
+// leadingWhitespace returns the spaces and tabs at the beginning of s.
+func leadingWhitespace(s string) string {
+	return s[:len(s)-len(strings.TrimLeft(s, " \t"))]
+}
+
+// dedent removes the whitespace common to the beginning of all lines,
+// like Python's textwrap.dedent. Lines consisting only of whitespace are
+// ignored and emptied.
+func dedent(s string) string {
+	lines := strings.Split(s, "\n")
+
+	common := ""
+	found := false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		prefix := leadingWhitespace(line)
+		if !found {
+			common = prefix
+			found = true
+			continue
+		}
+		for !strings.HasPrefix(prefix, common) {
+			common = common[:len(common)-1]
+		}
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+		} else {
+			lines[i] = line[len(common):]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// wordWrap wraps s at width characters like Django's wordwrap. Existing
+// line breaks are kept and words longer than width are not broken.
+func wordWrap(width int, s string) string {
+	var buffer bytes.Buffer
+
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			buffer.WriteByte('\n')
+		}
+
+		runes := []rune(line)
+		for len(runes) > width {
+			space := -1
+			for j := width; j >= 0; j-- {
+				if runes[j] == ' ' {
+					space = j
+					break
+				}
+			}
+			if space < 0 {
+				for j := width + 1; j < len(runes); j++ {
+					if runes[j] == ' ' {
+						space = j
+						break
+					}
+				}
+			}
+			if space < 0 {
+				break
+			}
+			buffer.WriteString(string(runes[:space]) + "\n")
+			runes = runes[space+1:]
+		}
+		buffer.WriteString(string(runes))
+	}
+
+	return buffer.String()
+}
+
+// isURLWord reports whether word looks like a URL, which wrap never
+// breaks.
+func isURLWord(word string) bool {
+	lower := strings.ToLower(word)
+	for _, prefix := range []string{"http://", "https://", "ftp://", "mailto:", "www."} {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+
+	return strings.Contains(lower, "://")
+}
+
+// wrapLine wraps a single line at width columns. Lines after the first
+// one start with hang. The indentation of the line is kept.
+func wrapLine(buffer *bytes.Buffer, line string, width int, hang string) {
+	current := leadingWhitespace(line)
+	currentWidth := displayWidth(current)
+	empty := true
+
+	flush := func() {
+		buffer.WriteString(strings.TrimRight(current, " \t") + "\n")
+		current = hang
+		currentWidth = displayWidth(hang)
+		empty = true
+	}
+
+	for _, word := range strings.Fields(line) {
+		wordWidth := displayWidth(word)
+		if !empty {
+			if currentWidth+1+wordWidth <= width {
+				current += " " + word
+				currentWidth += 1 + wordWidth
+				continue
+			}
+			flush()
+		}
+
+		if currentWidth+wordWidth <= width || isURLWord(word) {
+			current += word
+			currentWidth += wordWidth
+			empty = false
+			continue
+		}
+
+		// Break the word into pieces filling the remaining columns,
+		// putting at least one grapheme on every line.
+		for _, g := range graphemes(word) {
+			w := graphemeWidth(g)
+			if !empty && currentWidth+w > width {
+				flush()
+			}
+			current += g
+			currentWidth += w
+			empty = false
+		}
+	}
+
+	buffer.WriteString(strings.TrimRight(current, " \t"))
+}
+
+// wrap wraps s at width display columns. Existing line breaks are kept,
+// wrapped lines start with hang, and URLs are never broken. Wide
+// characters like CJK ideographs count as two columns.
+func wrap(width int, hang string, s string) string {
+	var buffer bytes.Buffer
+
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			buffer.WriteByte('\n')
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		wrapLine(&buffer, line, width, hang)
+	}
+
+	return buffer.String()
+}
+
+// normalizeNewlines converts \r\n and \r line breaks into \n.
+func normalizeNewlines(s string) string {
+	return strings.Replace(strings.Replace(s, "\r\n", "\n", -1), "\r", "\n", -1)
+}
+
+// escapeIf returns s escaped for HTML if escape is true.
+func escapeIf(s string, escape bool) string {
+	if escape {
+		return html.EscapeString(s)
+	}
+
+	return s
+}
+
+var paragraphSeparator = regexp.MustCompile(`\n{2,}`)
+
+// linebreaks converts blank line separated paragraphs into <p> elements
+// and single line breaks into <br>, like Django's linebreaks.
+func linebreaks(s string, escape bool) string {
+	paragraphs := paragraphSeparator.Split(normalizeNewlines(s), -1)
+	for i, p := range paragraphs {
+		paragraphs[i] = "<p>" + strings.Replace(escapeIf(p, escape), "\n", "<br>", -1) + "</p>"
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// linebreaksBR converts line breaks into <br>, like Django's
+// linebreaksbr.
+func linebreaksBR(s string, escape bool) string {
+	return strings.Replace(escapeIf(normalizeNewlines(s), escape), "\n", "<br>", -1)
+}
+
+// lineNumbers prefixes every line with its number, padded with zeros to
+// the width of the last line number.
+func lineNumbers(s string, escape bool) string {
+	lines := strings.Split(normalizeNewlines(s), "\n")
+	width := len(strconv.Itoa(len(lines)))
+	for i, line := range lines {
+		lines[i] = fmt.Sprintf("%0*d. %s", width, i+1, escapeIf(line, escape))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,101 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestIndentFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	text := "a:\n  b: 1\n\nc: 2"
+
+	TextTemplateParseTest(&buffer, "{{ . | indent }}", text)
+	AssertEqual(t, &buffer, "a:\n      b: 1\n\n    c: 2")
+
+	TextTemplateParseTest(&buffer, "{{ . | indent 2 true }}", text)
+	AssertEqual(t, &buffer, "  a:\n    b: 1\n\n  c: 2")
+
+	TextTemplateParseTest(&buffer, "{{ . | indent 2 true true }}", text)
+	AssertEqual(t, &buffer, "  a:\n    b: 1\n  \n  c: 2")
+
+	TextTemplateParseTest(&buffer, "{{ . | indent \"> \" true }}", "go\nruby")
+	AssertEqual(t, &buffer, "> go\n> ruby")
+
+	TextTemplateParseTest(&buffer, "spec:{{ . | nindent 2 }}", "a: 1\nb: 2")
+	AssertEqual(t, &buffer, "spec:\n  a: 1\n  b: 2")
+
+	TextTemplateParseTest(&buffer, "{{ . | dedent }}", "    def f():\n        return 1\n  \n    f()")
+	AssertEqual(t, &buffer, "def f():\n    return 1\n\nf()")
+
+	TextTemplateParseTest(&buffer, "{{ . | dedent }}", "\tgo\n  ruby")
+	AssertEqual(t, &buffer, "\tgo\n  ruby")
+}
+
+func TestWrapFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ . | wordwrap 5 }}", "Joel is a slug")
+	AssertEqual(t, &buffer, "Joel\nis a\nslug")
+
+	ParseTest(&buffer, "{{ . | wordwrap 3 }}", "extraordinary go\nab cd")
+	AssertEqual(t, &buffer, "extraordinary\ngo\nab\ncd")
+
+	ParseTest(&buffer, "{{ . | wordwrap 0 }}", "Joel is a slug")
+	AssertEqual(t, &buffer, "Joel is a slug")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 10 }}", "the go programming language\n\nis fun")
+	AssertEqual(t, &buffer, "the go\nprogrammin\ng language\n\nis fun")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 12 2 }}", "- the go programming language")
+	AssertEqual(t, &buffer, "- the go\n  programmin\n  g language")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 10 \"> \" }}", "see https://github.com/leekchan/gtf for details")
+	AssertEqual(t, &buffer, "see\n> https://github.com/leekchan/gtf\n> for\n> details")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 6 }}", "한국어 텍스트")
+	AssertEqual(t, &buffer, "한국어\n텍스트")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 4 }}", "日本語テキスト")
+	AssertEqual(t, &buffer, "日本\n語テ\nキス\nト")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 10 }}", "  indented text here")
+	AssertEqual(t, &buffer, "  indented\ntext here")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap 0 }}", "the go programming language")
+	AssertEqual(t, &buffer, "the go programming language")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap -1 2 }}", "the go programming language")
+	AssertEqual(t, &buffer, "the go programming language")
+
+	TextTemplateParseTest(&buffer, "{{ . | wrap \"ten\" }}", "the go programming language")
+	AssertEqual(t, &buffer, "the go programming language")
+
+	if w := displayWidth("e\u0301\U0001F44D\U0001F3FD한"); w != 5 {
+		t.Errorf("displayWidth = %d, want 5", w)
+	}
+}
+
+func TestLinebreaksFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	text := "Go & <Ruby>\r\nPython\r\rRust\n\n\nC"
+
+	ParseTest(&buffer, "{{ . | linebreaks }}", text)
+	AssertEqual(t, &buffer, "<p>Go &amp; &lt;Ruby&gt;<br>Python</p>\n\n<p>Rust</p>\n\n<p>C</p>")
+
+	TextTemplateParseTest(&buffer, "{{ . | linebreaks }}", text)
+	AssertEqual(t, &buffer, "<p>Go & <Ruby><br>Python</p>\n\n<p>Rust</p>\n\n<p>C</p>")
+
+	ParseTest(&buffer, "{{ . | linebreaksbr }}", "a\r\nb\rc\n<d>")
+	AssertEqual(t, &buffer, "a<br>b<br>c<br>&lt;d&gt;")
+
+	TextTemplateParseTest(&buffer, "{{ . | linebreaksbr }}", "a\r\nb")
+	AssertEqual(t, &buffer, "a<br>b")
+
+	ParseTest(&buffer, "{{ . | linenumbers }}", "a\r\nb\rc\nd\ne\nf\ng\nh\ni\n<j>")
+	AssertEqual(t, &buffer, "01. a\n02. b\n03. c\n04. d\n05. e\n06. f\n07. g\n08. h\n09. i\n10. &lt;j&gt;")
+
+	TextTemplateParseTest(&buffer, "{{ . | linenumbers }}", "<a>\nb")
+	AssertEqual(t, &buffer, "1. <a>\n2. b")
+}
@@ -0,0 +1,122 @@
+package gtf
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// titleCaser maps the first letter of a word to title case following the
+// rules of a language.
+type titleCaser struct {
+	special unicode.SpecialCase
+	dutch   bool
+}
+
+// titleCaserFor returns the caser for a BCP 47 language tag like "tr" or
+// "nl-BE". Unknown languages use the default Unicode case mapping.
+func titleCaserFor(lang string) titleCaser {
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+
+	switch lang {
+	case "tr":
+		return titleCaser{special: unicode.TurkishCase}
+	case "az":
+		return titleCaser{special: unicode.AzeriCase}
+	case "nl":
+		return titleCaser{dutch: true}
+	}
+
+	return titleCaser{}
+}
+
+func (c titleCaser) toTitle(r rune) rune {
+	if c.special != nil {
+		return c.special.ToTitle(r)
+	}
+
+	return unicode.ToTitle(r)
+}
+
+// capitalizeWord title cases the first letter of word. In Dutch, the
+// digraph "ij" is capitalized as a whole ("IJsland").
+func (c titleCaser) capitalizeWord(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	if size == 0 || !unicode.IsLetter(r) {
+		return word
+	}
+
+	if c.dutch && (r == 'i' || r == 'I') && size < len(word) && (word[size] == 'j' || word[size] == 'J') {
+		return "IJ" + word[size+1:]
+	}
+
+	return string(c.toTitle(r)) + word[size:]
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// splitTitleWords splits s into words and the text between them. An
+// apostrophe between two letters belongs to the word, so "don't" is a
+// single word.
+func splitTitleWords(s string) (parts []string, isWord []bool) {
+	runes := []rune(s)
+	start := 0
+	inWord := false
+	for i := 0; i <= len(runes); i++ {
+		word := false
+		if i < len(runes) {
+			r := runes[i]
+			word = isWordRune(r) ||
+				(inWord && isApostrophe(r) && i+1 < len(runes) && unicode.IsLetter(runes[i+1]))
+		}
+
+		if i == len(runes) || word != inWord {
+			if i > start {
+				parts = append(parts, string(runes[start:i]))
+				isWord = append(isWord, inWord)
+			}
+			start = i
+			inWord = word
+		}
+	}
+
+	return parts, isWord
+}
+
+// titleCase capitalizes the first letter of every word of s using the
+// rules of lang.
+func titleCase(lang string, s string) string {
+	c := titleCaserFor(lang)
+	parts, isWord := splitTitleWords(s)
+	for i, part := range parts {
+		if isWord[i] {
+			parts[i] = c.capitalizeWord(part)
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// capFirst capitalizes the first character of s using the rules of lang.
+func capFirst(lang string, s string) string {
+	return titleCaserFor(lang).capitalizeWord(s)
+}
+
+// langArgs splits the arguments of title and capfirst into the optional
+// language and the value.
+func langArgs(args []string) (string, string) {
+	if len(args) > 1 {
+		return args[0], args[len(args)-1]
+	}
+
+	return "", args[len(args)-1]
+}
@@ -0,0 +1,49 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTitleFuncs(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ParseTest(&buffer, "{{ . | title }}", "don't stop-believing, o'neil")
+	AssertEqual(t, &buffer, "Don&#39;t Stop-Believing, O&#39;neil")
+
+	TextTemplateParseTest(&buffer, "{{ . | title }}", "it’s 1st élan ǆungla")
+	AssertEqual(t, &buffer, "It’s 1st Élan ǅungla")
+
+	TextTemplateParseTest(&buffer, "{{ . | title }}", "élan vital")
+	AssertEqual(t, &buffer, "Élan Vital")
+
+	TextTemplateParseTest(&buffer, "{{ . | title \"tr\" }}", "istanbul ırmak")
+	AssertEqual(t, &buffer, "İstanbul Irmak")
+
+	TextTemplateParseTest(&buffer, "{{ . | title \"tr-TR\" }}", "izmir")
+	AssertEqual(t, &buffer, "İzmir")
+
+	TextTemplateParseTest(&buffer, "{{ . | title \"nl\" }}", "ijsland en ijmuiden")
+	AssertEqual(t, &buffer, "IJsland En IJmuiden")
+
+	TextTemplateParseTest(&buffer, "{{ . | title }}", "ijsland")
+	AssertEqual(t, &buffer, "Ijsland")
+
+	TextTemplateParseTest(&buffer, "{{ . | title }}", "안녕하세요 world")
+	AssertEqual(t, &buffer, "안녕하세요 World")
+
+	TextTemplateParseTest(&buffer, "{{ . | capfirst }}", "élan")
+	AssertEqual(t, &buffer, "Élan")
+
+	TextTemplateParseTest(&buffer, "{{ . | capfirst }}", "")
+	AssertEqual(t, &buffer, "")
+
+	TextTemplateParseTest(&buffer, "{{ . | capfirst }}", "1st")
+	AssertEqual(t, &buffer, "1st")
+
+	TextTemplateParseTest(&buffer, "{{ . | capfirst \"tr\" }}", "iyi günler")
+	AssertEqual(t, &buffer, "İyi günler")
+
+	TextTemplateParseTest(&buffer, "{{ . | capfirst \"nl\" }}", "ijs")
+	AssertEqual(t, &buffer, "IJs")
+}
@@ -0,0 +1,253 @@
+package gtf
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+
+	errTOMLType = errors.New("gtf: value can not be encoded as TOML")
+)
+
+// isBareKey reports whether s can be written without quotes as a TOML key.
+func isBareKey(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r > unicode.MaxASCII || !(r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func tomlKey(s string) string {
+	if isBareKey(s) {
+		return s
+	}
+
+	return tomlString(s)
+}
+
+// tomlString returns s as a TOML basic string.
+func tomlString(s string) string {
+	var buffer bytes.Buffer
+
+	buffer.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\b':
+			buffer.WriteString(`\b`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\f':
+			buffer.WriteString(`\f`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		default:
+			if r < ' ' || r == 0x7f {
+				buffer.WriteString(`\u` + strconv.FormatInt(int64(r)+0x10000, 16)[1:])
+			} else {
+				buffer.WriteRune(r)
+			}
+		}
+	}
+	buffer.WriteByte('"')
+
+	return buffer.String()
+}
+
+// tomlEntries returns the entries of a map or struct in output order,
+// leaving out nil values which TOML can not represent.
+func tomlEntries(v reflect.Value) []keyValue {
+	var entries []keyValue
+	switch v.Kind() {
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			if value := v.MapIndex(key); !isNil(value) {
+				entries = append(entries, keyValue{stringify(indirect(key)), value})
+			}
+		}
+	case reflect.Struct:
+		for _, f := range structFields(v.Type(), []string{"toml", "json"}, identity) {
+			value := fieldByIndex(v, f.index)
+			if isNil(value) || (f.omitEmpty && isEmpty(value)) {
+				continue
+			}
+			entries = append(entries, keyValue{f.name, value})
+		}
+	}
+
+	return entries
+}
+
+// isTable reports whether v is encoded as a TOML table.
+func isTable(v reflect.Value) bool {
+	v = indirect(v)
+	if !v.IsValid() || v.Type() == timeType {
+		return false
+	}
+	if _, ok := textValue(v); ok {
+		return false
+	}
+
+	return v.Kind() == reflect.Map || v.Kind() == reflect.Struct
+}
+
+// isTableArray reports whether v is a non-empty list of tables.
+func isTableArray(v reflect.Value) bool {
+	v = indirect(v)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array || v.Len() == 0 {
+		return false
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if !isTable(v.Index(i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tomlInline returns v as an inline TOML value.
+func tomlInline(v reflect.Value) (string, error) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return "", errTOMLType
+	}
+
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339Nano), nil
+	}
+	if text, ok := textValue(v); ok {
+		return tomlString(text), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return tomlString(v.String()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsInf(f, 1):
+			return "inf", nil
+		case math.IsInf(f, -1):
+			return "-inf", nil
+		case math.IsNaN(f):
+			return "nan", nil
+		}
+		s := strconv.FormatFloat(f, 'g', -1, v.Type().Bits())
+		if !strings.ContainsAny(s, ".eEn") {
+			s += ".0"
+		}
+		return s, nil
+	case reflect.Slice, reflect.Array:
+		items := make([]string, v.Len())
+		for i := range items {
+			item, err := tomlInline(v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			items[i] = item
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case reflect.Map, reflect.Struct:
+		var items []string
+		for _, entry := range tomlEntries(v) {
+			item, err := tomlInline(entry.value)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, tomlKey(entry.key)+" = "+item)
+		}
+		if len(items) == 0 {
+			return "{}", nil
+		}
+		return "{ " + strings.Join(items, ", ") + " }", nil
+	}
+
+	return "", errTOMLType
+}
+
+// writeTOMLTable writes the entries of v. Plain keys come first, followed
+// by sub-tables and arrays of tables, as TOML requires.
+func writeTOMLTable(buffer *bytes.Buffer, path []string, v reflect.Value) error {
+	entries := tomlEntries(indirect(v))
+
+	for _, entry := range entries {
+		if isTable(entry.value) || isTableArray(entry.value) {
+			continue
+		}
+		value, err := tomlInline(entry.value)
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(tomlKey(entry.key) + " = " + value + "\n")
+	}
+
+	for _, entry := range entries {
+		keyPath := append(append([]string{}, path...), tomlKey(entry.key))
+		switch {
+		case isTable(entry.value):
+			if buffer.Len() > 0 {
+				buffer.WriteString("\n")
+			}
+			buffer.WriteString("[" + strings.Join(keyPath, ".") + "]\n")
+			if err := writeTOMLTable(buffer, keyPath, entry.value); err != nil {
+				return err
+			}
+		case isTableArray(entry.value):
+			list := indirect(entry.value)
+			for i := 0; i < list.Len(); i++ {
+				if buffer.Len() > 0 {
+					buffer.WriteString("\n")
+				}
+				buffer.WriteString("[[" + strings.Join(keyPath, ".") + "]]\n")
+				if err := writeTOMLTable(buffer, keyPath, list.Index(i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// toTOML encodes a map or struct as a TOML document.
+func toTOML(value interface{}) (string, error) {
+	v := reflect.ValueOf(value)
+	if !isTable(v) {
+		return "", errTOMLType
+	}
+
+	var buffer bytes.Buffer
+	if err := writeTOMLTable(&buffer, nil, v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
@@ -0,0 +1,60 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+type tomlTestConfig struct {
+	Title   string                 `toml:"title"`
+	Owner   map[string]interface{} `toml:"owner"`
+	Servers []tomlTestServer       `toml:"servers"`
+	Ports   []int                  `toml:"ports"`
+	Ratio   float64                `toml:"ratio"`
+	Skipped *tomlTestServer        `toml:"skipped"`
+}
+
+type tomlTestServer struct {
+	Name string `toml:"name"`
+	IP   string `toml:"ip,omitempty"`
+}
+
+func TestToTOML(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | totoml }}", tomlTestConfig{
+		Title: "TOML \"Example\"",
+		Owner: map[string]interface{}{"name": "Tom", "dob": "1979-05-27", "web site": "example.com"},
+		Servers: []tomlTestServer{
+			{Name: "alpha", IP: "10.0.0.1"},
+			{Name: "beta"},
+		},
+		Ports: []int{8001, 8002},
+		Ratio: 2,
+	})
+	AssertEqual(t, &buffer, `title = "TOML \"Example\""
+ports = [8001, 8002]
+ratio = 2.0
+
+[owner]
+dob = "1979-05-27"
+name = "Tom"
+"web site" = "example.com"
+
+[[servers]]
+name = "alpha"
+ip = "10.0.0.1"
+
+[[servers]]
+name = "beta"`)
+
+	TextTemplateParseTest(&buffer, "{{ . | totoml }}", map[string]interface{}{
+		"points": []map[string]int{},
+		"matrix": [][]interface{}{{1, "a"}, {map[string]bool{"ok": true}}},
+	})
+	AssertEqual(t, &buffer, `matrix = [[1, "a"], [{ ok = true }]]
+points = []`)
+
+	TextTemplateParseTest(&buffer, "{{ . | totoml }}", []int{1})
+	AssertEqual(t, &buffer, "")
+}
@@ -0,0 +1,205 @@
+package gtf
+
+import (
+	"bytes"
+	"fmt"
+	htmlTemplate "html/template"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// URLParts is the result of urlparse.
+type URLParts struct {
+	Scheme   string
+	User     string
+	Host     string // host or host:port
+	Hostname string // host without the port
+	Port     string
+	Path     string
+	RawQuery string
+	Query    url.Values
+	Fragment string
+}
+
+func urlParse(s string) interface{} {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+
+	user := ""
+	if u.User != nil {
+		user = u.User.Username()
+	}
+
+	return URLParts{
+		Scheme:   u.Scheme,
+		User:     user,
+		Host:     u.Host,
+		Hostname: u.Hostname(),
+		Port:     u.Port(),
+		Path:     u.Path,
+		RawQuery: u.RawQuery,
+		Query:    u.Query(),
+		Fragment: u.Fragment,
+	}
+}
+
+// iriEncode percent-encodes the characters of an IRI which are not
+// allowed in a URI, like Django's iriencode. Reserved characters and
+// existing escapes are kept, and an internationalized host name is
+// converted into its ASCII form.
+func iriEncode(s string) string {
+	s = mapURLHost(s, idnaToASCII)
+
+	var buffer bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if isURLSafe(s[i]) {
+			buffer.WriteByte(s[i])
+		} else {
+			fmt.Fprintf(&buffer, "%%%02X", s[i])
+		}
+	}
+
+	return buffer.String()
+}
+
+func urlJoin(ref string, base string) string {
+	b, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+
+	return asciiHost(b.ResolveReference(r)).String()
+}
+
+// asciiHost converts an internationalized host name of u into its ASCII
+// form, which url.URL.String would otherwise percent-encode.
+func asciiHost(u *url.URL) *url.URL {
+	if host, ok := idnaToASCII(u.Hostname()); ok && host != u.Hostname() {
+		if port := u.Port(); port != "" {
+			host += ":" + port
+		}
+		u.Host = host
+	}
+
+	return u
+}
+
+// queryValues returns the query parameter values given for a key of
+// urlquery. nil removes the parameter and lists set several values.
+func queryValues(value interface{}) []string {
+	v := indirect(reflect.ValueOf(value))
+	if !v.IsValid() {
+		return nil
+	}
+
+	if items, _, ok := listItems(value); ok && v.Kind() != reflect.String {
+		values := make([]string, len(items))
+		for i, item := range items {
+			values[i] = stringify(indirect(item))
+		}
+		return values
+	}
+
+	return []string{stringify(v)}
+}
+
+// setQuery sets the query parameters given as key and value pairs in
+// the query string rawQuery. Other parameters keep their order, and a
+// parameter which is set again keeps its position.
+func setQuery(rawQuery string, pairs []interface{}) string {
+	var parts []string
+	if rawQuery != "" {
+		parts = strings.Split(rawQuery, "&")
+	}
+
+	for i := 0; i+1 < len(pairs); i += 2 {
+		key := fmt.Sprint(pairs[i])
+
+		position := -1
+		var kept []string
+		for _, part := range parts {
+			name, err := url.QueryUnescape(strings.SplitN(part, "=", 2)[0])
+			if err == nil && name == key {
+				if position < 0 {
+					position = len(kept)
+				}
+				continue
+			}
+			kept = append(kept, part)
+		}
+		if position < 0 {
+			position = len(kept)
+		}
+
+		var added []string
+		for _, value := range queryValues(pairs[i+1]) {
+			added = append(added, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		}
+
+		parts = append(append(append([]string{}, kept[:position]...), added...), kept[position:]...)
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// setURLQuery sets or removes query parameters of the URL given as the last
+// argument. It is registered as urlsetquery, because urlquery is a
+// predefined escaper of html/template.
+func setURLQuery(args []interface{}) string {
+	pairs := args[:len(args)-1]
+	if len(pairs)%2 != 0 {
+		return ""
+	}
+
+	u, err := url.Parse(args[len(args)-1].(string))
+	if err != nil {
+		return ""
+	}
+
+	u.RawQuery = setQuery(u.RawQuery, pairs)
+	u.ForceQuery = false
+
+	return asciiHost(u).String()
+}
+
+func urlDecode(s string) string {
+	result, err := url.QueryUnescape(s)
+	if err != nil {
+		return ""
+	}
+
+	return result
+}
+
+// isSafeURL reports whether s is a relative URL or uses one of the
+// schemes html/template considers safe.
+func isSafeURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	}
+
+	return false
+}
+
+// trustedURL marks s as a safe URL for html/template. URLs with other
+// schemes, like javascript:, are replaced like html/template does.
+func trustedURL(s string) htmlTemplate.URL {
+	if !isSafeURL(s) {
+		return "#ZgotmplZ"
+	}
+
+	return htmlTemplate.URL(s)
+}
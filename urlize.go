@@ -0,0 +1,195 @@
+package gtf
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	urlizeWordSeparator = regexp.MustCompile(`[\s<>"']+`)
+	urlizeSimpleURL     = regexp.MustCompile(`(?i)^https?://\[?[\pL\pN_]`)
+	urlizeBareDomain    = regexp.MustCompile(`(?i)^[\pL\pN][\pL\pN.-]*\.(com|edu|gov|int|mil|net|org|io|dev|app|info|biz|co|me|eu|uk|de|fr|nl|ch|jp|kr|ca|au|us)($|/.*)$`)
+)
+
+// urlizeWrapping lists the punctuation pairs which may wrap a URL.
+var urlizeWrapping = [][2]string{{"(", ")"}, {"[", "]"}}
+
+// urlizeTrailing lists the characters which are not part of a URL when
+// they end it.
+const urlizeTrailing = ".,:;!"
+
+// trimPunctuation splits the punctuation surrounding a URL from it, like
+// Django's urlize. Closing brackets are only split if they are not
+// balanced inside the URL, so "(see http://w.org/a_(b))" keeps the
+// parentheses of the URL.
+func trimPunctuation(word string) (lead, middle, trail string) {
+	middle = word
+	for trimmed := true; trimmed; {
+		trimmed = false
+		for _, pair := range urlizeWrapping {
+			if strings.HasPrefix(middle, pair[0]) {
+				middle = middle[len(pair[0]):]
+				lead += pair[0]
+				trimmed = true
+			}
+			if strings.HasSuffix(middle, pair[1]) && strings.Count(middle, pair[1]) == strings.Count(middle, pair[0])+1 {
+				middle = middle[:len(middle)-len(pair[1])]
+				trail = pair[1] + trail
+				trimmed = true
+			}
+		}
+
+		if stripped := strings.TrimRight(middle, urlizeTrailing); stripped != middle {
+			trail = middle[len(stripped):] + trail
+			middle = stripped
+			trimmed = true
+		}
+	}
+
+	return lead, middle, trail
+}
+
+// isSimpleEmail reports whether s looks like an email address.
+func isSimpleEmail(s string) bool {
+	if strings.Contains(s, ":") || strings.Count(s, "@") != 1 {
+		return false
+	}
+
+	i := strings.Index(s, "@")
+	local, domain := s[:i], s[i+1:]
+
+	return local != "" && strings.Contains(domain, ".") &&
+		!strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
+
+// isURLSafe reports whether c can appear unescaped in a URL produced by
+// urlize.
+func isURLSafe(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		strings.IndexByte("-._~!*'();:@&=+$,/?#[]%", c) >= 0
+}
+
+// quoteURL percent-encodes the characters of s which may not appear in a
+// URL, leaving existing escapes alone.
+func quoteURL(s string) string {
+	var buffer bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' && (i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2])) {
+			buffer.WriteString("%25")
+		} else if isURLSafe(c) {
+			buffer.WriteByte(c)
+		} else {
+			fmt.Fprintf(&buffer, "%%%02X", c)
+		}
+	}
+
+	return buffer.String()
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// truncateURL shortens the link text s to limit characters, ending with
+// an ellipsis. A limit of 0 or less leaves s unchanged.
+func truncateURL(s string, limit int) string {
+	if limit <= 0 || utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	if limit == 1 {
+		return "…"
+	}
+
+	return string([]rune(s)[:limit-1]) + "…"
+}
+
+// urlizeOptions holds the attributes of the links made by urlize.
+type urlizeOptions struct {
+	limit  int
+	rel    string
+	target string
+	escape bool
+}
+
+// urlizeArgs parses the optional rel and target arguments of urlize and
+// urlizetrunc, which precede the value.
+func urlizeArgs(args []interface{}) (urlizeOptions, string) {
+	options := urlizeOptions{rel: "nofollow"}
+	for i, arg := range args[:len(args)-1] {
+		switch i {
+		case 0:
+			options.rel = arg.(string)
+		case 1:
+			options.target = arg.(string)
+		}
+	}
+
+	return options, args[len(args)-1].(string)
+}
+
+// urlizeWord returns the link for a word of text, or the word itself if
+// it is not a URL or email address.
+func urlizeWord(word string, options urlizeOptions) string {
+	if !strings.ContainsAny(word, ".@:") {
+		return escapeIf(word, options.escape)
+	}
+
+	lead, middle, trail := trimPunctuation(word)
+
+	// Links point to the ASCII form of internationalized domain names
+	// and show their Unicode form. Other host names are shown as they
+	// are written.
+	href, text := "", middle
+	web := true
+	switch {
+	case urlizeSimpleURL.MatchString(middle):
+		href = quoteURL(mapURLHost(middle, idnaToASCII))
+		text = mapURLHost(middle, idnaDisplay)
+	case strings.HasPrefix(strings.ToLower(middle), "www."),
+		!strings.HasPrefix(strings.ToLower(middle), "http") && urlizeBareDomain.MatchString(middle):
+		href = quoteURL(mapURLHost("http://"+middle, idnaToASCII))
+		text = strings.TrimPrefix(mapURLHost("http://"+middle, idnaDisplay), "http://")
+	case isSimpleEmail(middle):
+		href = "mailto:" + quoteURL(mapEmailDomain(middle, idnaToASCII))
+		text = mapEmailDomain(middle, idnaDisplay)
+		web = false
+	default:
+		return escapeIf(word, options.escape)
+	}
+
+	attrs := ""
+	if web && options.rel != "" {
+		attrs += ` rel="` + html.EscapeString(options.rel) + `"`
+	}
+	if web && options.target != "" {
+		attrs += ` target="` + html.EscapeString(options.target) + `"`
+	}
+
+	text = escapeIf(truncateURL(text, options.limit), options.escape)
+
+	return escapeIf(lead, options.escape) +
+		`<a href="` + html.EscapeString(href) + `"` + attrs + `>` + text + `</a>` +
+		escapeIf(trail, options.escape)
+}
+
+// urlize converts the URLs and email addresses in s into links, like
+// Django's urlize. If options.escape is true, the rest of the text is
+// escaped for HTML.
+func urlize(s string, options urlizeOptions) string {
+	var buffer bytes.Buffer
+
+	start := 0
+	for _, loc := range urlizeWordSeparator.FindAllStringIndex(s, -1) {
+		buffer.WriteString(urlizeWord(s[start:loc[0]], options))
+		buffer.WriteString(escapeIf(s[loc[0]:loc[1]], options.escape))
+		start = loc[1]
+	}
+	buffer.WriteString(urlizeWord(s[start:], options))
+
+	return buffer.String()
+}
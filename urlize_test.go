@@ -0,0 +1,49 @@
+package gtf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUrlize(t *testing.T) {
+	var buffer bytes.Buffer
+
+	cases := map[string]string{
+		"see https://golang.org/doc":                    `see <a href="https://golang.org/doc" rel="nofollow">https://golang.org/doc</a>`,
+		"visit www.example.com.":                        `visit <a href="http://www.example.com" rel="nofollow">www.example.com</a>.`,
+		"try example.org/path, then go":                 `try <a href="http://example.org/path" rel="nofollow">example.org/path</a>, then go`,
+		"mail gopher@golang.org!":                       `mail <a href="mailto:gopher@golang.org">gopher@golang.org</a>!`,
+		"(see https://en.wikipedia.org/wiki/Go_(game))": `(see <a href="https://en.wikipedia.org/wiki/Go_(game)" rel="nofollow">https://en.wikipedia.org/wiki/Go_(game)</a>)`,
+		"[http://example.com]":                          `[<a href="http://example.com" rel="nofollow">http://example.com</a>]`,
+		"http://example.com/a b?q=1&r=<2>":              `<a href="http://example.com/a" rel="nofollow">http://example.com/a</a> b?q=1&amp;r=&lt;2&gt;`,
+		"http://example.com/ü?x=a%20b%zz":               `<a href="http://example.com/%C3%BC?x=a%20b%25zz" rel="nofollow">http://example.com/ü?x=a%20b%zz</a>`,
+		"main.go and a@b and foo:bar":                   `main.go and a@b and foo:bar`,
+		"<b>tags</b> & \"quotes\"":                      `&lt;b&gt;tags&lt;/b&gt; &amp; &#34;quotes&#34;`,
+		"javascript:alert(1)":                           `javascript:alert(1)`,
+		"javascript:alert(1)//x.com":                    `javascript:alert(1)//x.com`,
+		"see src/app.io and a_b.com":                    `see src/app.io and a_b.com`,
+		"go to sub-domain.example.co.uk/a.io":           `go to <a href="http://sub-domain.example.co.uk/a.io" rel="nofollow">sub-domain.example.co.uk/a.io</a>`,
+		"한국.kr":                                         `<a href="http://xn--3e0b707e.kr" rel="nofollow">한국.kr</a>`,
+		"":                                              ``,
+	}
+
+	for input, expected := range cases {
+		ParseTest(&buffer, "{{ . | urlize }}", input)
+		AssertEqual(t, &buffer, expected)
+	}
+
+	ParseTest(&buffer, "{{ . | urlize \"nofollow noopener\" \"_blank\" }}", "go to golang.org")
+	AssertEqual(t, &buffer, `go to <a href="http://golang.org" rel="nofollow noopener" target="_blank">golang.org</a>`)
+
+	ParseTest(&buffer, "{{ . | urlize \"\" }}", "golang.org")
+	AssertEqual(t, &buffer, `<a href="http://golang.org">golang.org</a>`)
+
+	ParseTest(&buffer, "{{ . | urlizetrunc 15 }}", "https://github.com/leekchan/gtf")
+	AssertEqual(t, &buffer, `<a href="https://github.com/leekchan/gtf" rel="nofollow">https://github…</a>`)
+
+	ParseTest(&buffer, "{{ . | urlizetrunc 15 \"nofollow\" \"_blank\" }}", "www.go.dev")
+	AssertEqual(t, &buffer, `<a href="http://www.go.dev" rel="nofollow" target="_blank">www.go.dev</a>`)
+
+	TextTemplateParseTest(&buffer, "{{ . | urlize }}", "<i>golang.org</i> & more")
+	AssertEqual(t, &buffer, `<i><a href="http://golang.org" rel="nofollow">golang.org</a></i> & more`)
+}
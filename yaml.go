@@ -0,0 +1,764 @@
+package gtf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	yamlIntRegexp   = regexp.MustCompile(`^[-+]?[0-9]+$`)
+	yamlFloatRegexp = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+
+	errYAMLSyntax = errors.New("gtf: invalid or unsupported YAML")
+)
+
+// yamlKeywords are plain scalars which must be quoted to stay strings.
+var yamlKeywords = map[string]bool{
+	"~": true, "null": true, "Null": true, "NULL": true,
+	"true": true, "True": true, "TRUE": true, "false": true, "False": true, "FALSE": true,
+	"yes": true, "Yes": true, "YES": true, "no": true, "No": true, "NO": true,
+	"on": true, "On": true, "ON": true, "off": true, "Off": true, "OFF": true,
+	"y": true, "Y": true, "n": true, "N": true,
+	".inf": true, "-.inf": true, "+.inf": true, ".Inf": true, ".INF": true, ".nan": true, ".NaN": true, ".NAN": true,
+}
+
+// yamlQuote returns s as a YAML scalar, quoting it only when a plain
+// scalar would be read back differently.
+func yamlQuote(s string) string {
+	needsQuote := s == "" ||
+		yamlKeywords[s] ||
+		yamlIntRegexp.MatchString(s) ||
+		yamlFloatRegexp.MatchString(s) ||
+		strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0o") ||
+		strings.TrimSpace(s) != s ||
+		strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") ||
+		strings.Contains(s, ": ") || strings.Contains(s, " #") ||
+		strings.HasSuffix(s, ":") ||
+		s == "---" || s == "..."
+
+	if !needsQuote {
+		for _, r := range s {
+			if r < ' ' || r == 0x7f || r == '\u2028' || r == '\u2029' || r == '\ufeff' {
+				needsQuote = true
+				break
+			}
+		}
+	}
+
+	if !needsQuote {
+		return s
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(s)
+
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// yamlInline returns the flow representation of scalars and empty
+// collections. ok is false for values which need a block.
+func yamlInline(v reflect.Value) (string, bool) {
+	if text, ok := textValue(v); ok {
+		return yamlQuote(text), true
+	}
+
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = indirect(v)
+		if text, ok := textValue(v); ok {
+			return yamlQuote(text), true
+		}
+	}
+	if !v.IsValid() {
+		return "null", true
+	}
+
+	if v.CanAddr() {
+		if text, ok := textValue(v.Addr()); ok {
+			return yamlQuote(text), true
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return yamlQuote(v.String()), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsInf(f, 1):
+			return ".inf", true
+		case math.IsInf(f, -1):
+			return "-.inf", true
+		case math.IsNaN(f):
+			return ".nan", true
+		}
+		return strconv.FormatFloat(f, 'g', -1, v.Type().Bits()), true
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return "[]", true
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			return "{}", true
+		}
+	case reflect.Struct:
+		if len(yamlFields(v)) == 0 {
+			return "{}", true
+		}
+	default:
+		return "", false
+	}
+
+	return "", false
+}
+
+// yamlFields returns the entries of a map or struct in output order.
+func yamlFields(v reflect.Value) []keyValue {
+	var fields []keyValue
+	switch v.Kind() {
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			fields = append(fields, keyValue{stringify(indirect(key)), v.MapIndex(key)})
+		}
+	case reflect.Struct:
+		for _, f := range structFields(v.Type(), []string{"yaml", "json"}, lowerFieldName) {
+			value := fieldByIndex(v, f.index)
+			if f.omitEmpty && isEmpty(value) {
+				continue
+			}
+			fields = append(fields, keyValue{f.name, value})
+		}
+	}
+
+	return fields
+}
+
+// yamlLines renders a non-empty collection as block YAML lines.
+func yamlLines(v reflect.Value) []string {
+	v = indirect(v)
+
+	var lines []string
+	switch v.Kind() {
+	case reflect.Map, reflect.Struct:
+		for _, f := range yamlFields(v) {
+			key := yamlQuote(f.key)
+			if inline, ok := yamlInline(f.value); ok {
+				lines = append(lines, key+": "+inline)
+				continue
+			}
+			lines = append(lines, key+":")
+			for _, line := range yamlLines(f.value) {
+				lines = append(lines, "  "+line)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			if inline, ok := yamlInline(item); ok {
+				lines = append(lines, "- "+inline)
+				continue
+			}
+			for j, line := range yamlLines(item) {
+				if j == 0 {
+					lines = append(lines, "- "+line)
+				} else {
+					lines = append(lines, "  "+line)
+				}
+			}
+		}
+	}
+
+	return lines
+}
+
+func toYAML(value interface{}) string {
+	v := reflect.ValueOf(value)
+	if inline, ok := yamlInline(v); ok {
+		return inline
+	}
+
+	return strings.Join(yamlLines(v), "\n")
+}
+
+type yamlLine struct {
+	indent int
+	text   string
+}
+
+// yamlParser reads the block and flow YAML commonly found in
+// configuration files: mappings, sequences, plain and quoted scalars,
+// literal and folded block scalars and comments. Anchors, aliases, tags
+// and multiple documents are not supported.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// stripYAMLComment removes a trailing comment which is not inside quotes.
+func stripYAMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.IndexByte(" \t[{,:", s[i-1]) >= 0 {
+				quote = c
+			}
+		case c == '#':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '\t' {
+				return strings.TrimRight(s[:i], " \t")
+			}
+		}
+	}
+
+	return strings.TrimRight(s, " \t")
+}
+
+func newYAMLParser(s string) *yamlParser {
+	s = strings.Replace(s, "\r\n", "\n", -1)
+
+	p := &yamlParser{}
+	for _, raw := range strings.Split(s, "\n") {
+		if raw == "..." {
+			break
+		}
+		if raw == "---" || strings.HasPrefix(raw, "--- ") || strings.HasPrefix(raw, "%") {
+			continue
+		}
+		text := strings.TrimLeft(raw, " ")
+		p.lines = append(p.lines, yamlLine{len(raw) - len(text), text})
+	}
+
+	return p
+}
+
+// isTabIndented reports whether the content of a line is indented with
+// tabs, which YAML does not allow.
+func isTabIndented(line yamlLine) bool {
+	return strings.HasPrefix(line.text, "\t")
+}
+
+// peek returns the next line with content, skipping blank and comment
+// lines.
+func (p *yamlParser) peek() (yamlLine, bool) {
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if stripYAMLComment(line.text) != "" {
+			line.text = stripYAMLComment(line.text)
+			return line, true
+		}
+		p.pos++
+	}
+
+	return yamlLine{}, false
+}
+
+func isSequenceEntry(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// isComplexKey reports whether text starts with the "?" indicator of a
+// complex mapping key, which is not supported.
+func isComplexKey(text string) bool {
+	return text == "?" || strings.HasPrefix(text, "? ") || strings.HasPrefix(text, "?\t")
+}
+
+// splitMappingEntry splits "key: value" into the key and the rest.
+func splitMappingEntry(text string) (key string, rest string, ok bool) {
+	if text == "" || text[0] == '[' || text[0] == '{' || isComplexKey(text) {
+		return "", "", false
+	}
+
+	end := 0
+	if text[0] == '"' || text[0] == '\'' {
+		quoted, n, err := yamlQuoted(text)
+		if err != nil {
+			return "", "", false
+		}
+		key, end = quoted, n
+		if end >= len(text) || text[end] != ':' {
+			return "", "", false
+		}
+	} else {
+		for end < len(text) {
+			if text[end] == ':' && (end+1 == len(text) || text[end+1] == ' ' || text[end+1] == '\t') {
+				break
+			}
+			end++
+		}
+		if end == len(text) {
+			return "", "", false
+		}
+		key = strings.TrimSpace(text[:end])
+	}
+
+	return key, strings.TrimSpace(text[end+1:]), true
+}
+
+func (p *yamlParser) parseNode(minIndent int) (interface{}, error) {
+	line, ok := p.peek()
+	if !ok || line.indent < minIndent {
+		return nil, nil
+	}
+	if isTabIndented(line) {
+		return nil, errYAMLSyntax
+	}
+
+	if isSequenceEntry(line.text) {
+		return p.parseSequence(line.indent)
+	}
+	if _, _, ok := splitMappingEntry(line.text); ok {
+		return p.parseMapping(line.indent)
+	}
+
+	p.pos++
+	if strings.HasPrefix(line.text, "|") || strings.HasPrefix(line.text, ">") {
+		return p.parseBlockScalar(line.text, line.indent-1)
+	}
+
+	return parseYAMLScalar(line.text)
+}
+
+func (p *yamlParser) parseSequence(indent int) (interface{}, error) {
+	result := []interface{}{}
+	for {
+		line, ok := p.peek()
+		if !ok || line.indent < indent || (line.indent == indent && !isSequenceEntry(line.text)) {
+			return result, nil
+		}
+		if line.indent > indent || isTabIndented(line) {
+			return nil, errYAMLSyntax
+		}
+
+		rest := strings.TrimLeft(line.text[1:], " ")
+		if rest == "" {
+			p.pos++
+			item, err := p.parseNode(indent + 1)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, item)
+			continue
+		}
+
+		// Parse the entry's content as if it was written on its own line.
+		p.lines[p.pos] = yamlLine{indent + len(line.text) - len(rest), rest}
+		item, err := p.parseNode(indent + 1)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+}
+
+func (p *yamlParser) parseMapping(indent int) (interface{}, error) {
+	result := map[string]interface{}{}
+	for {
+		line, ok := p.peek()
+		if !ok || line.indent < indent {
+			return result, nil
+		}
+		if line.indent > indent || isTabIndented(line) {
+			return nil, errYAMLSyntax
+		}
+
+		key, rest, ok := splitMappingEntry(line.text)
+		if !ok {
+			return nil, errYAMLSyntax
+		}
+		p.pos++
+
+		var value interface{}
+		var err error
+		switch {
+		case rest == "":
+			next, ok := p.peek()
+			switch {
+			case !ok:
+			case next.indent > indent:
+				value, err = p.parseNode(indent + 1)
+			case next.indent == indent && isSequenceEntry(next.text):
+				value, err = p.parseSequence(indent)
+			}
+		case rest[0] == '|' || rest[0] == '>':
+			value, err = p.parseBlockScalar(rest, indent)
+		default:
+			value, err = parseYAMLScalar(rest)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = value
+	}
+}
+
+// parseBlockScalar reads a literal (|) or folded (>) block scalar whose
+// lines are indented more than parentIndent.
+func (p *yamlParser) parseBlockScalar(header string, parentIndent int) (interface{}, error) {
+	literal := header[0] == '|'
+	chomping := byte(0)
+	for i := 1; i < len(header); i++ {
+		switch c := header[i]; {
+		case c == '-' || c == '+':
+			chomping = c
+		case c >= '1' && c <= '9', c == ' ':
+		case c == '#':
+			i = len(header)
+		default:
+			return nil, errYAMLSyntax
+		}
+	}
+
+	var raw []yamlLine
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.text != "" && line.indent <= parentIndent {
+			break
+		}
+		raw = append(raw, line)
+		p.pos++
+	}
+
+	blockIndent := -1
+	for _, line := range raw {
+		if line.text != "" {
+			blockIndent = line.indent
+			break
+		}
+	}
+
+	var lines []string
+	for _, line := range raw {
+		if line.text == "" || blockIndent < 0 {
+			lines = append(lines, "")
+			continue
+		}
+		lines = append(lines, strings.Repeat(" ", line.indent-blockIndent)+line.text)
+	}
+
+	trailing := 0
+	for trailing < len(lines) && lines[len(lines)-1-trailing] == "" {
+		trailing++
+	}
+	content := lines[:len(lines)-trailing]
+
+	var text string
+	if literal {
+		text = strings.Join(content, "\n")
+	} else {
+		var buffer bytes.Buffer
+		for i, line := range content {
+			if i > 0 {
+				previous := content[i-1]
+				switch {
+				case line == "" || previous == "":
+					buffer.WriteString("\n")
+				case strings.HasPrefix(line, " ") || strings.HasPrefix(previous, " "):
+					buffer.WriteString("\n")
+				default:
+					buffer.WriteString(" ")
+				}
+			}
+			buffer.WriteString(line)
+		}
+		text = strings.Replace(buffer.String(), "\n\n", "\n", -1)
+	}
+
+	switch {
+	case len(content) == 0:
+	case chomping == '+':
+		text += strings.Repeat("\n", trailing+1)
+	case chomping != '-':
+		text += "\n"
+	}
+
+	return text, nil
+}
+
+var yamlEscapes = map[byte]string{
+	'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n", 'v': "\v",
+	'f': "\f", 'r': "\r", 'e': "\x1b", ' ': " ", '"': "\"", '/': "/", '\\': "\\",
+	'N': "\u0085", '_': " ", 'L': " ", 'P': " ",
+}
+
+// yamlQuoted reads a single or double quoted scalar at the start of s and
+// returns its value and length.
+func yamlQuoted(s string) (string, int, error) {
+	quote := s[0]
+
+	var buffer bytes.Buffer
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '\'' && c == '\'':
+			if i+1 < len(s) && s[i+1] == '\'' {
+				buffer.WriteByte('\'')
+				i++
+				continue
+			}
+			return buffer.String(), i + 1, nil
+		case quote == '"' && c == '"':
+			return buffer.String(), i + 1, nil
+		case quote == '"' && c == '\\' && i+1 < len(s):
+			i++
+			if escaped, ok := yamlEscapes[s[i]]; ok {
+				buffer.WriteString(escaped)
+				continue
+			}
+
+			size := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[i]]
+			if size == 0 || i+size >= len(s) {
+				return "", 0, errYAMLSyntax
+			}
+			code, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(code)) {
+				return "", 0, errYAMLSyntax
+			}
+			buffer.WriteRune(rune(code))
+			i += size
+		default:
+			buffer.WriteByte(c)
+		}
+	}
+
+	return "", 0, errYAMLSyntax
+}
+
+// resolveYAMLPlain converts a plain scalar into null, a boolean, a number
+// or a string, following the YAML 1.2 core schema.
+func resolveYAMLPlain(s string) interface{} {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	case ".inf", "+.inf", ".Inf", "+.Inf", ".INF", "+.INF":
+		return math.Inf(1)
+	case "-.inf", "-.Inf", "-.INF":
+		return math.Inf(-1)
+	case ".nan", ".NaN", ".NAN":
+		return math.NaN()
+	}
+
+	if yamlIntRegexp.MatchString(s) {
+		if i, err := strconv.Atoi(s); err == nil {
+			return i
+		}
+	}
+	if strings.HasPrefix(s, "0x") {
+		if i, err := strconv.ParseInt(s[2:], 16, 0); err == nil {
+			return int(i)
+		}
+	}
+	if strings.HasPrefix(s, "0o") {
+		if i, err := strconv.ParseInt(s[2:], 8, 0); err == nil {
+			return int(i)
+		}
+	}
+	if yamlFloatRegexp.MatchString(s) {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	}
+
+	return s
+}
+
+func parseYAMLScalar(s string) (interface{}, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	switch s[0] {
+	case '[', '{':
+		f := &yamlFlowParser{s: s}
+		value, err := f.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		f.skipSpaces()
+		if f.pos != len(s) {
+			return nil, errYAMLSyntax
+		}
+		return value, nil
+	case '"', '\'':
+		value, n, err := yamlQuoted(s)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(s[n:]) != "" {
+			return nil, errYAMLSyntax
+		}
+		return value, nil
+	case '&', '*', '!':
+		return nil, errYAMLSyntax
+	case '?':
+		if isComplexKey(s) {
+			return nil, errYAMLSyntax
+		}
+	}
+
+	// A mapping indicator can not appear inside a plain scalar.
+	if strings.Contains(s, ": ") || strings.Contains(s, ":\t") || strings.HasSuffix(s, ":") {
+		return nil, errYAMLSyntax
+	}
+
+	return resolveYAMLPlain(s), nil
+}
+
+// yamlFlowParser reads flow sequences ([a, b]) and flow mappings
+// ({a: 1, b: 2}).
+type yamlFlowParser struct {
+	s   string
+	pos int
+}
+
+func (f *yamlFlowParser) skipSpaces() {
+	for f.pos < len(f.s) && (f.s[f.pos] == ' ' || f.s[f.pos] == '\t') {
+		f.pos++
+	}
+}
+
+func (f *yamlFlowParser) parseValue() (interface{}, error) {
+	f.skipSpaces()
+	if f.pos >= len(f.s) {
+		return nil, errYAMLSyntax
+	}
+
+	switch f.s[f.pos] {
+	case '[':
+		return f.parseSequence()
+	case '{':
+		return f.parseMapping()
+	case '"', '\'':
+		value, n, err := yamlQuoted(f.s[f.pos:])
+		if err != nil {
+			return nil, err
+		}
+		f.pos += n
+		return value, nil
+	}
+
+	start := f.pos
+	for f.pos < len(f.s) && !strings.ContainsRune(",]}", rune(f.s[f.pos])) {
+		if f.s[f.pos] == ':' && (f.pos+1 == len(f.s) || strings.ContainsRune(" ,]}", rune(f.s[f.pos+1]))) {
+			break
+		}
+		f.pos++
+	}
+
+	return resolveYAMLPlain(strings.TrimSpace(f.s[start:f.pos])), nil
+}
+
+func (f *yamlFlowParser) parseSequence() (interface{}, error) {
+	f.pos++
+	result := []interface{}{}
+	for {
+		f.skipSpaces()
+		if f.pos < len(f.s) && f.s[f.pos] == ']' {
+			f.pos++
+			return result, nil
+		}
+
+		item, err := f.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+
+		f.skipSpaces()
+		if f.pos >= len(f.s) {
+			return nil, errYAMLSyntax
+		}
+		switch f.s[f.pos] {
+		case ',':
+			f.pos++
+		case ']':
+		default:
+			return nil, errYAMLSyntax
+		}
+	}
+}
+
+func (f *yamlFlowParser) parseMapping() (interface{}, error) {
+	f.pos++
+	result := map[string]interface{}{}
+	for {
+		f.skipSpaces()
+		if f.pos < len(f.s) && f.s[f.pos] == '}' {
+			f.pos++
+			return result, nil
+		}
+
+		key, err := f.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{}
+		f.skipSpaces()
+		if f.pos < len(f.s) && f.s[f.pos] == ':' {
+			f.pos++
+			if value, err = f.parseValue(); err != nil {
+				return nil, err
+			}
+		}
+		if key == nil {
+			key = ""
+		}
+		result[fmt.Sprint(key)] = value
+
+		f.skipSpaces()
+		if f.pos >= len(f.s) {
+			return nil, errYAMLSyntax
+		}
+		switch f.s[f.pos] {
+		case ',':
+			f.pos++
+		case '}':
+		default:
+			return nil, errYAMLSyntax
+		}
+	}
+}
+
+// fromYAML decodes s into map[string]interface{}, []interface{} and
+// scalar values.
+func fromYAML(s string) (interface{}, error) {
+	p := newYAMLParser(s)
+
+	value, err := p.parseNode(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := p.peek(); ok {
+		return nil, errYAMLSyntax
+	}
+
+	return value, nil
+}
@@ -0,0 +1,147 @@
+package gtf
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type yamlTestServer struct {
+	Host    string
+	Port    int    `yaml:"port"`
+	Comment string `yaml:",omitempty"`
+	Tags    []string
+	Started time.Time
+}
+
+func TestToYAML(t *testing.T) {
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ . | toyaml }}", map[string]interface{}{
+		"name":    "gtf",
+		"version": "1.0",
+		"debug":   false,
+		"ports":   []int{80, 443},
+		"empty":   []string{},
+		"nothing": nil,
+		"servers": []yamlTestServer{
+			{Host: "example.com", Port: 80, Tags: []string{"yes", "a: b"}, Started: time.Date(2015, 8, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		"db": map[string]interface{}{"host": "localhost", "note": "multi\nline"},
+	})
+	AssertEqual(t, &buffer, `db:
+  host: localhost
+  note: "multi\nline"
+debug: false
+empty: []
+name: gtf
+nothing: null
+ports:
+  - 80
+  - 443
+servers:
+  - host: example.com
+    port: 80
+    tags:
+      - "yes"
+      - "a: b"
+    started: 2015-08-01T00:00:00Z
+version: "1.0"`)
+
+	TextTemplateParseTest(&buffer, "{{ . | toyaml }}", "")
+	AssertEqual(t, &buffer, `""`)
+
+	TextTemplateParseTest(&buffer, "{{ . | toyaml }}", [][]int{{1, 2}, {3}})
+	AssertEqual(t, &buffer, "- - 1\n  - 2\n- - 3")
+}
+
+func TestFromYAML(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"a: 1", map[string]interface{}{"a": 1}},
+		{`
+# comment
+name: gtf   # trailing comment
+version: "1.0"
+hash: "#1"
+ratio: 0.5
+enabled: true
+nothing: ~
+list:
+  - 1
+  - two
+inline: [1, "two", {three: 3}]
+compact:
+- a
+- b
+servers:
+  - host: example.com
+    port: 80
+  - host: localhost
+nested:
+  deeper:
+    key: 'it''s'
+`, map[string]interface{}{
+			"name":    "gtf",
+			"version": "1.0",
+			"hash":    "#1",
+			"ratio":   0.5,
+			"enabled": true,
+			"nothing": nil,
+			"list":    []interface{}{1, "two"},
+			"inline":  []interface{}{1, "two", map[string]interface{}{"three": 3}},
+			"compact": []interface{}{"a", "b"},
+			"servers": []interface{}{
+				map[string]interface{}{"host": "example.com", "port": 80},
+				map[string]interface{}{"host": "localhost"},
+			},
+			"nested": map[string]interface{}{"deeper": map[string]interface{}{"key": "it's"}},
+		}},
+		{"literal: |\n  line 1\n\n  line 2\nfolded: >-\n  a\n  b\nnext: \"\\u00e9\\t\"", map[string]interface{}{
+			"literal": "line 1\n\nline 2\n",
+			"folded":  "a b",
+			"next":    "é\t",
+		}},
+		{"---\n- a\n-\n  - b\n- |\n  text\n...\nignored", []interface{}{"a", []interface{}{"b"}, "text\n"}},
+		{"url: http://example.com:80/a\ntab:\tafter\nquestion: ?x\ncode: |\n  \tindented", map[string]interface{}{
+			"url":      "http://example.com:80/a",
+			"tab":      "after",
+			"question": "?x",
+			"code":     "\tindented\n",
+		}},
+	}
+
+	for _, c := range cases {
+		result, err := fromYAML(c.input)
+		if err != nil {
+			t.Errorf("fromYAML(%q) returned %v", c.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("fromYAML(%q): expected %#v, got %#v", c.input, c.expected, result)
+		}
+	}
+
+	invalid := []string{
+		"a: 1\n  b: 2", "a: [1, 2", "- a\nb: 1", "a: *alias",
+		"a:\n\tb: 1", "a:\n  b: 1\n\tc: 2", "- a\n\t- b", "\tkey: value",
+		"a: b: c", "- a: b:", "a: b:",
+		"? complex", "? a: b", "- ?",
+	}
+	for _, input := range invalid {
+		if _, err := fromYAML(input); err != errYAMLSyntax {
+			t.Errorf("fromYAML(%q) returned %v, want errYAMLSyntax", input, err)
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	TextTemplateParseTest(&buffer, "{{ $v := . | fromyaml }}{{ $v.name }}", "name: gtf")
+	AssertEqual(t, &buffer, "gtf")
+
+	TextTemplateParseTest(&buffer, "{{ . | toyaml | fromyaml | toyaml }}", map[string]interface{}{"a": []interface{}{"yes", 1, "1"}})
+	AssertEqual(t, &buffer, "a:\n  - \"yes\"\n  - 1\n  - \"1\"")
+}